@@ -25,6 +25,81 @@ import (
 type FivetranConnectorSpec struct {
 	Connector        Connector              `json:"connector"`
 	ConnectorSchemas *ConnectorSchemaConfig `json:"connectorSchemas,omitempty"`
+	// VaultRef selects the Vault connection used to resolve this connector's secrets instead of the
+	// operator-wide connection secret
+	// +optional
+	VaultRef *VaultRef `json:"vaultRef,omitempty"`
+	// ConnectCard publishes a Fivetran Connect Card URI in the status, through which a user
+	// completes browser-based authorization of the connector, such as OAuth
+	// +optional
+	ConnectCard *ConnectCard `json:"connectCard,omitempty"`
+	// ConnectionDetails publishes the connector ID, group ID and destination schema to a ConfigMap
+	// or Secret, so downstream jobs can discover where the connector lands its data
+	// +optional
+	ConnectionDetails *ConnectionDetails `json:"connectionDetails,omitempty"`
+	// DeletionPolicy is Delete to delete the Fivetran connector when the FivetranConnector is
+	// deleted, or Retain to leave the connector and its dynamic Vault credentials in place. An empty
+	// policy deletes the connector.
+	// +kubebuilder:validation:Enum=Delete;Retain
+	// +optional
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+	// SLA declares how fresh the connector's data must be, reported by the DataFresh condition
+	// +optional
+	SLA *SLA `json:"sla,omitempty"`
+}
+
+const (
+	// DeletionPolicyDelete deletes the Fivetran connector with its FivetranConnector
+	DeletionPolicyDelete = "Delete"
+	// DeletionPolicyRetain leaves the Fivetran connector in place when its FivetranConnector is deleted
+	DeletionPolicyRetain = "Retain"
+)
+
+// SLA declares the data freshness a connector must meet
+type SLA struct {
+	// MaxDataDelayMinutes is how long ago, at most, the connector may have last synced successfully
+	// +kubebuilder:validation:Minimum=1
+	MaxDataDelayMinutes int `json:"maxDataDelayMinutes"`
+}
+
+// VaultRef selects a Vault connection secret and overrides some of its settings
+type VaultRef struct {
+	// SecretName is the name of a Vault connection secret in the connector's namespace, with the
+	// same keys as the operator-wide connection secret
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+	// MountPath overrides the KV mount path of the connection secret
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+	// Namespace is the Vault Enterprise namespace to authenticate and read secrets in
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Role overrides the JWT/OIDC role of the connection secret
+	// +optional
+	Role string `json:"role,omitempty"`
+}
+
+// ConnectCard configures the Connect Card generated for a connector
+type ConnectCard struct {
+	// RedirectURI is where Fivetran sends the user once the Connect Card is completed
+	// +optional
+	RedirectURI string `json:"redirectUri,omitempty"`
+	// HideSetupGuide hides the setup guide in the Connect Card
+	// +optional
+	HideSetupGuide bool `json:"hideSetupGuide,omitempty"`
+}
+
+// ConnectionDetails names the ConfigMap or Secret the connection details are published to
+type ConnectionDetails struct {
+	// Name of the ConfigMap or Secret, created in the connector's namespace and owned by the
+	// connector
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Kind is ConfigMap or Secret
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	// +kubebuilder:default=ConfigMap
+	// +optional
+	Kind string `json:"kind,omitempty"`
 }
 
 // Connector defines the configuration and settings of a FivetranConnector
@@ -32,11 +107,9 @@ type FivetranConnectorSpec struct {
 
 type Connector struct {
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="field is immutable"
 	// The unique identifier for the group within the Fivetran system
 	GroupID string `json:"group_id"`
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="field is immutable"
 	// The connector name within the Fivetran system
 	Service string `json:"service"`
 
@@ -73,10 +146,8 @@ type Connector struct {
 
 	// Trust settings
 	// Specifies whether we should trust the certificate automatically. The default value is TRUE.
-	// +kubebuilder:default=true
 	TrustCertificates *bool `json:"trust_certificates,omitempty"`
 	// Specifies whether we should trust the SSH fingerprint automatically. The default value is TRUE.
-	// +kubebuilder:default=true
 	TrustFingerprints *bool `json:"trust_fingerprints,omitempty"`
 
 	// Data delay sensitivity
@@ -137,19 +208,142 @@ type FivetranConnectorStatus struct {
 	ConnectorURL string `json:"connectorUrl,omitempty"`
 	// ConnectorID is the ID of the created Fivetran connector
 	ConnectorID string `json:"connectorId,omitempty"`
+	// ConnectCardURI is the URI of the Connect Card generated for spec.connectCard
+	ConnectCardURI string `json:"connectCardUri,omitempty"`
+	// SSHPublicKey is the public key of the connector's group, to be authorized on the SSH tunnel
+	// host when spec.connector.networking_method is SshTunnel
+	SSHPublicKey string `json:"sshPublicKey,omitempty"`
 	// Conditions represent the underlying resource state
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// VaultSecretVersions records the KV v2 versions of the Vault secrets used in the last applied configuration
+	VaultSecretVersions []VaultSecretVersion `json:"vaultSecretVersions,omitempty"`
+	// VaultLeases records the leases of the dynamic Vault credentials used in the last applied configuration
+	VaultLeases []VaultLease `json:"vaultLeases,omitempty"`
+	// LastSyncError is the latest sync failure Fivetran reported for the connector
+	LastSyncError *SyncError `json:"lastSyncError,omitempty"`
+	// SetupTests are the results of the last setup test run
+	SetupTests []SetupTestResult `json:"setupTests,omitempty"`
+	// SetupTestWarnings is the number of setup tests that passed with a warning in the last run
+	SetupTestWarnings int `json:"setupTestWarnings,omitempty"`
+	// SyncState is the connector's sync state in Fivetran, such as scheduled, syncing, paused or
+	// rescheduled
+	SyncState string `json:"syncState,omitempty"`
+	// LastReconcileTime is when the operator last reconciled the connector successfully
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+	// LastSyncedAt is when Fivetran last synced the connector's data successfully
+	LastSyncedAt *metav1.Time `json:"lastSyncedAt,omitempty"`
+	// SchemaSummary counts what the connector syncs after the last schema apply
+	SchemaSummary *SchemaSummary `json:"schemaSummary,omitempty"`
+	// LastError is the error that failed the last reconcile; it is cleared by a successful reconcile
+	LastError *ErrorStatus `json:"lastError,omitempty"`
+	// Replacement is the Fivetran connector created to replace the current one, which takes over
+	// once it completes its initial sync
+	Replacement *ConnectorReplacement `json:"replacement,omitempty"`
+}
+
+// ConnectorReplacement is a Fivetran connector created from the spec alongside the current one
+type ConnectorReplacement struct {
+	// ConnectorID is the ID of the replacement Fivetran connector
+	ConnectorID string `json:"connectorId"`
+	// ConnectorHash is the hash of the connector spec the replacement was created from
+	ConnectorHash string `json:"connectorHash,omitempty"`
+	// StartedAt is when the replacement was created
+	StartedAt metav1.Time `json:"startedAt"`
+	// VaultLeases records the leases of the dynamic Vault credentials the replacement uses
+	VaultLeases []VaultLease `json:"vaultLeases,omitempty"`
+}
+
+// ErrorStatus describes a reconcile error for automation
+type ErrorStatus struct {
+	// Code identifies the kind of error, such as VAULT_KEY_NOT_FOUND, FIVETRAN_RATE_LIMITED or
+	// SCHEMA_MISMATCH. Codes are stable across releases.
+	Code string `json:"code"`
+	// Message is the error message, with resolved secrets masked
+	Message string `json:"message,omitempty"`
+	// Time is when the error occurred
+	Time metav1.Time `json:"time"`
+}
+
+// SchemaSummary counts the schemas, tables and hashed columns of a connector's schema in Fivetran
+type SchemaSummary struct {
+	// EnabledSchemas is the number of schemas that are synced
+	EnabledSchemas int `json:"enabledSchemas"`
+	// DisabledSchemas is the number of schemas that are not synced
+	DisabledSchemas int `json:"disabledSchemas"`
+	// EnabledTables is the number of enabled tables, including those of disabled schemas
+	EnabledTables int `json:"enabledTables"`
+	// DisabledTables is the number of disabled tables
+	DisabledTables int `json:"disabledTables"`
+	// HashedColumns is the number of columns whose values are hashed
+	HashedColumns int `json:"hashedColumns"`
+}
+
+// SetupTestResult is the result of one Fivetran setup test
+type SetupTestResult struct {
+	// Title names the test, such as the connectivity, permission or certificate check
+	Title string `json:"title"`
+	// Status is PASSED, SKIPPED, WARNING, FAILED or JOB_FAILED
+	Status string `json:"status"`
+	// Message explains a test that did not pass
+	Message string `json:"message,omitempty"`
+	// LastRunTime is when the test last ran
+	LastRunTime metav1.Time `json:"lastRunTime"`
+}
+
+// SyncError describes a failed Fivetran sync
+type SyncError struct {
+	// Message is what Fivetran reported about the failure, from the connector's tasks and warnings
+	Message string `json:"message,omitempty"`
+	// FailedAt is when the sync failed
+	FailedAt metav1.Time `json:"failedAt"`
+}
+
+// VaultSecretVersion identifies the version of a Vault KV v2 secret that was resolved
+type VaultSecretVersion struct {
+	// Mount is the KV mount the secret was read from
+	Mount string `json:"mount"`
+	// Path is the secret path within the mount
+	Path string `json:"path"`
+	// Version is the resolved secret version
+	Version int `json:"version"`
+	// Pinned reports whether the version was pinned in the reference
+	Pinned bool `json:"pinned,omitempty"`
+}
+
+// VaultLease tracks the lease of dynamic credentials issued by Vault
+type VaultLease struct {
+	// Path is the Vault path the credentials were read from
+	Path string `json:"path"`
+	// LeaseID is the ID of the lease
+	LeaseID string `json:"leaseId"`
+	// LeaseDuration is the lease TTL in seconds granted when the credentials were issued
+	LeaseDuration int `json:"leaseDuration"`
+	// Renewable reports whether the lease can be renewed
+	Renewable bool `json:"renewable,omitempty"`
+	// ExpireTime is when the lease expires unless renewed
+	ExpireTime metav1.Time `json:"expireTime"`
+	// LastRenewTime is when the lease was last renewed
+	LastRenewTime *metav1.Time `json:"lastRenewTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 
 // FivetranConnector is the Schema for the fivetranconnectors API.
+// +kubebuilder:printcolumn:name="Service",type=string,JSONPath=`.spec.connector.service`,priority=0
+// +kubebuilder:printcolumn:name="Group",type=string,JSONPath=`.spec.connector.group_id`,priority=1
+// +kubebuilder:printcolumn:name="Paused",type=boolean,JSONPath=`.spec.connector.paused`,priority=0
 // +kubebuilder:printcolumn:name="Connector",type=string,JSONPath=`.status.conditions[?(@.type=="ConnectorReady")].status`,priority=0
 // +kubebuilder:printcolumn:name="ConnectorURL",type=string,JSONPath=`.status.connectorUrl`,priority=0
 // +kubebuilder:printcolumn:name="SetupTests",type=string,JSONPath=`.status.conditions[?(@.type=="SetupTestReady")].status`,priority=1
 // +kubebuilder:printcolumn:name="Schema",type=string,JSONPath=`.status.conditions[?(@.type=="SchemaReady")].status`,priority=1
 // +kubebuilder:printcolumn:name="ConnectorID",type=string,JSONPath=`.status.connectorId`,priority=1
+// +kubebuilder:printcolumn:name="SyncState",type=string,JSONPath=`.status.syncState`,priority=0
+// +kubebuilder:printcolumn:name="Warnings",type=integer,JSONPath=`.status.setupTestWarnings`,priority=0
+// +kubebuilder:printcolumn:name="LastReconcile",type=date,JSONPath=`.status.lastReconcileTime`,priority=0
+// +kubebuilder:printcolumn:name="LastSynced",type=date,JSONPath=`.status.lastSyncedAt`,priority=0
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
 type FivetranConnector struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FivetranConnectorSummarySpec selects the FivetranConnectors a summary aggregates
+type FivetranConnectorSummarySpec struct {
+	// GroupID limits the summary to the connectors of a Fivetran group; empty summarizes every
+	// FivetranConnector in the namespace
+	// +optional
+	GroupID string `json:"groupId,omitempty"`
+}
+
+// FivetranConnectorSummaryStatus aggregates the health of the summarized connectors
+type FivetranConnectorSummaryStatus struct {
+	// ObservedGeneration is the generation of the spec the status was computed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Connectors is the number of summarized connectors
+	Connectors int `json:"connectors"`
+	// Ready is the number of connectors whose conditions are all True
+	Ready int `json:"ready"`
+	// Failed is the number of connectors with a False condition
+	Failed int `json:"failed"`
+	// Paused is the number of connectors paused in spec.connector.paused
+	Paused int `json:"paused"`
+	// FailedConnectors names the failed connectors
+	FailedConnectors []string `json:"failedConnectors,omitempty"`
+	// WorstCondition is the False condition that has been failing the longest
+	WorstCondition *SummaryCondition `json:"worstCondition,omitempty"`
+}
+
+// SummaryCondition is a condition of one of the summarized connectors
+type SummaryCondition struct {
+	// Connector is the name of the FivetranConnector
+	Connector string `json:"connector"`
+	// Type is the type of the condition, such as ConnectorReady or SetupTestReady
+	Type string `json:"type"`
+	// Reason is the reason of the condition
+	Reason string `json:"reason,omitempty"`
+	// Message is the message of the condition
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is when the condition turned False
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// FivetranConnectorSummary aggregates the health of the FivetranConnectors in its namespace.
+// +kubebuilder:printcolumn:name="Group",type=string,JSONPath=`.spec.groupId`,priority=0
+// +kubebuilder:printcolumn:name="Connectors",type=integer,JSONPath=`.status.connectors`,priority=0
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.ready`,priority=0
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failed`,priority=0
+// +kubebuilder:printcolumn:name="Paused",type=integer,JSONPath=`.status.paused`,priority=0
+// +kubebuilder:printcolumn:name="Worst",type=string,JSONPath=`.status.worstCondition.connector`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
+type FivetranConnectorSummary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FivetranConnectorSummarySpec   `json:"spec,omitempty"`
+	Status FivetranConnectorSummaryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FivetranConnectorSummaryList contains a list of FivetranConnectorSummary.
+type FivetranConnectorSummaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FivetranConnectorSummary `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FivetranConnectorSummary{}, &FivetranConnectorSummaryList{})
+}
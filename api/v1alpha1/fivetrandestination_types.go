@@ -0,0 +1,111 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// FivetranDestinationSpec defines the desired state of FivetranDestination
+type FivetranDestinationSpec struct {
+	// +kubebuilder:validation:Required
+	Destination Destination `json:"destination"`
+}
+
+// Destination defines the configuration and settings of a FivetranDestination
+type Destination struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// The unique identifier for the group the destination belongs to
+	GroupID string `json:"group_id"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// The destination type within the Fivetran system, such as snowflake or big_query
+	Service string `json:"service"`
+	// Data processing location, such as GCP_US_EAST4
+	Region string `json:"region,omitempty"`
+	// Determines the time zone for the Fivetran sync schedule, such as -5 or +3
+	TimeZoneOffset string `json:"time_zone_offset,omitempty"`
+
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// The destination configuration parameters. String values may be secret references, resolved
+	// like the config of a FivetranConnector.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+
+	// Specifies whether the setup tests should be run when the destination is created or updated.
+	// The default value is TRUE.
+	// +kubebuilder:default=true
+	RunSetupTests *bool `json:"run_setup_tests,omitempty"`
+	// Specifies whether we should trust the certificate automatically. The default value is FALSE.
+	TrustCertificates *bool `json:"trust_certificates,omitempty"`
+	// Specifies whether we should trust the SSH fingerprint automatically. The default value is FALSE.
+	TrustFingerprints *bool `json:"trust_fingerprints,omitempty"`
+	// Shift my UTC offset with daylight savings time
+	DaylightSavingTimeEnabled *bool `json:"daylight_saving_time_enabled,omitempty"`
+
+	// Networking
+	// +kubebuilder:validation:Enum=Directly;PrivateLink;SshTunnel;ProxyAgent
+	NetworkingMethod string `json:"networking_method,omitempty"`
+	// The unique identifier for the self-served private link that is used by the destination
+	PrivateLinkID string `json:"private_link_id,omitempty"`
+	// The unique identifier for the hybrid deployment agent within the Fivetran system.
+	HybridDeploymentAgentID string `json:"hybrid_deployment_agent_id,omitempty"`
+}
+
+// FivetranDestinationStatus defines the observed state of FivetranDestination.
+type FivetranDestinationStatus struct {
+	// DestinationID is the ID of the created Fivetran destination
+	DestinationID string `json:"destinationId,omitempty"`
+	// ObservedGeneration is the generation of the spec last reconciled
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// SetupTests holds the result of each test of the last setup test run
+	SetupTests []SetupTestResult `json:"setupTests,omitempty"`
+	// Conditions represent the underlying resource state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// FivetranDestination is the Schema for the fivetrandestinations API.
+// +kubebuilder:printcolumn:name="Service",type=string,JSONPath=`.spec.destination.service`,priority=0
+// +kubebuilder:printcolumn:name="Group",type=string,JSONPath=`.spec.destination.group_id`,priority=0
+// +kubebuilder:printcolumn:name="Destination",type=string,JSONPath=`.status.conditions[?(@.type=="DestinationReady")].status`,priority=0
+// +kubebuilder:printcolumn:name="SetupTests",type=string,JSONPath=`.status.conditions[?(@.type=="SetupTestReady")].status`,priority=0
+// +kubebuilder:printcolumn:name="DestinationID",type=string,JSONPath=`.status.destinationId`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
+type FivetranDestination struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FivetranDestinationSpec   `json:"spec,omitempty"`
+	Status FivetranDestinationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FivetranDestinationList contains a list of FivetranDestination.
+type FivetranDestinationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FivetranDestination `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FivetranDestination{}, &FivetranDestinationList{})
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FivetranGroupDefaultsSpec defines the defaults of the FivetranConnectors of a Fivetran group
+type FivetranGroupDefaultsSpec struct {
+	// GroupID is the Fivetran group whose FivetranConnectors in this namespace receive the defaults
+	// +kubebuilder:validation:MinLength=1
+	GroupID string `json:"groupId"`
+	// Connector holds the defaults of the fields left unset in spec.connector
+	Connector ConnectorDefaults `json:"connector"`
+}
+
+// ConnectorDefaults are defaults for the sync, trust and data delay settings of spec.connector
+type ConnectorDefaults struct {
+	// +kubebuilder:validation:Enum=1;5;15;30;60;120;180;360;480;720;1440
+	// The connection sync frequency in minutes
+	SyncFrequency int `json:"sync_frequency,omitempty"`
+	// +kubebuilder:validation:Enum=auto;manual
+	// The connection schedule configuration type. Supported values: auto, manual
+	ScheduleType string `json:"schedule_type,omitempty"`
+	// Specifies whether we should trust the certificate automatically
+	TrustCertificates *bool `json:"trust_certificates,omitempty"`
+	// Specifies whether we should trust the SSH fingerprint automatically
+	TrustFingerprints *bool `json:"trust_fingerprints,omitempty"`
+	// +kubebuilder:validation:Enum=LOW;NORMAL;HIGH;CUSTOM;SYNC_FREQUENCY
+	// The level of data delay notification threshold.
+	DataDelaySensitivity string `json:"data_delay_sensitivity,omitempty"`
+	// Custom sync delay notification threshold in minutes, applied to connectors whose
+	// data_delay_sensitivity is CUSTOM
+	DataDelayThreshold int `json:"data_delay_threshold,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FivetranGroupDefaults holds the defaults applied to the FivetranConnectors of a Fivetran group in
+// its namespace when they are created or updated.
+// +kubebuilder:printcolumn:name="Group",type=string,JSONPath=`.spec.groupId`,priority=0
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
+type FivetranGroupDefaults struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec FivetranGroupDefaultsSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FivetranGroupDefaultsList contains a list of FivetranGroupDefaults.
+type FivetranGroupDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FivetranGroupDefaults `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FivetranGroupDefaults{}, &FivetranGroupDefaultsList{})
+}
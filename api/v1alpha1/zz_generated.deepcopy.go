@@ -40,6 +40,36 @@ func (in *ColumnObject) DeepCopy() *ColumnObject {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectCard) DeepCopyInto(out *ConnectCard) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectCard.
+func (in *ConnectCard) DeepCopy() *ConnectCard {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectCard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionDetails) DeepCopyInto(out *ConnectionDetails) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionDetails.
+func (in *ConnectionDetails) DeepCopy() *ConnectionDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Connector) DeepCopyInto(out *Connector) {
 	*out = *in
@@ -90,6 +120,54 @@ func (in *Connector) DeepCopy() *Connector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectorDefaults) DeepCopyInto(out *ConnectorDefaults) {
+	*out = *in
+	if in.TrustCertificates != nil {
+		in, out := &in.TrustCertificates, &out.TrustCertificates
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrustFingerprints != nil {
+		in, out := &in.TrustFingerprints, &out.TrustFingerprints
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectorDefaults.
+func (in *ConnectorDefaults) DeepCopy() *ConnectorDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectorDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectorReplacement) DeepCopyInto(out *ConnectorReplacement) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.VaultLeases != nil {
+		in, out := &in.VaultLeases, &out.VaultLeases
+		*out = make([]VaultLease, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectorReplacement.
+func (in *ConnectorReplacement) DeepCopy() *ConnectorReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectorReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectorSchemaConfig) DeepCopyInto(out *ConnectorSchemaConfig) {
 	*out = *in
@@ -121,6 +199,62 @@ func (in *ConnectorSchemaConfig) DeepCopy() *ConnectorSchemaConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Destination) DeepCopyInto(out *Destination) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunSetupTests != nil {
+		in, out := &in.RunSetupTests, &out.RunSetupTests
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrustCertificates != nil {
+		in, out := &in.TrustCertificates, &out.TrustCertificates
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrustFingerprints != nil {
+		in, out := &in.TrustFingerprints, &out.TrustFingerprints
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DaylightSavingTimeEnabled != nil {
+		in, out := &in.DaylightSavingTimeEnabled, &out.DaylightSavingTimeEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Destination.
+func (in *Destination) DeepCopy() *Destination {
+	if in == nil {
+		return nil
+	}
+	out := new(Destination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorStatus) DeepCopyInto(out *ErrorStatus) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorStatus.
+func (in *ErrorStatus) DeepCopy() *ErrorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FivetranConnector) DeepCopyInto(out *FivetranConnector) {
 	*out = *in
@@ -189,6 +323,26 @@ func (in *FivetranConnectorSpec) DeepCopyInto(out *FivetranConnectorSpec) {
 		*out = new(ConnectorSchemaConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VaultRef != nil {
+		in, out := &in.VaultRef, &out.VaultRef
+		*out = new(VaultRef)
+		**out = **in
+	}
+	if in.ConnectCard != nil {
+		in, out := &in.ConnectCard, &out.ConnectCard
+		*out = new(ConnectCard)
+		**out = **in
+	}
+	if in.ConnectionDetails != nil {
+		in, out := &in.ConnectionDetails, &out.ConnectionDetails
+		*out = new(ConnectionDetails)
+		**out = **in
+	}
+	if in.SLA != nil {
+		in, out := &in.SLA, &out.SLA
+		*out = new(SLA)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranConnectorSpec.
@@ -211,6 +365,53 @@ func (in *FivetranConnectorStatus) DeepCopyInto(out *FivetranConnectorStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.VaultSecretVersions != nil {
+		in, out := &in.VaultSecretVersions, &out.VaultSecretVersions
+		*out = make([]VaultSecretVersion, len(*in))
+		copy(*out, *in)
+	}
+	if in.VaultLeases != nil {
+		in, out := &in.VaultLeases, &out.VaultLeases
+		*out = make([]VaultLease, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastSyncError != nil {
+		in, out := &in.LastSyncError, &out.LastSyncError
+		*out = new(SyncError)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SetupTests != nil {
+		in, out := &in.SetupTests, &out.SetupTests
+		*out = make([]SetupTestResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncedAt != nil {
+		in, out := &in.LastSyncedAt, &out.LastSyncedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.SchemaSummary != nil {
+		in, out := &in.SchemaSummary, &out.SchemaSummary
+		*out = new(SchemaSummary)
+		**out = **in
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(ErrorStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(ConnectorReplacement)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranConnectorStatus.
@@ -223,6 +424,298 @@ func (in *FivetranConnectorStatus) DeepCopy() *FivetranConnectorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranConnectorSummary) DeepCopyInto(out *FivetranConnectorSummary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranConnectorSummary.
+func (in *FivetranConnectorSummary) DeepCopy() *FivetranConnectorSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranConnectorSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FivetranConnectorSummary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranConnectorSummaryList) DeepCopyInto(out *FivetranConnectorSummaryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FivetranConnectorSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranConnectorSummaryList.
+func (in *FivetranConnectorSummaryList) DeepCopy() *FivetranConnectorSummaryList {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranConnectorSummaryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FivetranConnectorSummaryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranConnectorSummarySpec) DeepCopyInto(out *FivetranConnectorSummarySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranConnectorSummarySpec.
+func (in *FivetranConnectorSummarySpec) DeepCopy() *FivetranConnectorSummarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranConnectorSummarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranConnectorSummaryStatus) DeepCopyInto(out *FivetranConnectorSummaryStatus) {
+	*out = *in
+	if in.FailedConnectors != nil {
+		in, out := &in.FailedConnectors, &out.FailedConnectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorstCondition != nil {
+		in, out := &in.WorstCondition, &out.WorstCondition
+		*out = new(SummaryCondition)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranConnectorSummaryStatus.
+func (in *FivetranConnectorSummaryStatus) DeepCopy() *FivetranConnectorSummaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranConnectorSummaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranDestination) DeepCopyInto(out *FivetranDestination) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranDestination.
+func (in *FivetranDestination) DeepCopy() *FivetranDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FivetranDestination) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranDestinationList) DeepCopyInto(out *FivetranDestinationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FivetranDestination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranDestinationList.
+func (in *FivetranDestinationList) DeepCopy() *FivetranDestinationList {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranDestinationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FivetranDestinationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranDestinationSpec) DeepCopyInto(out *FivetranDestinationSpec) {
+	*out = *in
+	in.Destination.DeepCopyInto(&out.Destination)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranDestinationSpec.
+func (in *FivetranDestinationSpec) DeepCopy() *FivetranDestinationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranDestinationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranDestinationStatus) DeepCopyInto(out *FivetranDestinationStatus) {
+	*out = *in
+	if in.SetupTests != nil {
+		in, out := &in.SetupTests, &out.SetupTests
+		*out = make([]SetupTestResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranDestinationStatus.
+func (in *FivetranDestinationStatus) DeepCopy() *FivetranDestinationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranDestinationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranGroupDefaults) DeepCopyInto(out *FivetranGroupDefaults) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranGroupDefaults.
+func (in *FivetranGroupDefaults) DeepCopy() *FivetranGroupDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranGroupDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FivetranGroupDefaults) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranGroupDefaultsList) DeepCopyInto(out *FivetranGroupDefaultsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FivetranGroupDefaults, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranGroupDefaultsList.
+func (in *FivetranGroupDefaultsList) DeepCopy() *FivetranGroupDefaultsList {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranGroupDefaultsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FivetranGroupDefaultsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranGroupDefaultsSpec) DeepCopyInto(out *FivetranGroupDefaultsSpec) {
+	*out = *in
+	in.Connector.DeepCopyInto(&out.Connector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranGroupDefaultsSpec.
+func (in *FivetranGroupDefaultsSpec) DeepCopy() *FivetranGroupDefaultsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranGroupDefaultsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SLA) DeepCopyInto(out *SLA) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLA.
+func (in *SLA) DeepCopy() *SLA {
+	if in == nil {
+		return nil
+	}
+	out := new(SLA)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchemaObject) DeepCopyInto(out *SchemaObject) {
 	*out = *in
@@ -254,6 +747,69 @@ func (in *SchemaObject) DeepCopy() *SchemaObject {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaSummary) DeepCopyInto(out *SchemaSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaSummary.
+func (in *SchemaSummary) DeepCopy() *SchemaSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetupTestResult) DeepCopyInto(out *SetupTestResult) {
+	*out = *in
+	in.LastRunTime.DeepCopyInto(&out.LastRunTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetupTestResult.
+func (in *SetupTestResult) DeepCopy() *SetupTestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SetupTestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SummaryCondition) DeepCopyInto(out *SummaryCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SummaryCondition.
+func (in *SummaryCondition) DeepCopy() *SummaryCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(SummaryCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncError) DeepCopyInto(out *SyncError) {
+	*out = *in
+	in.FailedAt.DeepCopyInto(&out.FailedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncError.
+func (in *SyncError) DeepCopy() *SyncError {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncError)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TableObject) DeepCopyInto(out *TableObject) {
 	*out = *in
@@ -284,3 +840,53 @@ func (in *TableObject) DeepCopy() *TableObject {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultLease) DeepCopyInto(out *VaultLease) {
+	*out = *in
+	in.ExpireTime.DeepCopyInto(&out.ExpireTime)
+	if in.LastRenewTime != nil {
+		in, out := &in.LastRenewTime, &out.LastRenewTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultLease.
+func (in *VaultLease) DeepCopy() *VaultLease {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultLease)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultRef) DeepCopyInto(out *VaultRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultRef.
+func (in *VaultRef) DeepCopy() *VaultRef {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecretVersion) DeepCopyInto(out *VaultSecretVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretVersion.
+func (in *VaultSecretVersion) DeepCopy() *VaultSecretVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecretVersion)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// ConvertTo converts this FivetranConnector to the Hub version (v1alpha1).
+func (src *FivetranConnector) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*operatorv1alpha1.FivetranConnector)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec = operatorv1alpha1.FivetranConnectorSpec{
+		Connector:        convertConnectorToHub(src.Spec.Connector),
+		ConnectorSchemas: convertSchemaConfigToHub(src.Spec.SchemaConfig),
+		DeletionPolicy:   src.Spec.DeletionPolicy,
+	}
+	if src.Spec.VaultRef != nil {
+		vaultRef := operatorv1alpha1.VaultRef(*src.Spec.VaultRef)
+		dst.Spec.VaultRef = &vaultRef
+	}
+	if src.Spec.ConnectCard != nil {
+		connectCard := operatorv1alpha1.ConnectCard(*src.Spec.ConnectCard)
+		dst.Spec.ConnectCard = &connectCard
+	}
+	if src.Spec.ConnectionDetails != nil {
+		connectionDetails := operatorv1alpha1.ConnectionDetails(*src.Spec.ConnectionDetails)
+		dst.Spec.ConnectionDetails = &connectionDetails
+	}
+	if src.Spec.SLA != nil {
+		sla := operatorv1alpha1.SLA(*src.Spec.SLA)
+		dst.Spec.SLA = &sla
+	}
+
+	dst.Status = convertStatusToHub(src.Status)
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version.
+func (dst *FivetranConnector) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*operatorv1alpha1.FivetranConnector)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec = FivetranConnectorSpec{
+		Connector:      convertConnectorFromHub(src.Spec.Connector),
+		SchemaConfig:   convertSchemaConfigFromHub(src.Spec.ConnectorSchemas),
+		DeletionPolicy: src.Spec.DeletionPolicy,
+	}
+	if src.Spec.VaultRef != nil {
+		vaultRef := VaultRef(*src.Spec.VaultRef)
+		dst.Spec.VaultRef = &vaultRef
+	}
+	if src.Spec.ConnectCard != nil {
+		connectCard := ConnectCard(*src.Spec.ConnectCard)
+		dst.Spec.ConnectCard = &connectCard
+	}
+	if src.Spec.ConnectionDetails != nil {
+		connectionDetails := ConnectionDetails(*src.Spec.ConnectionDetails)
+		dst.Spec.ConnectionDetails = &connectionDetails
+	}
+	if src.Spec.SLA != nil {
+		sla := SLA(*src.Spec.SLA)
+		dst.Spec.SLA = &sla
+	}
+
+	dst.Status = convertStatusFromHub(src.Status)
+	return nil
+}
+
+// convertConnectorToHub flattens the structured connector settings into v1alpha1 fields
+func convertConnectorToHub(src Connector) operatorv1alpha1.Connector {
+	paused := src.Paused
+	dst := operatorv1alpha1.Connector{
+		GroupID:         src.GroupID,
+		Service:         src.Service,
+		Auth:            src.Auth,
+		Config:          src.Config,
+		Paused:          &paused,
+		RunSetupTests:   src.RunSetupTests,
+		PauseAfterTrial: src.PauseAfterTrial,
+	}
+	if src.Schedule != nil {
+		dst.ScheduleType = src.Schedule.Type
+		dst.SyncFrequency = src.Schedule.SyncFrequency
+		dst.DailySyncTime = src.Schedule.DailySyncTime
+	}
+	if src.Trust != nil {
+		dst.TrustCertificates = src.Trust.Certificates
+		dst.TrustFingerprints = src.Trust.Fingerprints
+	}
+	if src.DataDelay != nil {
+		dst.DataDelaySensitivity = src.DataDelay.Sensitivity
+		dst.DataDelayThreshold = src.DataDelay.Threshold
+	}
+	if src.Networking != nil {
+		dst.NetworkingMethod = src.Networking.Method
+		dst.ProxyAgentID = src.Networking.ProxyAgentID
+		dst.PrivateLinkID = src.Networking.PrivateLinkID
+		dst.HybridDeploymentAgentID = src.Networking.HybridDeploymentAgentID
+	}
+	return dst
+}
+
+// convertConnectorFromHub groups the v1alpha1 connector settings, leaving out groups with no
+// field set
+func convertConnectorFromHub(src operatorv1alpha1.Connector) Connector {
+	dst := Connector{
+		GroupID:         src.GroupID,
+		Service:         src.Service,
+		Auth:            src.Auth,
+		Config:          src.Config,
+		Paused:          src.Paused != nil && *src.Paused,
+		RunSetupTests:   src.RunSetupTests,
+		PauseAfterTrial: src.PauseAfterTrial,
+	}
+	if src.ScheduleType != "" || src.SyncFrequency != 0 || src.DailySyncTime != "" {
+		dst.Schedule = &Schedule{
+			Type:          src.ScheduleType,
+			SyncFrequency: src.SyncFrequency,
+			DailySyncTime: src.DailySyncTime,
+		}
+	}
+	if src.TrustCertificates != nil || src.TrustFingerprints != nil {
+		dst.Trust = &Trust{
+			Certificates: src.TrustCertificates,
+			Fingerprints: src.TrustFingerprints,
+		}
+	}
+	if src.DataDelaySensitivity != "" || src.DataDelayThreshold != 0 {
+		dst.DataDelay = &DataDelay{
+			Sensitivity: src.DataDelaySensitivity,
+			Threshold:   src.DataDelayThreshold,
+		}
+	}
+	if src.NetworkingMethod != "" || src.ProxyAgentID != "" || src.PrivateLinkID != "" || src.HybridDeploymentAgentID != "" {
+		dst.Networking = &Networking{
+			Method:                  src.NetworkingMethod,
+			ProxyAgentID:            src.ProxyAgentID,
+			PrivateLinkID:           src.PrivateLinkID,
+			HybridDeploymentAgentID: src.HybridDeploymentAgentID,
+		}
+	}
+	return dst
+}
+
+// convertSchemaConfigToHub converts the schema configuration to v1alpha1
+func convertSchemaConfigToHub(src *SchemaConfig) *operatorv1alpha1.ConnectorSchemaConfig {
+	if src == nil {
+		return nil
+	}
+	dst := &operatorv1alpha1.ConnectorSchemaConfig{SchemaChangeHandling: src.SchemaChangeHandling}
+	if src.Schemas != nil {
+		dst.Schemas = make(map[string]*operatorv1alpha1.SchemaObject, len(src.Schemas))
+	}
+	for schemaName, schema := range src.Schemas {
+		if schema == nil {
+			dst.Schemas[schemaName] = nil
+			continue
+		}
+		dstSchema := &operatorv1alpha1.SchemaObject{Enabled: schema.Enabled}
+		if schema.Tables != nil {
+			dstSchema.Tables = make(map[string]*operatorv1alpha1.TableObject, len(schema.Tables))
+		}
+		for tableName, table := range schema.Tables {
+			if table == nil {
+				dstSchema.Tables[tableName] = nil
+				continue
+			}
+			dstTable := &operatorv1alpha1.TableObject{Enabled: table.Enabled, SyncMode: table.SyncMode}
+			if table.Columns != nil {
+				dstTable.Columns = make(map[string]*operatorv1alpha1.ColumnObject, len(table.Columns))
+			}
+			for columnName, column := range table.Columns {
+				if column == nil {
+					dstTable.Columns[columnName] = nil
+					continue
+				}
+				dstColumn := operatorv1alpha1.ColumnObject(*column)
+				dstTable.Columns[columnName] = &dstColumn
+			}
+			dstSchema.Tables[tableName] = dstTable
+		}
+		dst.Schemas[schemaName] = dstSchema
+	}
+	return dst
+}
+
+// convertSchemaConfigFromHub converts the v1alpha1 schema configuration
+func convertSchemaConfigFromHub(src *operatorv1alpha1.ConnectorSchemaConfig) *SchemaConfig {
+	if src == nil {
+		return nil
+	}
+	dst := &SchemaConfig{SchemaChangeHandling: src.SchemaChangeHandling}
+	if src.Schemas != nil {
+		dst.Schemas = make(map[string]*Schema, len(src.Schemas))
+	}
+	for schemaName, schema := range src.Schemas {
+		if schema == nil {
+			dst.Schemas[schemaName] = nil
+			continue
+		}
+		dstSchema := &Schema{Enabled: schema.Enabled}
+		if schema.Tables != nil {
+			dstSchema.Tables = make(map[string]*Table, len(schema.Tables))
+		}
+		for tableName, table := range schema.Tables {
+			if table == nil {
+				dstSchema.Tables[tableName] = nil
+				continue
+			}
+			dstTable := &Table{Enabled: table.Enabled, SyncMode: table.SyncMode}
+			if table.Columns != nil {
+				dstTable.Columns = make(map[string]*Column, len(table.Columns))
+			}
+			for columnName, column := range table.Columns {
+				if column == nil {
+					dstTable.Columns[columnName] = nil
+					continue
+				}
+				dstColumn := Column(*column)
+				dstTable.Columns[columnName] = &dstColumn
+			}
+			dstSchema.Tables[tableName] = dstTable
+		}
+		dst.Schemas[schemaName] = dstSchema
+	}
+	return dst
+}
+
+// convertStatusToHub converts the status, which has the same fields in both versions
+func convertStatusToHub(src FivetranConnectorStatus) operatorv1alpha1.FivetranConnectorStatus {
+	dst := operatorv1alpha1.FivetranConnectorStatus{
+		ConnectorURL:      src.ConnectorURL,
+		ConnectorID:       src.ConnectorID,
+		ConnectCardURI:    src.ConnectCardURI,
+		SSHPublicKey:      src.SSHPublicKey,
+		Conditions:        src.Conditions,
+		SetupTestWarnings: src.SetupTestWarnings,
+		SyncState:         src.SyncState,
+		LastReconcileTime: src.LastReconcileTime,
+		LastSyncedAt:      src.LastSyncedAt,
+	}
+	for _, version := range src.VaultSecretVersions {
+		dst.VaultSecretVersions = append(dst.VaultSecretVersions, operatorv1alpha1.VaultSecretVersion(version))
+	}
+	for _, lease := range src.VaultLeases {
+		dst.VaultLeases = append(dst.VaultLeases, operatorv1alpha1.VaultLease(lease))
+	}
+	for _, result := range src.SetupTests {
+		dst.SetupTests = append(dst.SetupTests, operatorv1alpha1.SetupTestResult(result))
+	}
+	if src.LastSyncError != nil {
+		lastSyncError := operatorv1alpha1.SyncError(*src.LastSyncError)
+		dst.LastSyncError = &lastSyncError
+	}
+	if src.SchemaSummary != nil {
+		schemaSummary := operatorv1alpha1.SchemaSummary(*src.SchemaSummary)
+		dst.SchemaSummary = &schemaSummary
+	}
+	if src.LastError != nil {
+		lastError := operatorv1alpha1.ErrorStatus(*src.LastError)
+		dst.LastError = &lastError
+	}
+	if src.Replacement != nil {
+		dst.Replacement = &operatorv1alpha1.ConnectorReplacement{
+			ConnectorID:   src.Replacement.ConnectorID,
+			ConnectorHash: src.Replacement.ConnectorHash,
+			StartedAt:     src.Replacement.StartedAt,
+		}
+		for _, lease := range src.Replacement.VaultLeases {
+			dst.Replacement.VaultLeases = append(dst.Replacement.VaultLeases, operatorv1alpha1.VaultLease(lease))
+		}
+	}
+	return dst
+}
+
+// convertStatusFromHub converts the v1alpha1 status
+func convertStatusFromHub(src operatorv1alpha1.FivetranConnectorStatus) FivetranConnectorStatus {
+	dst := FivetranConnectorStatus{
+		ConnectorURL:      src.ConnectorURL,
+		ConnectorID:       src.ConnectorID,
+		ConnectCardURI:    src.ConnectCardURI,
+		SSHPublicKey:      src.SSHPublicKey,
+		Conditions:        src.Conditions,
+		SetupTestWarnings: src.SetupTestWarnings,
+		SyncState:         src.SyncState,
+		LastReconcileTime: src.LastReconcileTime,
+		LastSyncedAt:      src.LastSyncedAt,
+	}
+	for _, version := range src.VaultSecretVersions {
+		dst.VaultSecretVersions = append(dst.VaultSecretVersions, VaultSecretVersion(version))
+	}
+	for _, lease := range src.VaultLeases {
+		dst.VaultLeases = append(dst.VaultLeases, VaultLease(lease))
+	}
+	for _, result := range src.SetupTests {
+		dst.SetupTests = append(dst.SetupTests, SetupTestResult(result))
+	}
+	if src.LastSyncError != nil {
+		lastSyncError := SyncError(*src.LastSyncError)
+		dst.LastSyncError = &lastSyncError
+	}
+	if src.SchemaSummary != nil {
+		schemaSummary := SchemaSummary(*src.SchemaSummary)
+		dst.SchemaSummary = &schemaSummary
+	}
+	if src.LastError != nil {
+		lastError := ErrorStatus(*src.LastError)
+		dst.LastError = &lastError
+	}
+	if src.Replacement != nil {
+		dst.Replacement = &ConnectorReplacement{
+			ConnectorID:   src.Replacement.ConnectorID,
+			ConnectorHash: src.Replacement.ConnectorHash,
+			StartedAt:     src.Replacement.StartedAt,
+		}
+		for _, lease := range src.Replacement.VaultLeases {
+			dst.Replacement.VaultLeases = append(dst.Replacement.VaultLeases, VaultLease(lease))
+		}
+	}
+	return dst
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	hub := &operatorv1alpha1.FivetranConnector{
+		ObjectMeta: metav1.ObjectMeta{Name: "users", Namespace: "connectors"},
+		Spec: operatorv1alpha1.FivetranConnectorSpec{
+			Connector: operatorv1alpha1.Connector{
+				GroupID:              "group_a",
+				Service:              "postgres",
+				Config:               &runtime.RawExtension{Raw: []byte(`{"schema":"users"}`)},
+				Paused:               boolPtr(true),
+				RunSetupTests:        boolPtr(true),
+				ScheduleType:         "auto",
+				SyncFrequency:        1440,
+				DailySyncTime:        "03:00",
+				TrustFingerprints:    boolPtr(false),
+				DataDelaySensitivity: "CUSTOM",
+				DataDelayThreshold:   120,
+				NetworkingMethod:     "ProxyAgent",
+				ProxyAgentID:         "agent_a",
+			},
+			ConnectorSchemas: &operatorv1alpha1.ConnectorSchemaConfig{
+				SchemaChangeHandling: "BLOCK_ALL",
+				Schemas: map[string]*operatorv1alpha1.SchemaObject{
+					"public": {Enabled: true, Tables: map[string]*operatorv1alpha1.TableObject{
+						"users": {Enabled: true, SyncMode: "HISTORY", Columns: map[string]*operatorv1alpha1.ColumnObject{
+							"email": {Enabled: true, Hashed: true, MaskingAlgorithm: "HASHED"},
+						}},
+					}},
+				},
+			},
+			VaultRef:       &operatorv1alpha1.VaultRef{SecretName: "vault", Role: "connectors"},
+			DeletionPolicy: operatorv1alpha1.DeletionPolicyRetain,
+			SLA:            &operatorv1alpha1.SLA{MaxDataDelayMinutes: 120},
+		},
+		Status: operatorv1alpha1.FivetranConnectorStatus{
+			ConnectorID:   "connector_a",
+			SyncState:     "scheduled",
+			VaultLeases:   []operatorv1alpha1.VaultLease{{Path: "database/creds/users", LeaseID: "lease_a"}},
+			LastSyncError: &operatorv1alpha1.SyncError{Message: "timeout"},
+			Replacement: &operatorv1alpha1.ConnectorReplacement{
+				ConnectorID: "connector_b",
+				VaultLeases: []operatorv1alpha1.VaultLease{{Path: "database/creds/users", LeaseID: "lease_b"}},
+			},
+		},
+	}
+
+	spoke := &FivetranConnector{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	connector := spoke.Spec.Connector
+	if !connector.Paused || connector.Schedule == nil || connector.Schedule.SyncFrequency != 1440 ||
+		connector.Trust == nil || *connector.Trust.Fingerprints || connector.Trust.Certificates != nil ||
+		connector.DataDelay == nil || connector.DataDelay.Threshold != 120 ||
+		connector.Networking == nil || connector.Networking.ProxyAgentID != "agent_a" {
+		t.Errorf("unexpected v1beta1 connector: %+v", connector)
+	}
+	if spoke.Spec.SchemaConfig.Schemas["public"].Tables["users"].Columns["email"].MaskingAlgorithm != "HASHED" {
+		t.Errorf("unexpected v1beta1 schema config: %+v", spoke.Spec.SchemaConfig)
+	}
+
+	got := &operatorv1alpha1.FivetranConnector{}
+	if err := spoke.ConvertTo(got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !equality.Semantic.DeepEqual(hub, got) {
+		t.Errorf("round trip changed the connector:\nwant %+v\ngot  %+v", hub, got)
+	}
+}
+
+func TestConversionOmitsEmptyGroups(t *testing.T) {
+	hub := &operatorv1alpha1.FivetranConnector{
+		Spec: operatorv1alpha1.FivetranConnectorSpec{
+			Connector: operatorv1alpha1.Connector{GroupID: "group_a", Service: "postgres"},
+		},
+	}
+	spoke := &FivetranConnector{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	connector := spoke.Spec.Connector
+	if connector.Paused || connector.Schedule != nil || connector.Trust != nil || connector.DataDelay != nil ||
+		connector.Networking != nil || spoke.Spec.SchemaConfig != nil {
+		t.Errorf("unexpected v1beta1 connector: %+v", connector)
+	}
+
+	got := &operatorv1alpha1.FivetranConnector{}
+	if err := spoke.ConvertTo(got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// v1beta1 has no unset paused state, so an unset v1alpha1 paused comes back as false
+	if got.Spec.Connector.Paused == nil || *got.Spec.Connector.Paused {
+		t.Errorf("expected paused to be false, got %v", got.Spec.Connector.Paused)
+	}
+}
@@ -0,0 +1,609 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Column) DeepCopyInto(out *Column) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Column.
+func (in *Column) DeepCopy() *Column {
+	if in == nil {
+		return nil
+	}
+	out := new(Column)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectCard) DeepCopyInto(out *ConnectCard) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectCard.
+func (in *ConnectCard) DeepCopy() *ConnectCard {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectCard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionDetails) DeepCopyInto(out *ConnectionDetails) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionDetails.
+func (in *ConnectionDetails) DeepCopy() *ConnectionDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Connector) DeepCopyInto(out *Connector) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(Schedule)
+		**out = **in
+	}
+	if in.RunSetupTests != nil {
+		in, out := &in.RunSetupTests, &out.RunSetupTests
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PauseAfterTrial != nil {
+		in, out := &in.PauseAfterTrial, &out.PauseAfterTrial
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Trust != nil {
+		in, out := &in.Trust, &out.Trust
+		*out = new(Trust)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataDelay != nil {
+		in, out := &in.DataDelay, &out.DataDelay
+		*out = new(DataDelay)
+		**out = **in
+	}
+	if in.Networking != nil {
+		in, out := &in.Networking, &out.Networking
+		*out = new(Networking)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Connector.
+func (in *Connector) DeepCopy() *Connector {
+	if in == nil {
+		return nil
+	}
+	out := new(Connector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectorReplacement) DeepCopyInto(out *ConnectorReplacement) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.VaultLeases != nil {
+		in, out := &in.VaultLeases, &out.VaultLeases
+		*out = make([]VaultLease, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectorReplacement.
+func (in *ConnectorReplacement) DeepCopy() *ConnectorReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectorReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataDelay) DeepCopyInto(out *DataDelay) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataDelay.
+func (in *DataDelay) DeepCopy() *DataDelay {
+	if in == nil {
+		return nil
+	}
+	out := new(DataDelay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorStatus) DeepCopyInto(out *ErrorStatus) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorStatus.
+func (in *ErrorStatus) DeepCopy() *ErrorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranConnector) DeepCopyInto(out *FivetranConnector) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranConnector.
+func (in *FivetranConnector) DeepCopy() *FivetranConnector {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranConnector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FivetranConnector) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranConnectorList) DeepCopyInto(out *FivetranConnectorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FivetranConnector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranConnectorList.
+func (in *FivetranConnectorList) DeepCopy() *FivetranConnectorList {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranConnectorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FivetranConnectorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranConnectorSpec) DeepCopyInto(out *FivetranConnectorSpec) {
+	*out = *in
+	in.Connector.DeepCopyInto(&out.Connector)
+	if in.SchemaConfig != nil {
+		in, out := &in.SchemaConfig, &out.SchemaConfig
+		*out = new(SchemaConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VaultRef != nil {
+		in, out := &in.VaultRef, &out.VaultRef
+		*out = new(VaultRef)
+		**out = **in
+	}
+	if in.ConnectCard != nil {
+		in, out := &in.ConnectCard, &out.ConnectCard
+		*out = new(ConnectCard)
+		**out = **in
+	}
+	if in.ConnectionDetails != nil {
+		in, out := &in.ConnectionDetails, &out.ConnectionDetails
+		*out = new(ConnectionDetails)
+		**out = **in
+	}
+	if in.SLA != nil {
+		in, out := &in.SLA, &out.SLA
+		*out = new(SLA)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranConnectorSpec.
+func (in *FivetranConnectorSpec) DeepCopy() *FivetranConnectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranConnectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FivetranConnectorStatus) DeepCopyInto(out *FivetranConnectorStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VaultSecretVersions != nil {
+		in, out := &in.VaultSecretVersions, &out.VaultSecretVersions
+		*out = make([]VaultSecretVersion, len(*in))
+		copy(*out, *in)
+	}
+	if in.VaultLeases != nil {
+		in, out := &in.VaultLeases, &out.VaultLeases
+		*out = make([]VaultLease, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastSyncError != nil {
+		in, out := &in.LastSyncError, &out.LastSyncError
+		*out = new(SyncError)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SetupTests != nil {
+		in, out := &in.SetupTests, &out.SetupTests
+		*out = make([]SetupTestResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncedAt != nil {
+		in, out := &in.LastSyncedAt, &out.LastSyncedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.SchemaSummary != nil {
+		in, out := &in.SchemaSummary, &out.SchemaSummary
+		*out = new(SchemaSummary)
+		**out = **in
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(ErrorStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(ConnectorReplacement)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FivetranConnectorStatus.
+func (in *FivetranConnectorStatus) DeepCopy() *FivetranConnectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FivetranConnectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Networking) DeepCopyInto(out *Networking) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Networking.
+func (in *Networking) DeepCopy() *Networking {
+	if in == nil {
+		return nil
+	}
+	out := new(Networking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SLA) DeepCopyInto(out *SLA) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLA.
+func (in *SLA) DeepCopy() *SLA {
+	if in == nil {
+		return nil
+	}
+	out := new(SLA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schema) DeepCopyInto(out *Schema) {
+	*out = *in
+	if in.Tables != nil {
+		in, out := &in.Tables, &out.Tables
+		*out = make(map[string]*Table, len(*in))
+		for key, val := range *in {
+			var outVal *Table
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(Table)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schema.
+func (in *Schema) DeepCopy() *Schema {
+	if in == nil {
+		return nil
+	}
+	out := new(Schema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaConfig) DeepCopyInto(out *SchemaConfig) {
+	*out = *in
+	if in.Schemas != nil {
+		in, out := &in.Schemas, &out.Schemas
+		*out = make(map[string]*Schema, len(*in))
+		for key, val := range *in {
+			var outVal *Schema
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(Schema)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaConfig.
+func (in *SchemaConfig) DeepCopy() *SchemaConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaSummary) DeepCopyInto(out *SchemaSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaSummary.
+func (in *SchemaSummary) DeepCopy() *SchemaSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetupTestResult) DeepCopyInto(out *SetupTestResult) {
+	*out = *in
+	in.LastRunTime.DeepCopyInto(&out.LastRunTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetupTestResult.
+func (in *SetupTestResult) DeepCopy() *SetupTestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SetupTestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncError) DeepCopyInto(out *SyncError) {
+	*out = *in
+	in.FailedAt.DeepCopyInto(&out.FailedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncError.
+func (in *SyncError) DeepCopy() *SyncError {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Table) DeepCopyInto(out *Table) {
+	*out = *in
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make(map[string]*Column, len(*in))
+		for key, val := range *in {
+			var outVal *Column
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(Column)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Table.
+func (in *Table) DeepCopy() *Table {
+	if in == nil {
+		return nil
+	}
+	out := new(Table)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Trust) DeepCopyInto(out *Trust) {
+	*out = *in
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Fingerprints != nil {
+		in, out := &in.Fingerprints, &out.Fingerprints
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Trust.
+func (in *Trust) DeepCopy() *Trust {
+	if in == nil {
+		return nil
+	}
+	out := new(Trust)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultLease) DeepCopyInto(out *VaultLease) {
+	*out = *in
+	in.ExpireTime.DeepCopyInto(&out.ExpireTime)
+	if in.LastRenewTime != nil {
+		in, out := &in.LastRenewTime, &out.LastRenewTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultLease.
+func (in *VaultLease) DeepCopy() *VaultLease {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultLease)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultRef) DeepCopyInto(out *VaultRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultRef.
+func (in *VaultRef) DeepCopy() *VaultRef {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecretVersion) DeepCopyInto(out *VaultSecretVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretVersion.
+func (in *VaultSecretVersion) DeepCopy() *VaultSecretVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecretVersion)
+	in.DeepCopyInto(out)
+	return out
+}
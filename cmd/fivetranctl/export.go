@@ -0,0 +1,237 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/fivetran/go-fivetran/connections"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
+)
+
+const (
+	// annotationAdoptExistingConnectorID makes the operator adopt the exported connector instead
+	// of creating another one
+	annotationAdoptExistingConnectorID = "operator.dataverse.redhat.com/adopt-existing-connector-id"
+
+	// schemaNotFoundError is the error code of connectors without a schema config
+	schemaNotFoundError = "NotFound_SchemaConfig"
+)
+
+// invalidNameChars matches the characters not allowed in the name of a Kubernetes object
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// exportOptions configures the manifests printed by export
+type exportOptions struct {
+	groupID     string
+	namespace   string
+	vaultPrefix string
+	schemas     bool
+	noAdoption  bool
+	schemaName  string
+}
+
+// manifest is a FivetranConnector as applied by users, without the status and server-set metadata
+type manifest struct {
+	APIVersion string                                 `json:"apiVersion"`
+	Kind       string                                 `json:"kind"`
+	Metadata   manifestMetadata                       `json:"metadata"`
+	Spec       operatorv1alpha1.FivetranConnectorSpec `json:"spec"`
+}
+
+type manifestMetadata struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// runExport prints a FivetranConnector manifest for each connector of a group. Secret config
+// values, which Fivetran does not return, are replaced by Vault references to be filled in.
+func runExport(ctx context.Context, args []string, stdout io.Writer) error {
+	opts := exportOptions{}
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.StringVar(&opts.groupID, "group", "", "The ID of the Fivetran group whose connectors are exported. Required.")
+	flags.StringVar(&opts.schemaName, "destination-schema", "",
+		"Export only the connector with this destination schema name.")
+	flags.StringVar(&opts.namespace, "namespace", "", "The namespace set in the manifests.")
+	flags.StringVar(&opts.vaultPrefix, "vault-path-prefix", "fivetran",
+		"The Vault path under which the secrets of each connector are referenced, as <prefix>/<name>#<key>.")
+	flags.BoolVar(&opts.schemas, "schemas", true, "Export the schema config of each connector as spec.connectorSchemas.")
+	flags.BoolVar(&opts.noAdoption, "no-adopt", false,
+		"Leave out the annotation that makes the operator adopt the exported connectors, so it creates new ones.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if opts.groupID == "" {
+		return errors.New("--group is required")
+	}
+
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+	items, err := client.Groups.ListGroupConnections(ctx, opts.groupID, opts.schemaName)
+	if err != nil {
+		return fmt.Errorf("failed to list connectors of group %s: %w", opts.groupID, err)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Schema < items[j].Schema })
+
+	for i, item := range items {
+		resp, err := client.Connections.GetConnection(ctx, item.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get connector %s: %w", item.ID, err)
+		}
+		var schemas *operatorv1alpha1.ConnectorSchemaConfig
+		if opts.schemas {
+			schemaDetails, err := client.Schemas.GetSchemaDetails(ctx, item.ID)
+			if err != nil && schemaDetails.Code != schemaNotFoundError {
+				return fmt.Errorf("failed to get schema config of connector %s: %w", item.ID, err)
+			}
+			if err == nil {
+				schemas = fivetran.SchemaConfigFromResponse(schemaDetails)
+			}
+		}
+
+		m, err := connectorManifest(resp.Data.DetailsResponseDataCommon, resp.Data.Config, schemas, opts)
+		if err != nil {
+			return fmt.Errorf("failed to export connector %s: %w", item.ID, err)
+		}
+		data, err := yaml.Marshal(m)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if _, err := io.WriteString(stdout, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := stdout.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// connectorManifest returns the FivetranConnector manifest of a connector, named after its
+// destination schema
+func connectorManifest(details connections.DetailsResponseDataCommon, config map[string]any, schemas *operatorv1alpha1.ConnectorSchemaConfig, opts exportOptions) (*manifest, error) {
+	name := manifestName(details.Schema)
+	if name == "" {
+		name = manifestName(details.ID)
+	}
+
+	configJSON, err := json.Marshal(vaultPlaceholders(config, opts.vaultPrefix+"/"+name, nil, false))
+	if err != nil {
+		return nil, err
+	}
+	connector := operatorv1alpha1.Connector{
+		GroupID:                 details.GroupID,
+		Service:                 details.Service,
+		Config:                  &runtime.RawExtension{Raw: configJSON},
+		SyncFrequency:           intValue(details.SyncFrequency),
+		ScheduleType:            details.ScheduleType,
+		Paused:                  details.Paused,
+		PauseAfterTrial:         details.PauseAfterTrial,
+		DataDelaySensitivity:    details.DataDelaySensitivity,
+		DataDelayThreshold:      intValue(details.DataDelayThreshold),
+		NetworkingMethod:        details.NetworkingMethod,
+		ProxyAgentID:            details.ProxyAgentId,
+		PrivateLinkID:           details.PrivateLinkId,
+		HybridDeploymentAgentID: details.HybridDeploymentAgentId,
+	}
+	// Fivetran reports a daily sync time for every connector, but it only applies to daily syncs
+	if connector.SyncFrequency == 1440 {
+		connector.DailySyncTime = details.DailySyncTime
+	}
+	if connector.Paused == nil {
+		paused := false
+		connector.Paused = &paused
+	}
+
+	m := &manifest{
+		APIVersion: operatorv1alpha1.GroupVersion.String(),
+		Kind:       "FivetranConnector",
+		Metadata:   manifestMetadata{Name: name, Namespace: opts.namespace},
+		Spec: operatorv1alpha1.FivetranConnectorSpec{
+			Connector:        connector,
+			ConnectorSchemas: schemas,
+		},
+	}
+	if !opts.noAdoption {
+		m.Metadata.Annotations = map[string]string{annotationAdoptExistingConnectorID: details.ID}
+	}
+	return m, nil
+}
+
+// vaultPlaceholders returns a copy of a connector config with the values of sensitive keys, and
+// the values Fivetran masked, replaced by Vault references under path. The key of a reference
+// joins the keys leading to the value with underscores.
+func vaultPlaceholders(data any, path string, keys []string, secret bool) any {
+	switch v := data.(type) {
+	case map[string]any:
+		replaced := make(map[string]any, len(v))
+		for key, value := range v {
+			replaced[key] = vaultPlaceholders(value, path, append(keys[:len(keys):len(keys)], key), secret || redact.IsSensitiveKey(key))
+		}
+		return replaced
+	case []any:
+		replaced := make([]any, len(v))
+		for i, item := range v {
+			replaced[i] = vaultPlaceholders(item, path, append(keys[:len(keys):len(keys)], fmt.Sprint(i)), secret)
+		}
+		return replaced
+	case string:
+		if secret || v == fivetran.MaskedValue {
+			return fmt.Sprintf("vault:%s#%s", path, strings.Join(keys, "_"))
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// manifestName returns a Kubernetes object name made of a Fivetran name, such as a destination
+// schema name
+func manifestName(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "_", "-")
+	name = strings.Trim(invalidNameChars.ReplaceAllString(name, "-"), "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	return name
+}
+
+// intValue returns the value of an optional number, or zero when it is not set
+func intValue(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
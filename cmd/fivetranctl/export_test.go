@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/connections"
+)
+
+func TestConnectorManifest(t *testing.T) {
+	frequency, paused := 1440, true
+	details := connections.DetailsResponseDataCommon{
+		ID:            "decent_dropsy",
+		GroupID:       "projected_sickle",
+		Service:       "postgres",
+		Schema:        "Sales_DB",
+		SyncFrequency: &frequency,
+		DailySyncTime: "03:00",
+		ScheduleType:  "auto",
+		Paused:        &paused,
+	}
+	config := map[string]any{
+		"host":     "db.example.com",
+		"port":     float64(5432),
+		"password": "******",
+		"tunnel":   map[string]any{"private_key": "******", "user": "fivetran"},
+		"secrets":  []any{map[string]any{"api_token": "abc"}},
+	}
+
+	m, err := connectorManifest(details, config, nil, exportOptions{namespace: "fivetran-operator", vaultPrefix: "fivetran"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.Metadata.Name != "sales-db" || m.Metadata.Namespace != "fivetran-operator" {
+		t.Errorf("expected sales-db in fivetran-operator, got %s in %s", m.Metadata.Name, m.Metadata.Namespace)
+	}
+	if got := m.Metadata.Annotations[annotationAdoptExistingConnectorID]; got != "decent_dropsy" {
+		t.Errorf("expected the adoption annotation of decent_dropsy, got %q", got)
+	}
+	connector := m.Spec.Connector
+	if connector.GroupID != "projected_sickle" || connector.Service != "postgres" || connector.SyncFrequency != 1440 ||
+		connector.DailySyncTime != "03:00" || connector.Paused == nil || !*connector.Paused {
+		t.Errorf("unexpected connector %+v", connector)
+	}
+
+	var gotConfig map[string]any
+	if err := json.Unmarshal(connector.Config.Raw, &gotConfig); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]any{
+		"host":     "db.example.com",
+		"port":     float64(5432),
+		"password": "vault:fivetran/sales-db#password",
+		"tunnel":   map[string]any{"private_key": "vault:fivetran/sales-db#tunnel_private_key", "user": "fivetran"},
+		"secrets":  []any{map[string]any{"api_token": "vault:fivetran/sales-db#secrets_0_api_token"}},
+	}
+	if !reflect.DeepEqual(gotConfig, expected) {
+		t.Errorf("expected config %v, got %v", expected, gotConfig)
+	}
+	if config["password"] != "******" {
+		t.Errorf("expected the config to be left unchanged, got password %v", config["password"])
+	}
+
+	frequency = 60
+	m, err = connectorManifest(details, config, nil, exportOptions{noAdoption: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.Spec.Connector.DailySyncTime != "" {
+		t.Errorf("expected no daily sync time for hourly syncs, got %q", m.Spec.Connector.DailySyncTime)
+	}
+	if m.Metadata.Annotations != nil {
+		t.Errorf("expected no annotations, got %v", m.Metadata.Annotations)
+	}
+}
+
+func TestManifestName(t *testing.T) {
+	tests := map[string]string{
+		"salesforce":        "salesforce",
+		"Sales_DB":          "sales-db",
+		"_staging.events__": "staging-events",
+		"":                  "",
+	}
+	for name, expected := range tests {
+		if got := manifestName(name); got != expected {
+			t.Errorf("manifestName(%q): expected %q, got %q", name, expected, got)
+		}
+	}
+}
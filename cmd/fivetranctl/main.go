@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// fivetranctl works with FivetranConnector manifests outside of a cluster
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+const usage = `fivetranctl works with FivetranConnector manifests outside of a cluster.
+
+Usage:
+  fivetranctl <command> [flags]
+
+Commands:
+  export    Print FivetranConnector manifests of the connectors of a Fivetran group
+  render    Print the requests the operator sends to Fivetran for a FivetranConnector manifest
+
+export calls the Fivetran API with the FIVETRAN_API_KEY and FIVETRAN_API_SECRET environment variables.
+Run "fivetranctl <command> -h" for the flags of a command.
+`
+
+// commands are the subcommands of fivetranctl by name
+var commands = map[string]func(ctx context.Context, args []string, stdout io.Writer) error{
+	"export": runExport,
+	"render": runRender,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	name := os.Args[1]
+	if name == "-h" || name == "--help" || name == "help" {
+		fmt.Fprint(os.Stdout, usage)
+		return
+	}
+	command, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "fivetranctl: unknown command %q\n\n%s", name, usage)
+		os.Exit(2)
+	}
+
+	// The operator's code logs for the operator, not for users of the command line
+	log.SetLogger(logr.Discard())
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := command(ctx, os.Args[2:], os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "fivetranctl %s: %v\n", name, err)
+		os.Exit(1)
+	}
+}
+
+// newClient returns a Fivetran API client authenticated from the environment, as the operator is
+func newClient() (*fivetran.Client, error) {
+	return fivetran.NewClient(os.Getenv("FIVETRAN_API_KEY"), os.Getenv("FIVETRAN_API_SECRET"))
+}
@@ -0,0 +1,397 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	operatorv1beta1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1beta1"
+	"github.com/redhat-data-and-ai/fivetran-operator/internal/controller/fivetranconnector"
+	webhookv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/internal/webhook/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/secrets"
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+// renderConnectorID is the connector ID the rendered requests after the create call are sent for
+const renderConnectorID = "rendered_connector"
+
+// payload is a request the operator sends to the Fivetran API
+type payload struct {
+	Method string
+	Path   string
+	Body   map[string]any
+}
+
+// renderOptions configures render
+type renderOptions struct {
+	filename          string
+	groupDefaults     string
+	vaultMount        string
+	showSecrets       bool
+	defaults          operatorv1alpha1.ConnectorDefaults
+	trustCertificates bool
+	trustFingerprints bool
+}
+
+// runRender prints the requests the operator sends to the Fivetran API to create the connector of
+// a FivetranConnector manifest, after applying defaults as the admission webhook does and resolving
+// its secret references. Resolved secrets are redacted unless asked otherwise.
+func runRender(ctx context.Context, args []string, stdout io.Writer) error {
+	opts := renderOptions{}
+	flags := flag.NewFlagSet("render", flag.ContinueOnError)
+	flags.StringVar(&opts.filename, "f", "", "The FivetranConnector manifest to render, or - for standard input. Required.")
+	flags.StringVar(&opts.groupDefaults, "group-defaults", "",
+		"A manifest of FivetranGroupDefaults applied to the connector as in its namespace.")
+	flags.StringVar(&opts.vaultMount, "vault-mount", "secret",
+		"The KV mount vault: references are read from, unless the manifest's spec.vaultRef overrides it.")
+	flags.BoolVar(&opts.showSecrets, "show-secrets", false, "Print resolved secrets instead of redacting them.")
+	flags.IntVar(&opts.defaults.SyncFrequency, "default-sync-frequency", 0,
+		"The operator's default sync_frequency.")
+	flags.StringVar(&opts.defaults.ScheduleType, "default-schedule-type", "",
+		"The operator's default schedule_type.")
+	flags.StringVar(&opts.defaults.DataDelaySensitivity, "default-data-delay-sensitivity", "",
+		"The operator's default data_delay_sensitivity.")
+	flags.IntVar(&opts.defaults.DataDelayThreshold, "default-data-delay-threshold", 0,
+		"The operator's default data_delay_threshold for a CUSTOM data delay sensitivity.")
+	flags.BoolVar(&opts.trustCertificates, "default-trust-certificates", true,
+		"The operator's default trust_certificates.")
+	flags.BoolVar(&opts.trustFingerprints, "default-trust-fingerprints", true,
+		"The operator's default trust_fingerprints.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if opts.filename == "" {
+		return errors.New("-f is required")
+	}
+	opts.defaults.TrustCertificates = &opts.trustCertificates
+	opts.defaults.TrustFingerprints = &opts.trustFingerprints
+
+	connector, err := readConnector(opts.filename)
+	if err != nil {
+		return err
+	}
+	var groupDefaults []operatorv1alpha1.FivetranGroupDefaults
+	if opts.groupDefaults != "" {
+		if groupDefaults, err = readGroupDefaults(opts.groupDefaults); err != nil {
+			return err
+		}
+	}
+	defaulter := &webhookv1alpha1.FivetranConnectorCustomDefaulter{
+		Client:   groupDefaultsReader(groupDefaults),
+		Defaults: opts.defaults,
+	}
+	if err := defaulter.Default(ctx, connector); err != nil {
+		return fmt.Errorf("failed to apply defaults: %w", err)
+	}
+
+	vaultClient, err := newVaultClient(connector, opts.vaultMount)
+	if err != nil {
+		return err
+	}
+	resolvers := vault.NewRegistry()
+	for scheme, resolver := range map[string]vault.SecretResolver{
+		secrets.AWSSecretsManagerScheme: secrets.NewAWSSecretsManagerResolver(),
+		secrets.AzureKeyVaultScheme:     secrets.NewAzureKeyVaultResolver(),
+		secrets.GCPSecretManagerScheme:  secrets.NewGCPSecretManagerResolver(),
+	} {
+		if err := resolvers.Register(scheme, resolver); err != nil {
+			return err
+		}
+	}
+
+	payloads, err := renderPayloads(ctx, connector, resolvers, vaultClient, opts.showSecrets)
+	if err != nil {
+		return err
+	}
+	for _, p := range payloads {
+		body, err := json.MarshalIndent(p.Body, "", "  ")
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(stdout, "# %s %s\n%s\n", p.Method, p.Path, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderPayloads resolves the secret references of a connector and returns the requests that
+// create its connector, apply its schema config and unpause it. The requests are built by the operator's own
+// client and sent to a local server that records them. Dynamic credentials issued to resolve the
+// references are revoked.
+func renderPayloads(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, resolvers *vault.Registry, vaultClient *vaultpkg.VaultClient, showSecrets bool) ([]payload, error) {
+	redactor := redact.New()
+	ctx = secrets.WithNamespace(ctx, connector.Namespace)
+	var leases []vault.SecretLease
+	defer func() {
+		for _, lease := range leases {
+			if err := vaultpkg.RevokeLease(context.WithoutCancel(ctx), vaultClient, lease.LeaseID); err != nil {
+				fmt.Fprintf(os.Stderr, "fivetranctl render: %v\n", err)
+			}
+		}
+	}()
+	// Config and auth are resolved together, as the operator does
+	var config, auth *runtime.RawExtension
+	if connector.Spec.Connector.Config != nil {
+		config = connector.Spec.Connector.Config.DeepCopy()
+	}
+	if connector.Spec.Connector.Auth != nil {
+		auth = connector.Spec.Connector.Auth.DeepCopy()
+	}
+	results, err := resolvers.ResolveAll(ctx, vault.StaticClient(vaultClient), config, auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve secrets: %w", err)
+	}
+	for _, result := range results {
+		redactor.Add(result.SensitiveValues...)
+		leases = append(leases, result.Leases...)
+	}
+
+	recorder := &payloadRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+	cfg := fivetran.DefaultClientConfig()
+	cfg.BaseURL = server.URL
+	fivetranClient, err := fivetran.NewClientWithConfig("render", "render", cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The operator creates connectors paused with the rest of the spec, applies their schema
+	// config, then unpauses them
+	create, err := fivetranconnector.ToFivetranConnector(connector, config, auth)
+	if err != nil {
+		return nil, err
+	}
+	paused := true
+	create.Paused = &paused
+	if _, err := fivetranClient.Connections.CreateConnection(ctx, create); err != nil {
+		return nil, fmt.Errorf("failed to render the create request: %w", err)
+	}
+	if connector.Spec.ConnectorSchemas != nil {
+		schema := fivetranconnector.ToSchemaBuilder(connector.Spec.ConnectorSchemas)
+		if _, err := fivetranClient.Schemas.UpdateSchema(ctx, renderConnectorID, schema); err != nil {
+			return nil, fmt.Errorf("failed to render the schema request: %w", err)
+		}
+	}
+	if specPaused := connector.Spec.Connector.Paused; specPaused != nil && !*specPaused {
+		if _, err := fivetranClient.Connections.UpdateConnection(ctx, renderConnectorID, &fivetran.Connector{Paused: specPaused}); err != nil {
+			return nil, fmt.Errorf("failed to render the unpause request: %w", err)
+		}
+	}
+
+	payloads := recorder.payloads()
+	if !showSecrets {
+		for i := range payloads {
+			if payloads[i].Body, err = redactPayload(payloads[i].Body, redactor); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return payloads, nil
+}
+
+// redactPayload masks the values of sensitive keys and auth objects in a request body, and the
+// resolved secrets known to redactor
+func redactPayload(body map[string]any, redactor *redact.Redactor) (map[string]any, error) {
+	masked, err := fivetran.RedactConfig(body)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(masked)
+	if err != nil {
+		return nil, err
+	}
+	var redacted map[string]any
+	if err := json.Unmarshal([]byte(redactor.Redact(string(data))), &redacted); err != nil {
+		return nil, err
+	}
+	return redacted, nil
+}
+
+// payloadRecorder records the requests it receives and answers them as the Fivetran API does a
+// successful call
+type payloadRecorder struct {
+	mu       sync.Mutex
+	received []payload
+}
+
+func (p *payloadRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body map[string]any
+	data, err := io.ReadAll(r.Body)
+	if err == nil && len(bytes.TrimSpace(data)) > 0 {
+		err = json.Unmarshal(data, &body)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	p.mu.Lock()
+	p.received = append(p.received, payload{Method: r.Method, Path: r.URL.Path, Body: body})
+	p.mu.Unlock()
+
+	// Fivetran answers the creation of a connector with 201 Created, and other calls with 200 OK
+	status := http.StatusOK
+	if r.Method == http.MethodPost {
+		status = http.StatusCreated
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"code": "Success",
+		"data": map[string]any{"id": renderConnectorID},
+	})
+}
+
+// payloads returns the recorded requests in the order they were received
+func (p *payloadRecorder) payloads() []payload {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]payload(nil), p.received...)
+}
+
+// readConnector reads a FivetranConnector manifest of either API version, converting a v1beta1
+// manifest to v1alpha1 as the conversion webhook does
+func readConnector(filename string) (*operatorv1alpha1.FivetranConnector, error) {
+	data, err := readFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var typeMeta struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+	}
+	if err := utilyaml.Unmarshal(data, &typeMeta); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", filename, err)
+	}
+	if typeMeta.Kind != "FivetranConnector" {
+		return nil, fmt.Errorf("%s is a %q, not a FivetranConnector", filename, typeMeta.Kind)
+	}
+
+	connector := &operatorv1alpha1.FivetranConnector{}
+	switch typeMeta.APIVersion {
+	case operatorv1alpha1.GroupVersion.String():
+		if err := utilyaml.Unmarshal(data, connector); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", filename, err)
+		}
+	case operatorv1beta1.GroupVersion.String():
+		spoke := &operatorv1beta1.FivetranConnector{}
+		if err := utilyaml.Unmarshal(data, spoke); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", filename, err)
+		}
+		if err := spoke.ConvertTo(connector); err != nil {
+			return nil, fmt.Errorf("failed to convert %s to %s: %w", filename, operatorv1alpha1.GroupVersion, err)
+		}
+	default:
+		return nil, fmt.Errorf("%s has the unknown API version %q", filename, typeMeta.APIVersion)
+	}
+	return connector, nil
+}
+
+// readGroupDefaults reads the FivetranGroupDefaults of a manifest of one or more documents
+func readGroupDefaults(filename string) ([]operatorv1alpha1.FivetranGroupDefaults, error) {
+	data, err := readFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var items []operatorv1alpha1.FivetranGroupDefaults
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		item := operatorv1alpha1.FivetranGroupDefaults{}
+		if err := decoder.Decode(&item); errors.Is(err, io.EOF) {
+			return items, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", filename, err)
+		}
+		if item.Kind == "FivetranGroupDefaults" {
+			items = append(items, item)
+		}
+	}
+}
+
+// readFile reads a file, or standard input for -
+func readFile(filename string) ([]byte, error) {
+	if filename == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(filename)
+}
+
+// newVaultClient returns a Vault client configured by the standard VAULT_* environment variables,
+// such as VAULT_ADDR and VAULT_TOKEN, reading vault: references from the mount of the connector's
+// spec.vaultRef or else mount
+func newVaultClient(connector *operatorv1alpha1.FivetranConnector, mount string) (*vaultpkg.VaultClient, error) {
+	apiClient, err := vaultapi.NewClient(vaultapi.DefaultConfig())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Vault client: %w", err)
+	}
+	config := &vaultpkg.ClientConfig{Address: apiClient.Address(), MountPath: mount}
+	if ref := connector.Spec.VaultRef; ref != nil {
+		if ref.MountPath != "" {
+			config.MountPath = ref.MountPath
+		}
+		if ref.Namespace != "" {
+			apiClient.SetNamespace(ref.Namespace)
+			config.Namespace = ref.Namespace
+		}
+	}
+	return &vaultpkg.VaultClient{Client: apiClient, Config: config}, nil
+}
+
+// groupDefaultsReader serves FivetranGroupDefaults read from a manifest to the defaulter
+type groupDefaultsReader []operatorv1alpha1.FivetranGroupDefaults
+
+var _ client.Reader = groupDefaultsReader(nil)
+
+func (r groupDefaultsReader) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	return fmt.Errorf("%T %s is not read by render", obj, key)
+}
+
+func (r groupDefaultsReader) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	defaults, ok := list.(*operatorv1alpha1.FivetranGroupDefaultsList)
+	if !ok {
+		return fmt.Errorf("%T is not listed by render", list)
+	}
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	defaults.Items = nil
+	for _, item := range r {
+		if listOpts.Namespace == "" || item.Namespace == "" || item.Namespace == listOpts.Namespace {
+			defaults.Items = append(defaults.Items, item)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	webhookv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/internal/webhook/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+const renderManifest = `apiVersion: operator.dataverse.redhat.com/v1alpha1
+kind: FivetranConnector
+metadata:
+  name: sales-db
+  namespace: fivetran-operator
+spec:
+  connector:
+    group_id: projected_sickle
+    service: postgres
+    paused: false
+    config:
+      host: db.example.com
+      password: hunter22
+  connectorSchemas:
+    schemas:
+      public:
+        enabled: true
+`
+
+const renderGroupDefaults = `apiVersion: operator.dataverse.redhat.com/v1alpha1
+kind: FivetranGroupDefaults
+metadata:
+  name: sales
+  namespace: fivetran-operator
+spec:
+  groupId: projected_sickle
+  connector:
+    sync_frequency: 360
+`
+
+func TestRenderPayloads(t *testing.T) {
+	dir := t.TempDir()
+	manifestPath := filepath.Join(dir, "connector.yaml")
+	defaultsPath := filepath.Join(dir, "defaults.yaml")
+	if err := os.WriteFile(manifestPath, []byte(renderManifest), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(defaultsPath, []byte(renderGroupDefaults), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	connector, err := readConnector(manifestPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	groupDefaults, err := readGroupDefaults(defaultsPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defaulter := &webhookv1alpha1.FivetranConnectorCustomDefaulter{
+		Client:   groupDefaultsReader(groupDefaults),
+		Defaults: operatorv1alpha1.ConnectorDefaults{ScheduleType: "auto"},
+	}
+	if err := defaulter.Default(context.Background(), connector); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	vaultClient := &vaultpkg.VaultClient{Config: &vaultpkg.ClientConfig{MountPath: "secret"}}
+	payloads, err := renderPayloads(context.Background(), connector, nil, vaultClient, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(payloads) != 3 {
+		t.Fatalf("expected 3 requests, got %d: %+v", len(payloads), payloads)
+	}
+
+	create, schema, unpause := payloads[0], payloads[1], payloads[2]
+	if create.Method != "POST" || create.Path != "/connections" {
+		t.Errorf("expected the create request first, got %s %s", create.Method, create.Path)
+	}
+	if create.Body["paused"] != true || create.Body["sync_frequency"] != float64(360) || create.Body["schedule_type"] != "auto" {
+		t.Errorf("expected a paused create with the group's sync frequency and the default schedule type, got %v", create.Body)
+	}
+	config, _ := create.Body["config"].(map[string]any)
+	if config["password"] != redact.Mask || config["host"] != "db.example.com" {
+		t.Errorf("expected the password redacted, got config %v", config)
+	}
+	if schema.Method != "PATCH" || schema.Path != "/connections/"+renderConnectorID+"/schemas" {
+		t.Errorf("expected the schema request second, got %s %s", schema.Method, schema.Path)
+	}
+	if unpause.Method != "PATCH" || unpause.Path != "/connections/"+renderConnectorID {
+		t.Errorf("expected the unpause request last, got %s %s", unpause.Method, unpause.Path)
+	}
+	if unpause.Body["paused"] != false || unpause.Body["config"] != nil {
+		t.Errorf("expected only the spec's pause state, got %v", unpause.Body)
+	}
+
+	payloads, err = renderPayloads(context.Background(), connector, nil, vaultClient, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config, _ := payloads[0].Body["config"].(map[string]any); config["password"] != "hunter22" {
+		t.Errorf("expected the password shown, got config %v", config)
+	}
+}
@@ -17,10 +17,13 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -32,15 +35,26 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	operatorv1beta1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1beta1"
 	"github.com/redhat-data-and-ai/fivetran-operator/internal/controller/fivetranconnector"
+	"github.com/redhat-data-and-ai/fivetran-operator/internal/controller/fivetranconnectorsummary"
+	"github.com/redhat-data-and-ai/fivetran-operator/internal/controller/fivetrandestination"
+	webhookv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/internal/webhook/v1alpha1"
 	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/notify"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/secrets"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/tracing"
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -53,6 +67,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(operatorv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(operatorv1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -65,6 +80,23 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var vaultCacheTTL time.Duration
+	var vaultCacheSize int
+	var vaultRotationCheckInterval time.Duration
+	var connectorHealthInterval time.Duration
+	var tracingConfig tracing.Config
+	var dependencyReadinessChecks bool
+	var envConfigMap string
+	var envPrefix string
+	var secretAudit bool
+	var connectorURLTemplate string
+	var applyWindow fivetranconnector.ApplyWindow
+	var connectorDefaults operatorv1alpha1.ConnectorDefaults
+	var defaultTrustCertificates, defaultTrustFingerprints bool
+	var webhookVaultReadTimeout time.Duration
+	var plaintextSecretsPolicy string
+	var notifyConfig notify.Config
+	fivetranConfig := fivetran.DefaultClientConfig()
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -83,6 +115,125 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.DurationVar(&vaultCacheTTL, "vault-cache-ttl", 0,
+		"How long secrets read from Vault are reused across reconciles. Zero disables the cache.")
+	flag.IntVar(&vaultCacheSize, "vault-cache-size", 1000,
+		"The maximum number of Vault secrets kept in the cache. Zero means no limit.")
+	flag.DurationVar(&vaultRotationCheckInterval, "vault-rotation-check-interval", 0,
+		"How often referenced Vault secrets are checked for new versions. Zero disables rotation detection.")
+	flag.DurationVar(&connectorHealthInterval, "connector-health-interval", 0,
+		"How often the state of managed connectors is read from Fivetran, exported as metrics and recorded in "+
+			"their status.syncState, status.lastSyncedAt and status.lastSyncError. Zero disables both.")
+	flag.StringVar(&envConfigMap, "env-configmap", "",
+		"A ConfigMap in the operator's namespace whose keys take precedence over the operator's environment "+
+			"for ${ENV:NAME} placeholders in connector config.")
+	flag.StringVar(&envPrefix, "env-prefix", vault.DefaultEnvPrefix,
+		"The prefix of the operator environment variables ${ENV:NAME} placeholders in connector config may read. "+
+			"Keys of --env-configmap are not restricted. An empty prefix exposes every variable, including the "+
+			"operator's credentials, to anyone who can write a FivetranConnector.")
+	flag.StringVar(&connectorURLTemplate, "connector-url-template", fivetranconnector.DefaultConnectorURLTemplate,
+		"The dashboard URL of a connector recorded in status.connectorUrl, with {connectorId} and {groupId} "+
+			"placeholders, for accounts with a custom subdomain or the connections dashboard.")
+	flag.DurationVar(&applyWindow.Window, "apply-window", 0,
+		"How long changes of a FivetranConnector are collected into one reconcile, so a GitOps sync updating it "+
+			"several times calls Fivetran once. Zero reconciles each change right away.")
+	flag.Float64Var(&applyWindow.Rate, "apply-rate", 0,
+		"The maximum number of changed FivetranConnectors reconciled per second, spreading a bulk apply over "+
+			"time. Zero does not limit the rate.")
+	flag.IntVar(&connectorDefaults.SyncFrequency, "default-sync-frequency", 0,
+		"The sync_frequency in minutes of connectors that set none, after FivetranGroupDefaults. Zero leaves it to Fivetran.")
+	flag.StringVar(&connectorDefaults.ScheduleType, "default-schedule-type", "",
+		"The schedule_type of connectors that set none, after FivetranGroupDefaults.")
+	flag.StringVar(&connectorDefaults.DataDelaySensitivity, "default-data-delay-sensitivity", "",
+		"The data_delay_sensitivity of connectors that set none, after FivetranGroupDefaults.")
+	flag.IntVar(&connectorDefaults.DataDelayThreshold, "default-data-delay-threshold", 0,
+		"The data_delay_threshold in minutes of connectors with a CUSTOM data_delay_sensitivity that set none, "+
+			"after FivetranGroupDefaults.")
+	flag.BoolVar(&defaultTrustCertificates, "default-trust-certificates", true,
+		"The trust_certificates of connectors that set none, after FivetranGroupDefaults.")
+	flag.BoolVar(&defaultTrustFingerprints, "default-trust-fingerprints", true,
+		"The trust_fingerprints of connectors that set none, after FivetranGroupDefaults.")
+	flag.DurationVar(&webhookVaultReadTimeout, "webhook-vault-read-timeout", 0,
+		"How long the webhook waits to read the Vault secrets a connector references, to reject missing "+
+			"secrets and keys at admission. Zero only checks the syntax of the references.")
+	flag.StringVar(&plaintextSecretsPolicy, "plaintext-secrets", webhookv1alpha1.PlaintextSecretsWarn,
+		"What the webhook does with connectors whose password, token, key and other secret fields hold a value "+
+			"instead of a secret reference: allow, warn or reject.")
+	flag.BoolVar(&secretAudit, "secret-audit", false,
+		"Log the secret references resolved for each connector, without their values, for auditing credential flow.")
+	flag.DurationVar(&fivetranConfig.HTTP.Timeout, "fivetran-request-timeout", fivetranConfig.HTTP.Timeout,
+		"The maximum duration of a Fivetran API request, including reading the response. Zero means no timeout.")
+	flag.DurationVar(&fivetranConfig.HTTP.KeepAlive, "fivetran-keep-alive", fivetranConfig.HTTP.KeepAlive,
+		"The interval between keep-alive probes on connections to the Fivetran API. Negative disables keep-alives.")
+	flag.DurationVar(&fivetranConfig.HTTP.IdleConnTimeout, "fivetran-idle-conn-timeout", fivetranConfig.HTTP.IdleConnTimeout,
+		"How long idle connections to the Fivetran API are kept open for reuse.")
+	flag.BoolVar(&fivetranConfig.DebugLogging, "fivetran-debug-logging", false,
+		"Log the method, path, status, duration and a sanitized body snippet of every Fivetran API request at "+
+			"verbosity 2, with auth and credential values masked.")
+	flag.IntVar(&fivetranConfig.Retry.MaxAttempts, "fivetran-retry-attempts", fivetranConfig.Retry.MaxAttempts,
+		"The number of times idempotent Fivetran API calls are attempted on transient errors. 1 disables retries.")
+	flag.DurationVar(&fivetranConfig.Retry.InitialBackoff, "fivetran-retry-backoff", fivetranConfig.Retry.InitialBackoff,
+		"The delay before the first retry of a Fivetran API call. It doubles on each further attempt, with jitter.")
+	flag.DurationVar(&fivetranConfig.Retry.MaxBackoff, "fivetran-retry-max-backoff", fivetranConfig.Retry.MaxBackoff,
+		"The maximum delay between retries of a Fivetran API call.")
+	flag.Float64Var(&fivetranConfig.RateLimit.RequestsPerSecond, "fivetran-rate-limit", fivetranConfig.RateLimit.RequestsPerSecond,
+		"The maximum sustained rate of Fivetran API requests per second across all reconciles. Zero disables the limit.")
+	flag.IntVar(&fivetranConfig.RateLimit.Burst, "fivetran-rate-limit-burst", fivetranConfig.RateLimit.Burst,
+		"The number of Fivetran API requests that may be sent at once above the sustained rate.")
+	flag.IntVar(&fivetranConfig.CircuitBreaker.FailureThreshold, "fivetran-circuit-breaker-threshold",
+		fivetranConfig.CircuitBreaker.FailureThreshold,
+		"The number of consecutive Fivetran API server errors that stops requests to the API. Zero disables the breaker.")
+	flag.DurationVar(&fivetranConfig.CircuitBreaker.OpenDuration, "fivetran-circuit-breaker-open-duration",
+		fivetranConfig.CircuitBreaker.OpenDuration,
+		"How long requests to the Fivetran API are stopped before a probe request checks for recovery.")
+	flag.StringVar(&fivetranConfig.BaseURL, "fivetran-api-url", "",
+		"The base URL of the Fivetran API, such as a fake server in end-to-end tests. Empty uses the public API.")
+	flag.Func("fivetran-api-version",
+		"Pin every Fivetran API request to API version 1 or 2. Empty keeps the version the SDK requests for each endpoint.",
+		func(value string) error {
+			fivetranConfig.APIVersion = fivetran.APIVersion(value)
+			return fivetranConfig.APIVersion.Validate()
+		})
+	flag.Func("fivetran-connections-api-version",
+		"Pin Fivetran API connections requests to API version 1 or 2, overriding --fivetran-api-version.",
+		func(value string) error {
+			fivetranConfig.ConnectionsAPIVersion = fivetran.APIVersion(value)
+			return fivetranConfig.ConnectionsAPIVersion.Validate()
+		})
+	flag.Float64Var(&fivetranConfig.Faults.ServerErrorRate, "fivetran-fault-server-error-rate", 0,
+		"For testing only: the share of Fivetran API requests, from 0 to 1, failed with an injected 503 response.")
+	flag.Float64Var(&fivetranConfig.Faults.RateLimitRate, "fivetran-fault-rate-limit-rate", 0,
+		"For testing only: the share of Fivetran API requests, from 0 to 1, failed with an injected 429 response.")
+	flag.Float64Var(&fivetranConfig.Faults.PartialFailureRate, "fivetran-fault-partial-failure-rate", 0,
+		"For testing only: the share of Fivetran API requests, from 0 to 1, that are sent but answered with an "+
+			"injected 502 response.")
+	flag.DurationVar(&fivetranConfig.Faults.Latency, "fivetran-fault-latency", 0,
+		"For testing only: latency added to every Fivetran API request.")
+	flag.BoolVar(&dependencyReadinessChecks, "dependency-readiness-checks", false,
+		"Report the operator as not ready while the Fivetran API rejects its credentials or is unreachable, or "+
+			"its Vault client cannot log in, so bad credentials fail the rollout. Only enable it with "+
+			"ENABLE_WEBHOOKS=false: an unready pod drops out of the webhook service, so every FivetranConnector "+
+			"change is rejected while Fivetran or Vault is down.")
+	flag.StringVar(&tracingConfig.Endpoint, "otlp-endpoint", "",
+		"The host:port of an OTLP/gRPC collector to export traces of reconciles and Fivetran and Vault calls to. "+
+			"Empty uses OTEL_EXPORTER_OTLP_ENDPOINT, and disables tracing when it is not set either.")
+	flag.BoolVar(&tracingConfig.Insecure, "otlp-insecure", false,
+		"Connect to the OTLP collector without TLS.")
+	flag.Float64Var(&tracingConfig.SampleRatio, "trace-sample-ratio", 1,
+		"The share of reconciles, from 0 to 1, that are traced.")
+	flag.StringVar(&notifyConfig.SMTP.Addr, "notify-smtp-addr", "",
+		"The host:port of an SMTP server to email connector failure notifications through. Empty disables email.")
+	flag.StringVar(&notifyConfig.SMTP.From, "notify-smtp-from", "", "The sender address of notification emails.")
+	flag.Func("notify-smtp-to", "A comma-separated list of recipients of notification emails.", func(value string) error {
+		for _, to := range strings.Split(value, ",") {
+			if to = strings.TrimSpace(to); to != "" {
+				notifyConfig.SMTP.To = append(notifyConfig.SMTP.To, to)
+			}
+		}
+		return nil
+	})
+	flag.StringVar(&notifyConfig.SMTP.Username, "notify-smtp-username", "",
+		"The username to authenticate to the SMTP server with, using the NOTIFY_SMTP_PASSWORD environment variable.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -91,6 +242,17 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if err := fivetranconnector.ValidateConnectorURLTemplate(connectorURLTemplate); err != nil {
+		setupLog.Error(err, "invalid --connector-url-template")
+		os.Exit(1)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingConfig)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -219,23 +381,150 @@ func main() {
 		os.Exit(1)
 	}
 
-	client, err := fivetran.NewClient(os.Getenv("FIVETRAN_API_KEY"), os.Getenv("FIVETRAN_API_SECRET"))
+	client, err := fivetran.NewClientWithConfig(os.Getenv("FIVETRAN_API_KEY"), os.Getenv("FIVETRAN_API_SECRET"), fivetranConfig)
 	if err != nil {
 		setupLog.Error(err, "FIVETRAN_API_KEY and FIVETRAN_API_SECRET environment variables are required but not set.")
 		os.Exit(1)
 	}
 
+	// Webhook URLs embed their credentials, so they are read from the environment like the API key
+	notifyConfig.SlackWebhookURL = os.Getenv("NOTIFY_SLACK_WEBHOOK_URL")
+	notifyConfig.WebhookURL = os.Getenv("NOTIFY_WEBHOOK_URL")
+	notifyConfig.SMTP.Password = os.Getenv("NOTIFY_SMTP_PASSWORD")
+	notifier, err := notify.New(notifyConfig)
+	if err != nil {
+		setupLog.Error(err, "unable to set up notifications")
+		os.Exit(1)
+	}
+
+	vaultClients := vaultpkg.NewClientManager(mgr.GetClient(), vaultpkg.NewSecretCache(vaultCacheTTL, vaultCacheSize))
+	if err := mgr.Add(vaultClients); err != nil {
+		setupLog.Error(err, "unable to add vault client manager to manager")
+		os.Exit(1)
+	}
+
+	// Resolvers for secret reference schemes other than the built-in vault: and vaultDynamic:
+	secretResolvers := vault.NewRegistry()
+	for scheme, resolver := range map[string]vault.SecretResolver{
+		secrets.SecretScheme:            secrets.NewKubernetesSecretResolver(mgr.GetClient()),
+		secrets.ConfigMapScheme:         secrets.NewConfigMapResolver(mgr.GetClient()),
+		secrets.AWSSecretsManagerScheme: secrets.NewAWSSecretsManagerResolver(),
+		secrets.AzureKeyVaultScheme:     secrets.NewAzureKeyVaultResolver(),
+		secrets.GCPSecretManagerScheme:  secrets.NewGCPSecretManagerResolver(),
+	} {
+		if err := secretResolvers.Register(scheme, resolver); err != nil {
+			setupLog.Error(err, "unable to register secret resolver", "scheme", scheme)
+			os.Exit(1)
+		}
+	}
+	secretResolvers.SetEnvPrefix(envPrefix)
+	if envConfigMap != "" {
+		secretResolvers.SetEnvSource(secrets.NewConfigMapEnvSource(mgr.GetClient(), watchNamespace, envConfigMap))
+	}
+
 	if client != nil {
+		// Like the API key, the secret signing Fivetran's webhook requests is read from the environment
+		webhookSecret := os.Getenv("FIVETRAN_WEBHOOK_SECRET")
+		var webhookEvents chan event.GenericEvent
+		if webhookSecret != "" {
+			// Buffered for bursts of events, such as the sync_end events of a group's connectors
+			webhookEvents = make(chan event.GenericEvent, 1024)
+		}
 		if err = (&fivetranconnector.FivetranConnectorReconciler{
-			Client:         mgr.GetClient(),
-			Scheme:         mgr.GetScheme(),
-			FivetranClient: client,
+			Client:               mgr.GetClient(),
+			Scheme:               mgr.GetScheme(),
+			FivetranClient:       client,
+			VaultClients:         vaultClients,
+			SecretResolvers:      secretResolvers,
+			SecretAudit:          secretAudit,
+			Recorder:             mgr.GetEventRecorderFor("fivetranconnector-controller"),
+			Notifier:             notifier,
+			ConnectorURLTemplate: connectorURLTemplate,
+			WebhookEvents:        webhookEvents,
+			ApplyWindow:          applyWindow,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "FivetranConnector")
 			os.Exit(1)
 		}
+
+		if webhookSecret != "" {
+			setupLog.Info("Receiving Fivetran webhook events", "path", fivetranconnector.EventReceiverPath)
+			mgr.GetWebhookServer().Register(fivetranconnector.EventReceiverPath, &fivetranconnector.EventReceiver{
+				Client:   mgr.GetClient(),
+				Recorder: mgr.GetEventRecorderFor("fivetranconnector-controller"),
+				Secret:   []byte(webhookSecret),
+				Enqueue:  webhookEvents,
+			})
+		}
+
+		if err = (&fivetrandestination.FivetranDestinationReconciler{
+			Client:          mgr.GetClient(),
+			Scheme:          mgr.GetScheme(),
+			FivetranClient:  client,
+			VaultClients:    vaultClients,
+			VaultSecretName: fivetranconnector.VaultSecretName(),
+			SecretResolvers: secretResolvers,
+			Recorder:        mgr.GetEventRecorderFor("fivetrandestination-controller"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "FivetranDestination")
+			os.Exit(1)
+		}
 	} else {
-		setupLog.Info("Fivetran client not initialized, skipping FivetranConnector controller setup.")
+		setupLog.Info("Fivetran client not initialized, skipping FivetranConnector and FivetranDestination controller setup.")
+	}
+
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		var metadataService fivetran.MetadataService
+		if client != nil {
+			metadataService = client.Metadata
+		}
+		connectorDefaults.TrustCertificates = &defaultTrustCertificates
+		connectorDefaults.TrustFingerprints = &defaultTrustFingerprints
+		vaultReads := webhookv1alpha1.VaultReads{Timeout: webhookVaultReadTimeout}
+		if webhookVaultReadTimeout > 0 {
+			vaultReads.Client = func(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) (*vaultpkg.VaultClient, error) {
+				return fivetranconnector.ConnectorVaultClient(ctx, vaultClients, connector)
+			}
+		}
+		if err = webhookv1alpha1.SetupFivetranConnectorWebhookWithManager(mgr, metadataService, connectorDefaults, vaultReads,
+			webhookv1alpha1.PlaintextSecrets{Policy: plaintextSecretsPolicy, Registry: secretResolvers}); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "FivetranConnector")
+			os.Exit(1)
+		}
+	}
+
+	if err = (&fivetranconnectorsummary.FivetranConnectorSummaryReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "FivetranConnectorSummary")
+		os.Exit(1)
+	}
+
+	if vaultRotationCheckInterval > 0 {
+		if err := mgr.Add(&fivetranconnector.SecretRotationWatcher{
+			Client:       mgr.GetClient(),
+			VaultClients: vaultClients,
+			Namespace:    watchNamespace,
+			Interval:     vaultRotationCheckInterval,
+		}); err != nil {
+			setupLog.Error(err, "unable to add vault secret rotation watcher to manager")
+			os.Exit(1)
+		}
+	}
+
+	if connectorHealthInterval > 0 && client != nil {
+		if err := mgr.Add(&fivetranconnector.ConnectorHealthExporter{
+			Client:         mgr.GetClient(),
+			FivetranClient: client,
+			Namespace:      watchNamespace,
+			Interval:       connectorHealthInterval,
+			Recorder:       mgr.GetEventRecorderFor("fivetranconnector-controller"),
+		}); err != nil {
+			setupLog.Error(err, "unable to add connector health exporter to manager")
+			os.Exit(1)
+		}
 	}
 
 	// +kubebuilder:scaffold:builder
@@ -256,6 +545,17 @@ func main() {
 		}
 	}
 
+	// Flush the spans of the last reconciles when the manager stops
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return shutdownTracing(shutdownCtx)
+	})); err != nil {
+		setupLog.Error(err, "unable to add tracing shutdown to manager")
+		os.Exit(1)
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -264,6 +564,18 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if dependencyReadinessChecks {
+		if client != nil {
+			if err := mgr.AddReadyzCheck("fivetran", fivetranconnector.FivetranAPICheck(client)); err != nil {
+				setupLog.Error(err, "unable to set up fivetran ready check")
+				os.Exit(1)
+			}
+		}
+		if err := mgr.AddReadyzCheck("vault", fivetranconnector.VaultCheck(vaultClients, watchNamespace)); err != nil {
+			setupLog.Error(err, "unable to set up vault ready check")
+			os.Exit(1)
+		}
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// reconcileConnectCard publishes the URI of a new Connect Card when spec.connectCard is set, and
+// clears it once spec.connectCard is removed
+func (r *FivetranConnectorReconciler) reconcileConnectCard(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, connectorID string) error {
+	logger := log.FromContext(ctx)
+
+	card := connector.Spec.ConnectCard
+	if card == nil {
+		if connector.Status.ConnectCardURI == "" {
+			return nil
+		}
+		logger.Info("Clearing connect card", "connectorId", connectorID)
+		connector.Status.ConnectCardURI = ""
+		return r.Status().Update(ctx, connector)
+	}
+
+	logger.Info("Generating connect card", "connectorId", connectorID)
+	resp, err := r.FivetranClient.Connections.CreateConnectCard(ctx, connectorID, card.RedirectURI, card.HideSetupGuide)
+	if err != nil {
+		return fmt.Errorf("reconcileConnectCard: %w", err)
+	}
+
+	connector.Status.ConnectCardURI = resp.Data.ConnectCard.Uri
+	return r.Status().Update(ctx, connector)
+}
+
+// connectCardOutdated reports whether a Connect Card URI is published without spec.connectCard, or
+// spec.connectCard is set without a published URI
+func connectCardOutdated(connector *operatorv1alpha1.FivetranConnector) bool {
+	return (connector.Spec.ConnectCard != nil) != (connector.Status.ConnectCardURI != "")
+}
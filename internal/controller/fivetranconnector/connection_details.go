@@ -0,0 +1,130 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+const (
+	connectionDetailsKindConfigMap = "ConfigMap"
+	connectionDetailsKindSecret    = "Secret"
+
+	connectionDetailsKeyConnectorID       = "connectorId"
+	connectionDetailsKeyGroupID           = "groupId"
+	connectionDetailsKeyDestinationSchema = "destinationSchema"
+)
+
+// errConnectionDetailsNotOwned is returned instead of overwriting a ConfigMap or Secret the
+// connector did not create
+var errConnectionDetailsNotOwned = errors.New("exists and is not owned by the connector")
+
+// reconcileConnectionDetails publishes the connector ID, group ID and destination schema of a
+// created connector to the ConfigMap or Secret named by spec.connectionDetails
+func (r *FivetranConnectorReconciler) reconcileConnectionDetails(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) error {
+	details := connector.Spec.ConnectionDetails
+	if details == nil || connector.Status.ConnectorID == "" {
+		return nil
+	}
+
+	kind := connectionDetailsKindConfigMap
+	var obj client.Object = &corev1.ConfigMap{}
+	if details.Kind == connectionDetailsKindSecret {
+		kind = connectionDetailsKindSecret
+		obj = &corev1.Secret{}
+	}
+	obj.SetName(details.Name)
+	obj.SetNamespace(connector.Namespace)
+
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+		if obj.GetUID() != "" && !metav1.IsControlledBy(obj, connector) {
+			return fmt.Errorf("%s %s %w", kind, details.Name, errConnectionDetailsNotOwned)
+		}
+		data, err := r.connectionDetailsData(ctx, connector, objectData(obj))
+		if err != nil {
+			return err
+		}
+		setObjectData(obj, data)
+		return controllerutil.SetControllerReference(connector, obj, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("reconcileConnectionDetails: %w", err)
+	}
+	if result != controllerutil.OperationResultNone {
+		log.FromContext(ctx).Info("Published connection details", "kind", kind, "name", details.Name, "operation", result)
+	}
+	return nil
+}
+
+// connectionDetailsData returns the connection details of the connector. The destination schema of
+// a Fivetran connection cannot change, so it is only read from Fivetran when the published details
+// are missing or belong to another connector ID.
+func (r *FivetranConnectorReconciler) connectionDetailsData(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, published map[string]string) (map[string]string, error) {
+	connectorID := connector.Status.ConnectorID
+	schema := published[connectionDetailsKeyDestinationSchema]
+	if schema == "" || published[connectionDetailsKeyConnectorID] != connectorID {
+		resp, err := r.FivetranClient.Connections.GetConnection(ctx, connectorID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get connector %s: %w", connectorID, err)
+		}
+		schema = resp.Data.Schema
+	}
+
+	return map[string]string{
+		connectionDetailsKeyConnectorID:       connectorID,
+		connectionDetailsKeyGroupID:           connector.Spec.Connector.GroupID,
+		connectionDetailsKeyDestinationSchema: schema,
+	}, nil
+}
+
+// objectData returns the data of a ConfigMap or Secret
+func objectData(obj client.Object) map[string]string {
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		return o.Data
+	case *corev1.Secret:
+		data := make(map[string]string, len(o.Data))
+		for key, value := range o.Data {
+			data[key] = string(value)
+		}
+		return data
+	}
+	return nil
+}
+
+// setObjectData replaces the data of a ConfigMap or Secret
+func setObjectData(obj client.Object, data map[string]string) {
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		o.Data = data
+	case *corev1.Secret:
+		o.Data = make(map[string][]byte, len(data))
+		for key, value := range data {
+			o.Data[key] = []byte(value)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/go-fivetran/connections"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// recordAlerts reflects the alerts Fivetran reports for a connector in its ConnectorAlerts
+// condition. Fivetran reports errors that stop the connector until someone acts on them, such as
+// broken credentials, as tasks, and problems it syncs through, such as schema incompatibilities,
+// as warnings. Tasks make the condition False and warnings leave it True with the OpenWarnings
+// reason. A Warning event is recorded for each alert whenever the alerts change.
+func (e *ConnectorHealthExporter) recordAlerts(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, status connections.StatusResponse) error {
+	conditionStatus, reason, message := metav1.ConditionTrue, AlertsReasonNone, msgNoAlerts
+	switch {
+	case len(status.Tasks) > 0:
+		conditionStatus, reason, message = metav1.ConditionFalse, AlertsReasonOpenTasks, alertsMessage(status.Tasks, status.Warnings)
+	case len(status.Warnings) > 0:
+		reason, message = AlertsReasonOpenWarnings, alertsMessage(status.Warnings)
+	}
+
+	existing := meta.FindStatusCondition(connector.Status.Conditions, conditionTypeConnectorAlerts)
+	if existing != nil && existing.Status == conditionStatus && existing.Reason == reason && existing.Message == message {
+		return nil
+	}
+
+	log.FromContext(ctx).Info("Fivetran alerts changed", "connector", connector.Name, "reason", reason)
+	if e.Recorder != nil {
+		for _, task := range status.Tasks {
+			e.Recorder.Event(connector, corev1.EventTypeWarning, eventReasonFivetranTask, alertMessage(task))
+		}
+		for _, warning := range status.Warnings {
+			e.Recorder.Event(connector, corev1.EventTypeWarning, eventReasonFivetranWarning, alertMessage(warning))
+		}
+	}
+
+	meta.SetStatusCondition(&connector.Status.Conditions, metav1.Condition{
+		Type:    conditionTypeConnectorAlerts,
+		Status:  conditionStatus,
+		Reason:  reason,
+		Message: message,
+	})
+	return e.Client.Status().Update(ctx, connector)
+}
+
+// alertsMessage lists alerts in a condition message
+func alertsMessage(alerts ...[]common.CommonResponse) string {
+	var parts []string
+	for _, items := range alerts {
+		for _, item := range items {
+			parts = append(parts, alertMessage(item))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// alertMessage describes an alert by its code and message
+func alertMessage(alert common.CommonResponse) string {
+	return fmt.Sprintf("%s: %s", alert.Code, alert.Message)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// ConnectorIDIndexKey indexes FivetranConnectors by the ID of the Fivetran connector they manage
+const ConnectorIDIndexKey = "status.connectorId"
+
+// connectorIDIndex returns the Fivetran connector ID of a FivetranConnector, or none before the
+// connector is created or adopted
+func connectorIDIndex(obj client.Object) []string {
+	connector, ok := obj.(*operatorv1alpha1.FivetranConnector)
+	if !ok || connector.Status.ConnectorID == "" {
+		return nil
+	}
+	return []string{connector.Status.ConnectorID}
+}
+
+// ListByConnectorID returns the FivetranConnectors of every namespace that claim a Fivetran
+// connector, using the index registered by SetupWithManager
+func ListByConnectorID(ctx context.Context, reader client.Reader, connectorID string) ([]operatorv1alpha1.FivetranConnector, error) {
+	connectors := &operatorv1alpha1.FivetranConnectorList{}
+	if err := reader.List(ctx, connectors, client.MatchingFields{ConnectorIDIndexKey: connectorID}); err != nil {
+		return nil, fmt.Errorf("failed to list FivetranConnectors by connector ID: %w", err)
+	}
+	return connectors.Items, nil
+}
+
+// connectorIDClaims returns the other FivetranConnectors claiming the Fivetran connector of a
+// FivetranConnector, and whether it owns the connector. The oldest claimant owns it, so a
+// FivetranConnector adopting the connector of another does not take it over.
+func (r *FivetranConnectorReconciler) connectorIDClaims(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) ([]string, bool, error) {
+	if connector.Status.ConnectorID == "" {
+		return nil, true, nil
+	}
+	claimants, err := ListByConnectorID(ctx, r.Client, connector.Status.ConnectorID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var others []string
+	owns := true
+	for i := range claimants {
+		other := &claimants[i]
+		if other.UID == connector.UID {
+			continue
+		}
+		others = append(others, other.Namespace+"/"+other.Name)
+		if claimsBefore(other, connector) {
+			owns = false
+		}
+	}
+	slices.Sort(others)
+	return others, owns, nil
+}
+
+// claimsBefore orders claimants by creation time, then by namespace and name
+func claimsBefore(a, b *operatorv1alpha1.FivetranConnector) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Namespace+"/"+a.Name < b.Namespace+"/"+b.Name
+}
+
+// reconcileConnectorIDConflict sets the Conflict condition while other FivetranConnectors claim the
+// same Fivetran connector, and removes it once they no longer do. It returns ErrConnectorIDConflict
+// when another FivetranConnector owns the connector, so this one leaves it alone.
+func (r *FivetranConnectorReconciler) reconcileConnectorIDConflict(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) error {
+	others, owns, err := r.connectorIDClaims(ctx, connector)
+	if err != nil {
+		return err
+	}
+
+	if len(others) == 0 {
+		if meta.FindStatusCondition(connector.Status.Conditions, conditionTypeConflict) == nil {
+			return nil
+		}
+		meta.RemoveStatusCondition(&connector.Status.Conditions, conditionTypeConflict)
+		return r.Status().Update(ctx, connector)
+	}
+
+	message := fmt.Sprintf("Fivetran connector %s is also claimed by %s", connector.Status.ConnectorID, strings.Join(others, ", "))
+	log.FromContext(ctx).Info("Fivetran connector claimed by several FivetranConnectors",
+		"connectorID", connector.Status.ConnectorID, "others", others, "owns", owns)
+	if existing := meta.FindStatusCondition(connector.Status.Conditions, conditionTypeConflict); existing == nil || existing.Message != message {
+		if err := r.setCondition(ctx, connector, conditionTypeConflict, metav1.ConditionTrue, ConflictReasonConnectorIDClaimed, message); err != nil {
+			return err
+		}
+	}
+	if !owns {
+		return fmt.Errorf("%w: %s", ErrConnectorIDConflict, message)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/internal/kubeutils"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+// migrateConnectorIfNeeded retires the Fivetran connector of a FivetranConnector whose group_id or
+// service no longer match it, so the next reconcile creates a connector from the spec. Fivetran
+// cannot move a connector to another group or change its service, so a migration needs the
+// migrate annotation. It returns true when the connector was retired.
+func (r *FivetranConnectorReconciler) migrateConnectorIfNeeded(ctx context.Context, vaultClient *connectorVault, connector *operatorv1alpha1.FivetranConnector) (bool, error) {
+	if connector.Status.ConnectorID == "" {
+		return false, nil
+	}
+	// Only a changed spec can name another group or service
+	connectorHashChanged, err := r.hasConnectorHashChanged(connector)
+	if err != nil || !connectorHashChanged {
+		return false, err
+	}
+
+	start := time.Now()
+	defer observePhase(phaseConnector, start)
+
+	connectorID := connector.Status.ConnectorID
+	existingConnector, err := r.FivetranClient.Connections.GetConnection(ctx, connectorID)
+	if err != nil {
+		return false, fmt.Errorf("migrateConnectorIfNeeded: failed to get connector %s: %w", connectorID, err)
+	}
+	if connector.Spec.Connector.GroupID == existingConnector.Data.GroupID &&
+		connector.Spec.Connector.Service == existingConnector.Data.Service {
+		return false, nil
+	}
+	if kubeutils.GetAnnotation(connector, annotationMigrateConnector) != "true" {
+		return false, fmt.Errorf("migrateConnectorIfNeeded: connector %s is in group '%s' with service '%s': %w",
+			connectorID, existingConnector.Data.GroupID, existingConnector.Data.Service, ErrConnectorMigrationNotAllowed)
+	}
+
+	if err := r.retireConnector(ctx, vaultClient, connector); err != nil {
+		return false, err
+	}
+	r.normalEvent(ctx, connector, eventReasonConnectorRetired, fmt.Sprintf(
+		"Retired connector %s of group %s with service %s (deletion policy %s) to create one in group %s with service %s",
+		connectorID, existingConnector.Data.GroupID, existingConnector.Data.Service, deletionPolicy(connector),
+		connector.Spec.Connector.GroupID, connector.Spec.Connector.Service))
+	return true, nil
+}
+
+// retireConnector deletes the connector or, with the Retain deletion policy, pauses it, then
+// forgets it so a connector is created from the spec
+func (r *FivetranConnectorReconciler) retireConnector(ctx context.Context, vaultClient *connectorVault, connector *operatorv1alpha1.FivetranConnector) error {
+	logger := log.FromContext(ctx)
+	connectorID := connector.Status.ConnectorID
+
+	if connector.Spec.DeletionPolicy == operatorv1alpha1.DeletionPolicyRetain {
+		// The retained connector must not keep syncing into the destination the new one takes over
+		pausedTrue := true
+		if _, err := r.FivetranClient.Connections.UpdateConnection(ctx, connectorID, &fivetran.Connector{Paused: &pausedTrue}); err != nil {
+			return fmt.Errorf("retireConnector: failed to pause connector %s: %w", connectorID, err)
+		}
+		logger.Info("Paused and retained Fivetran connector", "connectorID", connectorID)
+	} else {
+		if _, err := r.FivetranClient.Connections.DeleteConnection(ctx, connectorID); err != nil {
+			return fmt.Errorf("retireConnector: failed to delete connector %s: %w", connectorID, err)
+		}
+		connectorOperationsTotal.WithLabelValues(operationDelete).Inc()
+		logger.Info("Deleted Fivetran connector", "connectorID", connectorID)
+
+		// The connector no longer uses its dynamic credentials
+		revokeVaultLeases(ctx, vaultClient, statusLeaseIDs(connector))
+	}
+
+	// The new connector is issued its own dynamic credentials
+	connector.Status.ConnectorID = ""
+	connector.Status.ConnectorURL = ""
+	connector.Status.VaultLeases = nil
+	return r.Status().Update(ctx, connector)
+}
+
+// deletionPolicy returns the deletion policy of a connector, defaulting to Delete
+func deletionPolicy(connector *operatorv1alpha1.FivetranConnector) string {
+	if connector.Spec.DeletionPolicy == "" {
+		return operatorv1alpha1.DeletionPolicyDelete
+	}
+	return connector.Spec.DeletionPolicy
+}
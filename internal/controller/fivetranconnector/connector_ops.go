@@ -27,13 +27,15 @@ import (
 
 	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
 	"github.com/redhat-data-and-ai/fivetran-operator/internal/kubeutils"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
 )
 
 // reconcileConnector creates or updates connector as needed
-func (r *FivetranConnectorReconciler) reconcileConnector(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, resolvedConfig, resolvedAuth *runtime.RawExtension) (string, error) {
+func (r *FivetranConnectorReconciler) reconcileConnector(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, secrets *resolvedSecrets) (string, error) {
 	logger := log.FromContext(ctx)
 	logger.Info("Reconciling connector")
 	connectorID := connector.Status.ConnectorID
+	resolvedConfig, resolvedAuth := secrets.config, secrets.auth
 
 	if connectorID == "" {
 		// Create new connector
@@ -46,7 +48,7 @@ func (r *FivetranConnectorReconciler) reconcileConnector(ctx context.Context, co
 		if err := r.updateConnectorIDStatus(ctx, connector, connectorID); err != nil {
 			return "", err
 		}
-		if err := r.updateConnectorHash(ctx, connector); err != nil {
+		if err := r.updateConnectorHash(ctx, connector, secrets.hash); err != nil {
 			return "", err
 		}
 		if err := r.setCondition(ctx, connector, conditionTypeConnectorReady, metav1.ConditionTrue, ConnectorReasonSuccess, msgConnectorReady); err != nil {
@@ -54,12 +56,29 @@ func (r *FivetranConnectorReconciler) reconcileConnector(ctx context.Context, co
 		}
 		logger.Info("Connector created successfully", "connectorId", connectorID)
 	} else {
+		// Skip the update when Fivetran already has the spec, as after adopting a connector.
+		// Forced reconciles always update, to push the spec again.
+		if !kubeutils.HasLabel(connector, annotationForceReconcile) {
+			changed, err := r.connectorChanges(ctx, connector, connectorID, secrets)
+			if err != nil {
+				return "", err
+			}
+			if len(changed) == 0 {
+				logger.Info("Fivetran connector already matches the spec, skipping update", "connectorId", connectorID)
+				if err := r.updateConnectorHash(ctx, connector, secrets.hash); err != nil {
+					return "", err
+				}
+				return connectorID, r.setCondition(ctx, connector, conditionTypeConnectorReady, metav1.ConditionTrue, ConnectorReasonSuccess, msgConnectorReady)
+			}
+			logger.Info("Fivetran connector differs from the spec", "connectorId", connectorID, "fields", changed)
+		}
+
 		// Update existing connector
 		updated, err := r.updateConnector(ctx, connector, connectorID, resolvedConfig, resolvedAuth)
 		if err != nil {
 			return "", err
 		}
-		if err := r.updateConnectorHash(ctx, connector); err != nil {
+		if err := r.updateConnectorHash(ctx, connector, secrets.hash); err != nil {
 			return "", err
 		}
 		if updated {
@@ -73,11 +92,12 @@ func (r *FivetranConnectorReconciler) reconcileConnector(ctx context.Context, co
 	return connectorID, nil
 }
 
-// createConnector creates a new Fivetran connector
+// createConnector creates a new Fivetran connector with the complete spec, but paused until
+// applyPauseState, so it does not sync before its setup tests pass and its schema config is applied
 func (r *FivetranConnectorReconciler) createConnector(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, resolvedConfig, resolvedAuth *runtime.RawExtension) (string, error) {
 	logger := log.FromContext(ctx)
 	logger.Info("Creating new Fivetran connector")
-	fivetranConnector, err := r.toFivetranConnector(connector, resolvedConfig, resolvedAuth)
+	fivetranConnector, err := ToFivetranConnector(connector, resolvedConfig, resolvedAuth)
 	if err != nil {
 		return "", err
 	}
@@ -90,15 +110,27 @@ func (r *FivetranConnectorReconciler) createConnector(ctx context.Context, conne
 	if err != nil {
 		return "", err
 	}
+	connectorOperationsTotal.WithLabelValues(operationCreate).Inc()
 
 	return resp.Data.ID, nil
 }
 
+// applyPauseState unpauses a connector created paused when the spec does not pause it
+func (r *FivetranConnectorReconciler) applyPauseState(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, connectorID string) error {
+	paused := connector.Spec.Connector.Paused
+	if paused == nil || *paused {
+		return nil
+	}
+	log.FromContext(ctx).Info("Unpausing Fivetran connector", "connectorId", connectorID)
+	_, err := r.FivetranClient.Connections.UpdateConnection(ctx, connectorID, &fivetran.Connector{Paused: paused})
+	return err
+}
+
 // updateConnector updates connector
 func (r *FivetranConnectorReconciler) updateConnector(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, connectorID string, resolvedConfig, resolvedAuth *runtime.RawExtension) (bool, error) {
 	logger := log.FromContext(ctx)
 	logger.Info("Updating Fivetran connector")
-	fivetranConnector, err := r.toFivetranConnector(connector, resolvedConfig, resolvedAuth)
+	fivetranConnector, err := ToFivetranConnector(connector, resolvedConfig, resolvedAuth)
 	if err != nil {
 		return false, err
 	}
@@ -109,6 +141,33 @@ func (r *FivetranConnectorReconciler) updateConnector(ctx context.Context, conne
 	return true, nil
 }
 
+// connectorChanges returns the fields an update of the connector would change in Fivetran. Secret
+// values Fivetran masks are taken to be applied when the config and auth last pushed resolved to
+// the same values and no dynamic credentials were issued.
+func (r *FivetranConnectorReconciler) connectorChanges(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, connectorID string, secrets *resolvedSecrets) ([]string, error) {
+	desired, err := ToFivetranConnector(connector, secrets.config, secrets.auth)
+	if err != nil {
+		return nil, err
+	}
+	var specConfig map[string]any
+	if config := connector.Spec.Connector.Config; config != nil && len(config.Raw) > 0 {
+		if err := json.Unmarshal(config.Raw, &specConfig); err != nil {
+			return nil, fmt.Errorf("connectorChanges: failed to unmarshal config: %w", err)
+		}
+	}
+
+	current, err := r.FivetranClient.Connections.GetConnection(ctx, connectorID)
+	if err != nil {
+		return nil, fmt.Errorf("connectorChanges: failed to get connector %s: %w", connectorID, err)
+	}
+	return fivetran.CompareConnector(current, desired, specConfig, secretsApplied(connector, secrets)), nil
+}
+
+// secretsApplied reports whether the resolved secrets are the ones last pushed to Fivetran
+func secretsApplied(connector *operatorv1alpha1.FivetranConnector, secrets *resolvedSecrets) bool {
+	return len(secrets.leases) == 0 && kubeutils.GetAnnotation(connector, annotationSecretsHash) == secrets.hash
+}
+
 // handleConnectorAdoption validates and adopts an existing Fivetran connector
 func (r *FivetranConnectorReconciler) handleExistingConnectorAdoption(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, adoptConnectorID string) error {
 	logger := log.FromContext(ctx)
@@ -137,27 +196,7 @@ func (r *FivetranConnectorReconciler) handleExistingConnectorAdoption(ctx contex
 	if connector.Spec.Connector.Config != nil {
 		var connectorConfig map[string]any
 		if err := json.Unmarshal(connector.Spec.Connector.Config.Raw, &connectorConfig); err == nil {
-			var expectedSchema string
-
-			// Determine the expected schema name based on connector configuration
-			// Priority order: schema_prefix > schema (with optional table/table_group_name suffix)
-			if prefix, ok := connectorConfig["schema_prefix"].(string); ok && prefix != "" {
-				// Use schema_prefix if explicitly set (highest priority)
-				expectedSchema = prefix
-			} else if schema, ok := connectorConfig["schema"].(string); ok && schema != "" {
-				// Use base schema name
-				expectedSchema = schema
-
-				// For single-table connectors, append table name to schema
-				if table, ok := connectorConfig["table"].(string); ok && table != "" {
-					expectedSchema = schema + "." + table
-				}
-
-				// For table group connectors, append table group name to schema
-				if tablegroupname, ok := connectorConfig["table_group_name"]; ok && tablegroupname != "" {
-					expectedSchema = schema + "." + tablegroupname.(string)
-				}
-			}
+			expectedSchema := fivetran.DestinationSchema(connectorConfig)
 
 			// Verify the expected schema matches the existing connector's actual schema
 			if expectedSchema != "" && expectedSchema != existingConnector.Data.Schema {
@@ -173,6 +212,7 @@ func (r *FivetranConnectorReconciler) handleExistingConnectorAdoption(ctx contex
 		return err
 	}
 
+	connectorOperationsTotal.WithLabelValues(operationAdopt).Inc()
 	logger.Info("Successfully adopted existing connector", "connectorID", adoptConnectorID,
 		"service", existingConnector.Data.Service, "groupID", existingConnector.Data.GroupID, "schema", existingConnector.Data.Schema)
 	return nil
@@ -183,12 +223,13 @@ func (r *FivetranConnectorReconciler) updateConnectorIDStatus(ctx context.Contex
 	logger := log.FromContext(ctx)
 	logger.Info("Updating connector ID status", "connectorID", connectorID)
 	connector.Status.ConnectorID = connectorID
-	connector.Status.ConnectorURL = fmt.Sprintf(fivetranConnectorURL, connectorID)
+	connector.Status.ConnectorURL = r.connectorURL(connector, connectorID)
 	return r.Status().Update(ctx, connector)
 }
 
-// updateConnectorHash updates only the connector hash annotation
-func (r *FivetranConnectorReconciler) updateConnectorHash(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) error {
+// updateConnectorHash updates the connector hash annotation and the hash of the secrets applied
+// with the spec
+func (r *FivetranConnectorReconciler) updateConnectorHash(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, secretsHash string) error {
 	logger := log.FromContext(ctx)
 	logger.Info("Updating connector hash")
 	hash, err := r.calculateConnectorHash(connector)
@@ -196,5 +237,6 @@ func (r *FivetranConnectorReconciler) updateConnectorHash(ctx context.Context, c
 		return err
 	}
 	kubeutils.SetAnnotation(connector, annotationConnectorHash, hash)
+	kubeutils.SetAnnotation(connector, annotationSecretsHash, secretsHash)
 	return r.Update(ctx, connector)
 }
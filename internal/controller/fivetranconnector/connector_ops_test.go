@@ -0,0 +1,148 @@
+package fivetranconnector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/internal/kubeutils"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/secrets"
+)
+
+// fakeSecretStore resolves the references of every registered scheme from a map keyed by reference
+type fakeSecretStore map[string]string
+
+func (s fakeSecretStore) registry(t *testing.T) *vault.Registry {
+	t.Helper()
+	registry := vault.NewRegistry()
+	for _, scheme := range []string{
+		secrets.SecretScheme,
+		secrets.ConfigMapScheme,
+		secrets.AWSSecretsManagerScheme,
+		secrets.AzureKeyVaultScheme,
+		secrets.GCPSecretManagerScheme,
+	} {
+		resolver := vault.SecretResolverFunc(func(_ context.Context, ref string) (any, error) {
+			value, ok := s[scheme+":"+ref]
+			if !ok {
+				return nil, fmt.Errorf("no value for %s:%s", scheme, ref)
+			}
+			return value, nil
+		})
+		if err := registry.Register(scheme, resolver); err != nil {
+			t.Fatalf("failed to register %s resolver: %v", scheme, err)
+		}
+	}
+	return registry
+}
+
+func connectorWithPassword(password string) *operatorv1alpha1.FivetranConnector {
+	return &operatorv1alpha1.FivetranConnector{
+		ObjectMeta: metav1.ObjectMeta{Name: "sales", Namespace: "connectors", UID: "4c2f1f7e"},
+		Spec: operatorv1alpha1.FivetranConnectorSpec{
+			Connector: operatorv1alpha1.Connector{
+				Config: &runtime.RawExtension{Raw: fmt.Appendf(nil, `{"host":"db.example.com","password":%q}`, password)},
+			},
+		},
+	}
+}
+
+func TestSecretsApplied(t *testing.T) {
+	type change struct {
+		from, to string
+		// update changes the store after the first value is pushed
+		update map[string]string
+	}
+	changes := map[string]change{
+		"plaintext to reference": {from: "hunter22", to: "{scheme}:db-creds#password"},
+		"reference to reference": {from: "{scheme}:db-creds#password", to: "{scheme}:db-creds-v2#password"},
+		"value behind reference": {
+			from:   "{scheme}:db-creds#password",
+			to:     "{scheme}:db-creds#password",
+			update: map[string]string{"db-creds#password": "rotated"},
+		},
+		"unchanged reference": {from: "{scheme}:db-creds#password", to: "{scheme}:db-creds#password"},
+	}
+
+	for _, scheme := range []string{
+		secrets.SecretScheme,
+		secrets.ConfigMapScheme,
+		secrets.AWSSecretsManagerScheme,
+		secrets.AzureKeyVaultScheme,
+		secrets.GCPSecretManagerScheme,
+	} {
+		for name, c := range changes {
+			t.Run(scheme+"/"+name, func(t *testing.T) {
+				store := fakeSecretStore{
+					scheme + ":db-creds#password":    "s3cret-1",
+					scheme + ":db-creds-v2#password": "s3cret-2",
+				}
+				r := &FivetranConnectorReconciler{SecretResolvers: store.registry(t)}
+				ctx := context.Background()
+				expand := func(value string) string {
+					return strings.ReplaceAll(value, "{scheme}", scheme)
+				}
+
+				connector := connectorWithPassword(expand(c.from))
+				pushed, err := r.resolveSecrets(ctx, &connectorVault{}, connector)
+				if err != nil {
+					t.Fatalf("failed to resolve secrets: %v", err)
+				}
+				kubeutils.SetAnnotation(connector, annotationSecretsHash, pushed.hash)
+
+				for ref, value := range c.update {
+					store[scheme+":"+ref] = value
+				}
+				next := connectorWithPassword(expand(c.to))
+				next.Annotations = connector.Annotations
+				resolved, err := r.resolveSecrets(ctx, &connectorVault{}, next)
+				if err != nil {
+					t.Fatalf("failed to resolve secrets: %v", err)
+				}
+
+				expected := c.from == c.to && c.update == nil
+				if applied := secretsApplied(next, resolved); applied != expected {
+					t.Errorf("expected secretsApplied %v, got %v", expected, applied)
+				}
+			})
+		}
+	}
+}
+
+func TestSecretsAppliedVaultVersionRecorded(t *testing.T) {
+	versions := []operatorv1alpha1.VaultSecretVersion{
+		{Mount: "fivetran", Path: "sales", Version: 3},
+		{Mount: "fivetran", Path: "sales-v2", Version: 1},
+	}
+	connector := connectorWithPassword("vault:fivetran/sales#password")
+	connector.Status.VaultSecretVersions = versions
+	kubeutils.SetAnnotation(connector, annotationSecretsHash,
+		calculateSecretsHash(connector, &runtime.RawExtension{Raw: []byte(`{"host":"db.example.com","password":"hunter22"}`)}, nil))
+
+	// The reference moves to another path whose version is already recorded
+	resolved := &resolvedSecrets{
+		config:   &runtime.RawExtension{Raw: []byte(`{"host":"db.example.com","password":"hunter23"}`)},
+		versions: versions,
+	}
+	resolved.hash = calculateSecretsHash(connector, resolved.config, resolved.auth)
+	if secretsApplied(connector, resolved) {
+		t.Error("expected secrets resolved from another path not to be applied")
+	}
+
+	resolved.config = &runtime.RawExtension{Raw: []byte(`{"host":"db.example.com","password":"hunter22"}`)}
+	resolved.hash = calculateSecretsHash(connector, resolved.config, resolved.auth)
+	if !secretsApplied(connector, resolved) {
+		t.Error("expected the secrets last pushed to be applied")
+	}
+
+	resolved.leases = []vault.SecretLease{{LeaseID: "database/creds/sales/1"}}
+	if secretsApplied(connector, resolved) {
+		t.Error("expected dynamic credentials never to be applied")
+	}
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/internal/kubeutils"
+)
+
+// reconcileReplacement replaces the Fivetran connector of a FivetranConnector annotated to be
+// replaced with a connector created from its changed spec, for changes Fivetran cannot apply in
+// place, such as a new destination schema. The replacement is created alongside the current
+// connector, which keeps syncing until the replacement completes its initial sync. The current
+// connector is then retired according to the deletion policy and the replacement takes its place.
+// It returns true while the replacement is in progress, so the changed spec is not applied to the
+// current connector.
+func (r *FivetranConnectorReconciler) reconcileReplacement(ctx context.Context, vaultClient *connectorVault, connector *operatorv1alpha1.FivetranConnector) (bool, error) {
+	replacement := connector.Status.Replacement
+	if replacement == nil {
+		if connector.Status.ConnectorID == "" || kubeutils.GetAnnotation(connector, annotationReplaceConnector) != "true" {
+			return false, nil
+		}
+		// Only a changed spec needs a new connector
+		connectorHashChanged, err := r.hasConnectorHashChanged(connector)
+		if err != nil || !connectorHashChanged {
+			return false, err
+		}
+		return true, r.startReplacement(ctx, vaultClient, connector)
+	}
+
+	start := time.Now()
+	defer observePhase(phaseConnector, start)
+
+	resp, err := r.FivetranClient.Connections.GetConnection(ctx, replacement.ConnectorID)
+	if err != nil {
+		return true, fmt.Errorf("reconcileReplacement: failed to get replacement connector %s: %w", replacement.ConnectorID, err)
+	}
+	data := resp.Data.DetailsResponseDataCommon
+	specPaused := connector.Spec.Connector.Paused != nil && *connector.Spec.Connector.Paused
+
+	// A replacement left paused was not configured completely, for example because its setup
+	// tests failed, and is configured again
+	if !specPaused && data.Paused != nil && *data.Paused {
+		secrets, err := r.resolveSecrets(ctx, vaultClient, connector)
+		if err != nil {
+			return true, err
+		}
+		return true, r.configureReplacement(ctx, vaultClient, connector, secrets, false)
+	}
+
+	// Wait for the initial sync; a paused connector never syncs, so its replacement takes over
+	// right away
+	if data.SucceededAt.IsZero() && !specPaused {
+		message := fmt.Sprintf(msgReplacementInProgressFormat, replacement.ConnectorID)
+		if existing := meta.FindStatusCondition(connector.Status.Conditions, conditionTypeConnectorReady); existing != nil &&
+			existing.Reason == ConnectorReasonReplacementInProgress && existing.Message == message {
+			return true, nil
+		}
+		return true, r.setCondition(ctx, connector, conditionTypeConnectorReady, metav1.ConditionTrue,
+			ConnectorReasonReplacementInProgress, message)
+	}
+
+	return false, r.completeReplacement(ctx, vaultClient, connector, data.SucceededAt)
+}
+
+// startReplacement creates the replacement connector from the spec, records it in the status and
+// configures it
+func (r *FivetranConnectorReconciler) startReplacement(ctx context.Context, vaultClient *connectorVault, connector *operatorv1alpha1.FivetranConnector) error {
+	start := time.Now()
+	defer observePhase(phaseConnector, start)
+
+	hash, err := r.calculateConnectorHash(connector)
+	if err != nil {
+		return err
+	}
+	secrets, err := r.resolveSecrets(ctx, vaultClient, connector)
+	if err != nil {
+		return err
+	}
+	connectorID, err := r.createConnector(ctx, connector, secrets.config, secrets.auth)
+	if err != nil {
+		revokeVaultLeases(ctx, vaultClient, secretLeaseIDs(secrets.leases))
+		return fmt.Errorf("startReplacement: failed to create replacement connector: %w", err)
+	}
+
+	connector.Status.Replacement = &operatorv1alpha1.ConnectorReplacement{
+		ConnectorID:   connectorID,
+		ConnectorHash: hash,
+		StartedAt:     metav1.Now(),
+		VaultLeases:   toVaultLeasesStatus(secrets.leases, time.Now()),
+	}
+	if err := r.Status().Update(ctx, connector); err != nil {
+		// A replacement that is not recorded would never be completed or cleaned up, and the next
+		// reconcile would create another one, so it is deleted
+		if deleteErr := r.deleteReplacement(ctx, vaultClient, connector); deleteErr != nil {
+			log.FromContext(ctx).Error(deleteErr, "failed to delete unrecorded replacement connector", "replacementID", connectorID)
+		}
+		connector.Status.Replacement = nil
+		return fmt.Errorf("startReplacement: failed to record replacement connector %s: %w", connectorID, err)
+	}
+	log.FromContext(ctx).Info("Created replacement Fivetran connector", "connectorID", connector.Status.ConnectorID,
+		"replacementID", connectorID)
+	r.normalEvent(ctx, connector, eventReasonReplacementStarted, fmt.Sprintf(
+		"Created connector %s to replace connector %s once it completes its initial sync", connectorID, connector.Status.ConnectorID))
+
+	return r.configureReplacement(ctx, vaultClient, connector, secrets, true)
+}
+
+// configureReplacement applies the schema config to the replacement connector, which is created
+// paused so its initial sync only covers the selected tables, runs its setup tests, then applies
+// the spec's pause state, along with the rest of the spec and the resolved secrets unless the
+// replacement was just created with them. The leases of the secrets are recorded for the
+// replacement, revoking the ones they replace.
+func (r *FivetranConnectorReconciler) configureReplacement(ctx context.Context, vaultClient *connectorVault, connector *operatorv1alpha1.FivetranConnector, secrets *resolvedSecrets, created bool) error {
+	connectorID := connector.Status.Replacement.ConnectorID
+	credentialsPushed := false
+	defer func() {
+		if !credentialsPushed {
+			revokeVaultLeases(ctx, vaultClient, secretLeaseIDs(secrets.leases))
+		}
+	}()
+
+	if r.hasSchemaConfig(connector) {
+		if err := r.reconcileSchema(ctx, connector, connectorID); err != nil {
+			return err
+		}
+	}
+	if _, err := r.reconcileSetupTests(ctx, connector, connectorID); err != nil {
+		return err
+	}
+	if created {
+		if err := r.applyPauseState(ctx, connector, connectorID); err != nil {
+			return err
+		}
+	} else if _, err := r.updateConnector(ctx, connector, connectorID, secrets.config, secrets.auth); err != nil {
+		return err
+	}
+	credentialsPushed = true
+
+	// The status updates of the steps above replaced the status, so the replacement is read again
+	replacement := connector.Status.Replacement
+	current := make(map[string]bool, len(secrets.leases))
+	for _, lease := range secrets.leases {
+		current[lease.LeaseID] = true
+	}
+	var replaced []string
+	for _, lease := range replacement.VaultLeases {
+		if !current[lease.LeaseID] {
+			replaced = append(replaced, lease.LeaseID)
+		}
+	}
+	if len(replaced) == 0 && len(replacement.VaultLeases) == len(secrets.leases) {
+		return nil
+	}
+	replacement.VaultLeases = toVaultLeasesStatus(secrets.leases, time.Now())
+	if err := r.Status().Update(ctx, connector); err != nil {
+		return err
+	}
+	revokeVaultLeases(ctx, vaultClient, replaced)
+	return nil
+}
+
+// completeReplacement retires the current connector according to the deletion policy and switches
+// the status to the replacement, which last synced successfully at syncedAt
+func (r *FivetranConnectorReconciler) completeReplacement(ctx context.Context, vaultClient *connectorVault, connector *operatorv1alpha1.FivetranConnector, syncedAt time.Time) error {
+	replacement := connector.Status.Replacement
+	retiredID := connector.Status.ConnectorID
+
+	// The annotation is removed before the switch, so a switch that fails is retried rather than
+	// starting another replacement
+	kubeutils.SetAnnotation(connector, annotationConnectorHash, replacement.ConnectorHash)
+	kubeutils.RemoveAnnotation(connector, annotationReplaceConnector)
+	if err := r.Update(ctx, connector); err != nil {
+		return err
+	}
+
+	if retiredID != "" {
+		if err := r.retireConnector(ctx, vaultClient, connector); err != nil {
+			return err
+		}
+	}
+
+	connector.Status.ConnectorID = replacement.ConnectorID
+	connector.Status.ConnectorURL = r.connectorURL(connector, replacement.ConnectorID)
+	connector.Status.VaultLeases = replacement.VaultLeases
+	connector.Status.Replacement = nil
+	// The sync history is the replacement's from now on
+	connector.Status.LastSyncError = nil
+	connector.Status.LastSyncedAt = nil
+	if !syncedAt.IsZero() {
+		lastSyncedAt := metav1.NewTime(syncedAt)
+		connector.Status.LastSyncedAt = &lastSyncedAt
+	}
+	if err := r.Status().Update(ctx, connector); err != nil {
+		return err
+	}
+
+	log.FromContext(ctx).Info("Replaced Fivetran connector", "retiredID", retiredID, "connectorID", connector.Status.ConnectorID)
+	r.normalEvent(ctx, connector, eventReasonConnectorReplaced, fmt.Sprintf(
+		"Connector %s replaced connector %s, which was retired with deletion policy %s",
+		connector.Status.ConnectorID, retiredID, deletionPolicy(connector)))
+	return r.setCondition(ctx, connector, conditionTypeConnectorReady, metav1.ConditionTrue, ConnectorReasonSuccess, msgConnectorReady)
+}
+
+// deleteReplacement deletes the replacement connector of a FivetranConnector being deleted with
+// the Delete deletion policy and revokes its dynamic credentials
+func (r *FivetranConnectorReconciler) deleteReplacement(ctx context.Context, vaultClient *connectorVault, connector *operatorv1alpha1.FivetranConnector) error {
+	replacement := connector.Status.Replacement
+	if replacement == nil {
+		return nil
+	}
+	if _, err := r.FivetranClient.Connections.DeleteConnection(ctx, replacement.ConnectorID); err != nil {
+		return fmt.Errorf("deleteReplacement: failed to delete replacement connector %s: %w", replacement.ConnectorID, err)
+	}
+	connectorOperationsTotal.WithLabelValues(operationDelete).Inc()
+	log.FromContext(ctx).Info("Deleted replacement Fivetran connector", "connectorID", replacement.ConnectorID)
+
+	leaseIDs := make([]string, 0, len(replacement.VaultLeases))
+	for _, lease := range replacement.VaultLeases {
+		leaseIDs = append(leaseIDs, lease.LeaseID)
+	}
+	revokeVaultLeases(ctx, vaultClient, leaseIDs)
+	return nil
+}
@@ -0,0 +1,45 @@
+package fivetranconnector
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/fivetranfake"
+)
+
+func TestStartReplacementDeletesUnrecordedConnector(t *testing.T) {
+	connector := connectorWithPassword("hunter22")
+	connector.Status.ConnectorID = "connection_current"
+	k8sClient := fake.NewClientBuilder().WithScheme(testScheme(t)).
+		WithObjects(connector).
+		WithStatusSubresource(connector).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(context.Context, client.Client, string, client.Object, ...client.SubResourceUpdateOption) error {
+				return errors.New("the object has been modified")
+			},
+		}).
+		Build()
+	connections := fivetranfake.NewConnections()
+	r := &FivetranConnectorReconciler{Client: k8sClient, FivetranClient: fivetranfake.NewClient(connections, nil)}
+
+	if err := r.startReplacement(context.Background(), &connectorVault{}, connector); err == nil {
+		t.Fatal("expected an error when the replacement cannot be recorded")
+	}
+
+	created := connections.CallsTo("CreateConnection")
+	deleted := connections.CallsTo("DeleteConnection")
+	if len(created) != 1 || len(deleted) != 1 {
+		t.Fatalf("expected the replacement to be created and deleted once, got %d creates and %d deletes", len(created), len(deleted))
+	}
+	if _, ok := connections.Get("connection_1"); ok {
+		t.Error("expected the unrecorded replacement connector to be deleted")
+	}
+	if connector.Status.Replacement != nil {
+		t.Errorf("expected no replacement in the status, got %+v", connector.Status.Replacement)
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/internal/kubeutils"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+const (
+	snapshotKeyConnector = "connector.json"
+	snapshotKeySchemas   = "connectorSchemas.json"
+)
+
+// snapshotConnector stores the config of a connector about to be deleted, with its sensitive values
+// masked, and its schema config in a ConfigMap named by snapshotName, so a connector deleted by
+// accident can be recreated as it was. The ConfigMap has no owner, so it outlives the
+// FivetranConnector.
+func (r *FivetranConnectorReconciler) snapshotConnector(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) error {
+	connectorID := connector.Status.ConnectorID
+	resp, err := r.FivetranClient.Connections.GetConnection(ctx, connectorID)
+	if err != nil {
+		return fmt.Errorf("snapshotConnector: failed to get connector %s: %w", connectorID, err)
+	}
+	details := resp.Data
+	details.Config, err = fivetran.RedactConfig(details.Config)
+	if err != nil {
+		return fmt.Errorf("snapshotConnector: failed to redact config: %w", err)
+	}
+	connectorJSON, err := json.MarshalIndent(details, "", "  ")
+	if err != nil {
+		return fmt.Errorf("snapshotConnector: failed to encode connector: %w", err)
+	}
+	data := map[string]string{snapshotKeyConnector: string(connectorJSON)}
+
+	// Connectors without tables, such as webhooks, have no schema config
+	schemaDetails, err := r.FivetranClient.Schemas.GetSchemaDetails(ctx, connectorID)
+	if err != nil && schemaDetails.Code != SchemaNotFoundError {
+		return fmt.Errorf("snapshotConnector: failed to get schema details: %w", err)
+	}
+	if err == nil {
+		schemasJSON, err := json.MarshalIndent(fivetran.SchemaConfigFromResponse(schemaDetails), "", "  ")
+		if err != nil {
+			return fmt.Errorf("snapshotConnector: failed to encode schema config: %w", err)
+		}
+		data[snapshotKeySchemas] = string(schemasJSON)
+	}
+
+	snapshot := &corev1.ConfigMap{}
+	snapshot.Name = snapshotName(connector.Name, connectorID)
+	snapshot.Namespace = connector.Namespace
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, snapshot, func() error {
+		kubeutils.SetLabel(snapshot, labelSnapshotOf, connector.Name)
+		kubeutils.SetAnnotation(snapshot, annotationSnapshotConnectorID, connectorID)
+		kubeutils.SetAnnotation(snapshot, annotationSnapshotTakenAt, time.Now().UTC().Format(time.RFC3339))
+		snapshot.Data = data
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("snapshotConnector: %w", err)
+	}
+	log.FromContext(ctx).Info("Stored snapshot of Fivetran connector", "connectorID", connectorID,
+		"configMap", snapshot.Name, "operation", result)
+	r.normalEvent(ctx, connector, eventReasonSnapshotStored, fmt.Sprintf(
+		"Stored the config of connector %s in ConfigMap %s before deleting it", connectorID, snapshot.Name))
+	return nil
+}
+
+// snapshotName returns the name of the snapshot ConfigMap of a connector, which is unique to the
+// connector ID so that snapshots of earlier connectors of the same FivetranConnector are kept
+func snapshotName(name, connectorID string) string {
+	return name + "-snapshot-" + strings.ToLower(strings.ReplaceAll(connectorID, "_", "-"))
+}
+
+// restoreFromSnapshotIfNeeded merges the schema config of the snapshot named by the
+// restore-from-snapshot annotation into the spec of a FivetranConnector whose connector is yet to
+// be created, so the connector comes back with the table selection of the one the snapshot was
+// taken of. The schemas and tables the spec configures are kept. The annotation is removed with the
+// same update, and it returns true when the spec was updated.
+func (r *FivetranConnectorReconciler) restoreFromSnapshotIfNeeded(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) (bool, error) {
+	name := kubeutils.GetAnnotation(connector, annotationRestoreFromSnapshot)
+	if name == "" || connector.Status.ConnectorID != "" {
+		return false, nil
+	}
+
+	snapshot := &corev1.ConfigMap{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: connector.Namespace, Name: name}, snapshot); err != nil {
+		return false, fmt.Errorf("restoreFromSnapshot: failed to get snapshot %s: %w", name, err)
+	}
+	// A snapshot of a connector without a schema has nothing to restore
+	if data, ok := snapshot.Data[snapshotKeySchemas]; ok {
+		schemas := &operatorv1alpha1.ConnectorSchemaConfig{}
+		if err := json.Unmarshal([]byte(data), schemas); err != nil {
+			return false, fmt.Errorf("restoreFromSnapshot: failed to decode %s of snapshot %s: %w", snapshotKeySchemas, name, err)
+		}
+		connector.Spec.ConnectorSchemas = fivetran.MergeSchemaConfig(connector.Spec.ConnectorSchemas, schemas)
+	}
+	kubeutils.RemoveAnnotation(connector, annotationRestoreFromSnapshot)
+	if err := r.Update(ctx, connector); err != nil {
+		return false, err
+	}
+
+	log.FromContext(ctx).Info("Restored schema config from snapshot", "configMap", name,
+		"snapshotConnectorID", kubeutils.GetAnnotation(snapshot, annotationSnapshotConnectorID))
+	r.normalEvent(ctx, connector, eventReasonSnapshotRestored, fmt.Sprintf(
+		"Merged the schema config of snapshot %s into the spec", name))
+	return true, nil
+}
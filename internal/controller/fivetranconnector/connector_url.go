@@ -0,0 +1,63 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+const (
+	// DefaultConnectorURLTemplate links to a connector in the Fivetran dashboard
+	DefaultConnectorURLTemplate = "https://fivetran.com/dashboard/connectors/" + connectorIDPlaceholder
+
+	connectorIDPlaceholder = "{connectorId}"
+	groupIDPlaceholder     = "{groupId}"
+)
+
+// ValidateConnectorURLTemplate checks that a connector URL template is an absolute URL naming the
+// connector
+func ValidateConnectorURLTemplate(template string) error {
+	if !strings.Contains(template, connectorIDPlaceholder) {
+		return fmt.Errorf("connector URL template %q must contain %s", template, connectorIDPlaceholder)
+	}
+	parsed, err := url.Parse(expandConnectorURL(template, "id", "group"))
+	if err != nil || !parsed.IsAbs() {
+		return fmt.Errorf("connector URL template %q must be an absolute URL", template)
+	}
+	return nil
+}
+
+// connectorURL returns the dashboard URL of the connector from the operator's URL template
+func (r *FivetranConnectorReconciler) connectorURL(connector *operatorv1alpha1.FivetranConnector, connectorID string) string {
+	template := r.ConnectorURLTemplate
+	if template == "" {
+		template = DefaultConnectorURLTemplate
+	}
+	return expandConnectorURL(template, connectorID, connector.Spec.Connector.GroupID)
+}
+
+// expandConnectorURL replaces the placeholders of a connector URL template
+func expandConnectorURL(template, connectorID, groupID string) string {
+	return strings.NewReplacer(
+		connectorIDPlaceholder, url.PathEscape(connectorID),
+		groupIDPlaceholder, url.PathEscape(groupID),
+	).Replace(template)
+}
@@ -16,23 +16,44 @@ limitations under the License.
 
 package fivetranconnector
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 const (
 	// Controller constants
-	fivetranFinalizer    = "fivetran.dataverse.redhat.com/finalizer"
-	fivetranConnectorURL = "https://fivetran.com/dashboard/connectors/%s"
+	fivetranFinalizer = "fivetran.dataverse.redhat.com/finalizer"
 
 	// Annotation constants
 	annotationForceReconcile           = "operator.dataverse.redhat.com/force-reconcile"
 	annotationConnectorHash            = "operator.dataverse.redhat.com/connector-hash"
+	annotationSecretsHash              = "operator.dataverse.redhat.com/secrets-hash"
 	annotationSchemaHash               = "operator.dataverse.redhat.com/schema-hash"
 	annotationAdoptExistingConnectorID = "operator.dataverse.redhat.com/adopt-existing-connector-id"
+	annotationMigrateConnector         = "operator.dataverse.redhat.com/migrate-connector"
+	annotationReplaceConnector         = "operator.dataverse.redhat.com/replace-connector"
+	annotationSnapshotConnectorID      = "operator.dataverse.redhat.com/snapshot-connector-id"
+	annotationSnapshotTakenAt          = "operator.dataverse.redhat.com/snapshot-taken-at"
+	annotationRestoreFromSnapshot      = "operator.dataverse.redhat.com/restore-from-snapshot"
+
+	// Label constants
+	labelNotifications    = "operator.dataverse.redhat.com/notifications"
+	notificationsDisabled = "disabled"
+	labelPriority         = "operator.dataverse.redhat.com/priority"
+	labelSnapshotOf       = "operator.dataverse.redhat.com/snapshot-of"
 
 	// Condition types
 	conditionTypeConnectorReady = "ConnectorReady"
 	conditionTypeSetupTestReady = "SetupTestReady"
 	conditionTypeSchemaReady    = "SchemaReady"
+	conditionTypeVaultReady     = "VaultReady"
+	conditionTypeConflict       = "Conflict"
+	// conditionTypeConnectorAlerts reflects the alerts Fivetran reports rather than the outcome of
+	// a reconcile
+	conditionTypeConnectorAlerts = "ConnectorAlerts"
+	// conditionTypeDataFresh reflects whether the connector's last successful sync meets its SLA
+	conditionTypeDataFresh = "DataFresh"
 
 	// Standard Kubernetes condition reasons
 	ConnectorReasonDeletionFailed                  = "DeletionFailed"
@@ -43,6 +64,21 @@ const (
 	ConnectorReasonVaultSecretsResolutionFailed    = "VaultSecretsResolutionFailed"
 	ConnectorReasonFivetranClientNotInitialized    = "FivetranClientNotInitialized"
 	ConnectorReasonExistingConnectorAdoptionFailed = "ExistingConnectorAdoptionFailed"
+	ConnectorReasonFivetranAPIUnavailable          = "FivetranAPIUnavailable"
+	ConnectorReasonMigrationFailed                 = "MigrationFailed"
+	ConnectorReasonConnectorIDConflict             = "ConnectorIDConflict"
+	ConnectorReasonReplacementFailed               = "ReplacementFailed"
+	ConnectorReasonReplacementInProgress           = "ReplacementInProgress"
+	ConnectorReasonSnapshotRestoreFailed           = "SnapshotRestoreFailed"
+
+	ConflictReasonConnectorIDClaimed = "ConnectorIDClaimed"
+
+	AlertsReasonNone         = "NoAlerts"
+	AlertsReasonOpenTasks    = "OpenTasks"
+	AlertsReasonOpenWarnings = "OpenWarnings"
+
+	DataFreshReasonWithinSLA   = "WithinSLA"
+	DataFreshReasonSLABreached = "SLABreached"
 
 	SetupTestsReasonReconciliationFailed              = "ReconciliationFailed"
 	SetupTestsReasonReconciliationSuccess             = "ReconciledSuccessfully"
@@ -53,11 +89,40 @@ const (
 	SchemaReasonReconciliationSuccess = "ReconciledSuccessfully"
 	SchemaReasonSkipped               = "Skipped"
 
+	VaultReasonAuthenticated              = "Authenticated"
+	VaultReasonClientInitializationFailed = "ClientInitializationFailed"
+	VaultReasonTokenRenewalFailed         = "TokenRenewalFailed"
+	VaultReasonInvalidReference           = "InvalidReference"
+	VaultReasonSecretNotFound             = "SecretNotFound"
+	VaultReasonKeyNotFound                = "KeyNotFound"
+	VaultReasonPermissionDenied           = "PermissionDenied"
+	VaultReasonSecretResolutionFailed     = "SecretResolutionFailed"
+
+	// Event reasons
+	eventReasonSetupTestFailed    = "SetupTestFailed"
+	eventReasonSetupTestWarning   = "SetupTestWarning"
+	eventReasonSchemaMismatch     = "SchemaMismatch"
+	eventReasonConnectorRetired   = "ConnectorRetired"
+	eventReasonReplacementStarted = "ReplacementStarted"
+	eventReasonConnectorReplaced  = "ConnectorReplaced"
+	eventReasonFivetranTask       = "FivetranTask"
+	eventReasonFivetranWarning    = "FivetranWarning"
+	eventReasonSnapshotStored     = "SnapshotStored"
+	eventReasonSnapshotRestored   = "SnapshotRestored"
+
 	SchemaNotFoundError = "NotFound_SchemaConfig"
 
 	envFivetranVaultSecretName = "FIVETRAN_VAULT_SECRET_NAME"
 	defaultVaultSecretName     = "fivetran-vault-secret"
 
+	// Vault lease constants
+	leaseRenewFraction      = 3 // renew leases once less than a third of their duration remains
+	minLeaseRenewalInterval = 10 * time.Second
+
+	// replacementPollInterval is how often a replacement connector is checked for the end of its
+	// initial sync
+	replacementPollInterval = time.Minute
+
 	// Setup test status constants
 	setupTestStatusPassed  = "PASSED"
 	setupTestStatusSkipped = "SKIPPED"
@@ -72,6 +137,13 @@ const (
 	msgSetupTestsSkipped               = "Setup tests skipped"
 	msgSchemaReady                     = "Schema configuration is ready"
 	msgSchemaSkipped                   = "No schema configuration specified"
+	msgVaultReady                      = "Vault client is authenticated"
+	msgSyncFailed                      = "Sync failed without details from Fivetran"
+	msgNoAlerts                        = "Fivetran reports no alerts"
+	msgReplacementInProgressFormat     = "Replacement connector %s is running its initial sync"
+	msgDataFreshFormat                 = "Last successful sync at %s is within %d minutes"
+	msgDataStaleFormat                 = "Last successful sync at %s is older than %d minutes"
+	msgDataNeverSyncedFormat           = "No successful sync yet; the SLA allows %d minutes from creation"
 )
 
 var (
@@ -79,4 +151,7 @@ var (
 	ErrVaultClientInitializationFailed = errors.New("failed to initialize vault client")
 	ErrSchemaMismatchAfterRetry        = errors.New("schema still mismatches CR after retry; possible schema config issue")
 	ErrSetupTestsFailed                = errors.New("setup tests failed")
+	ErrConnectorMigrationNotAllowed    = errors.New("group_id or service differs from the Fivetran connector; set the " +
+		annotationMigrateConnector + " annotation to \"true\" to replace it")
+	ErrConnectorIDConflict = errors.New("the Fivetran connector is managed by another FivetranConnector")
 )
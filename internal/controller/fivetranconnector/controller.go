@@ -20,69 +20,133 @@ import (
 	"context"
 	"fmt"
 	"os"
-
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
 	"github.com/redhat-data-and-ai/fivetran-operator/internal/kubeutils"
 	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/notify"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
 	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
 )
 
+// tracer creates the spans of reconciles
+var tracer = otel.Tracer("github.com/redhat-data-and-ai/fivetran-operator/internal/controller/fivetranconnector")
+
 // FivetranConnectorReconciler reconciles a FivetranConnector object
 type FivetranConnectorReconciler struct {
 	client.Client
 	Scheme         *runtime.Scheme
 	FivetranClient *fivetran.Client
-	VaultClient    *vaultpkg.VaultClient
+	// VaultClients provides the Vault client shared by concurrent reconciles
+	VaultClients *vaultpkg.ClientManager
+	// SecretResolvers resolves secret references of schemes other than vault: and vaultDynamic:
+	SecretResolvers *vault.Registry
+	// SecretAudit logs the secret references resolved for each connector, never their values
+	SecretAudit bool
+	// Recorder emits the events that detail setup test failures and schema mismatches
+	Recorder record.EventRecorder
+	// Notifier notifies of conditions turning False and of failed setup test runs
+	Notifier *notify.Notifier
+	// ConnectorURLTemplate is the dashboard URL of a connector, with {connectorId} and {groupId}
+	// placeholders; empty uses DefaultConnectorURLTemplate
+	ConnectorURLTemplate string
+	// WebhookEvents enqueues the FivetranConnectors an EventReceiver received Fivetran webhook events
+	// for; nil watches none
+	WebhookEvents <-chan event.GenericEvent
+	// ApplyWindow coalesces and spreads the reconciles of FivetranConnectors changed at once; the
+	// zero value reconciles each event right away
+	ApplyWindow ApplyWindow
 }
 
 // +kubebuilder:rbac:groups=operator.dataverse.redhat.com,namespace=fivetran-operator,resources=fivetranconnectors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.dataverse.redhat.com,namespace=fivetran-operator,resources=fivetranconnectors/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=operator.dataverse.redhat.com,namespace=fivetran-operator,resources=fivetranconnectors/finalizers,verbs=update
-// +kubebuilder:rbac:groups="",namespace=fivetran-operator,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=fivetran-operator,resources=secrets,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups="",namespace=fivetran-operator,resources=configmaps,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups="",namespace=fivetran-operator,resources=events,verbs=create;patch
 
 func (r *FivetranConnectorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// Mask resolved secret values in every log line and condition message of this reconcile
+	redactor := redact.New()
+	ctx = redact.IntoContext(ctx, redactor)
+	ctx = log.IntoContext(ctx, redact.NewLogger(log.FromContext(ctx), redactor))
+
+	ctx, span := tracer.Start(ctx, "FivetranConnector.Reconcile", trace.WithAttributes(
+		attribute.String("k8s.namespace.name", req.Namespace), attribute.String("fivetranconnector.name", req.Name)))
+	defer span.End()
+
 	logger := log.FromContext(ctx)
 	logger.Info("Starting reconciliation")
 
 	connector := &operatorv1alpha1.FivetranConnector{}
 	if err := r.Get(ctx, req.NamespacedName, connector); err != nil {
+		if apierrors.IsNotFound(err) {
+			forgetConnector(req.NamespacedName)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	defer observeManagedConnector(connector)
+	// The connector ID is only known after the connector is created or adopted
+	defer func() {
+		span.SetAttributes(attribute.String("fivetran.connector_id", connector.Status.ConnectorID))
+	}()
 
 	// Validate fivetran client
 	if r.FivetranClient == nil {
 		return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonFivetranClientNotInitialized, ErrFivetranClientNotInitialized)
 	}
 
-	// Initialize vault client if it's not present or if the token is not valid
-	if r.VaultClient == nil || !vaultpkg.IsTokenValid(r.VaultClient, 300) {
-		logger.Info("vault client is not initialized or expired, initializing new client")
-		vaultSecretName := os.Getenv(envFivetranVaultSecretName)
-		if vaultSecretName == "" {
-			vaultSecretName = defaultVaultSecretName
-		}
-		vaultClient, err := vaultpkg.InitializeVaultClientFromSecret(ctx, r.Client, req.Namespace, vaultSecretName)
-		if err != nil {
-			err = fmt.Errorf("%w: %w", ErrVaultClientInitializationFailed, err)
+	// Get a valid vault client when the connector uses Vault, initializing it if it's not present or
+	// the token is not valid. Connectors without Vault references or leases never need one.
+	vaultClient := &connectorVault{clients: r.VaultClients, connector: connector}
+	if usesVault(connector) {
+		if _, err := vaultClient.Client(ctx); err != nil {
+			if condErr := r.updateVaultReadyCondition(ctx, connector, metav1.ConditionFalse, VaultReasonClientInitializationFailed, err.Error()); condErr != nil {
+				logger.Error(condErr, "failed to update vault ready condition")
+			}
 			return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonVaultClientInitializationFailed, err)
 		}
-		r.VaultClient = vaultClient
-		logger.Info("vault client initialized successfully")
+
+		// Surface token renewal problems without failing the reconcile while the token is still valid
+		if renewalErr := connectorVaultRenewalErr(r.VaultClients, connector); renewalErr != nil {
+			if err := r.updateVaultReadyCondition(ctx, connector, metav1.ConditionFalse, VaultReasonTokenRenewalFailed, renewalErr.Error()); err != nil {
+				return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
+			}
+		} else if vaultReady := meta.FindStatusCondition(connector.Status.Conditions, conditionTypeVaultReady); vaultReady == nil || !isVaultSecretResolutionReason(vaultReady.Reason) {
+			// A secret resolution failure is kept until secrets are resolved again
+			if err := r.updateVaultReadyCondition(ctx, connector, metav1.ConditionTrue, VaultReasonAuthenticated, msgVaultReady); err != nil {
+				return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
+			}
+		}
 	}
 
 	// Handle deletion
 	if !connector.DeletionTimestamp.IsZero() {
-		if err := r.handleDeletion(ctx, connector); err != nil {
+		if err := r.handleDeletion(ctx, vaultClient, connector); err != nil {
 			return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonDeletionFailed, err)
 		}
+		forgetConnector(req.NamespacedName)
 		return ctrl.Result{}, nil
 	}
 
@@ -103,23 +167,93 @@ func (r *FivetranConnectorReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// Complete the schema config of a recreated connector from the snapshot of the deleted one
+	restored, err := r.restoreFromSnapshotIfNeeded(ctx, connector)
+	if err != nil {
+		return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonSnapshotRestoreFailed, err)
+	}
+	if restored {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Leave a Fivetran connector claimed by an older FivetranConnector to it
+	if err := r.reconcileConnectorIDConflict(ctx, connector); err != nil {
+		return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonConnectorIDConflict, err)
+	}
+
+	// Replace the connector with one created alongside it when annotated to, leaving the current
+	// connector as it is until the replacement takes over
+	replacing, err := r.reconcileReplacement(ctx, vaultClient, connector)
+	if err != nil {
+		return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReplacementFailed, err)
+	}
+	if replacing {
+		return ctrl.Result{RequeueAfter: replacementPollInterval}, nil
+	}
+
+	// Retire the connector when group_id or service moved it, so the next reconcile creates one
+	migrated, err := r.migrateConnectorIfNeeded(ctx, vaultClient, connector)
+	if err != nil {
+		return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonMigrationFailed, err)
+	}
+	if migrated {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Renew dynamic credential leases, re-issuing the credentials when a lease can no longer be renewed
+	reissueCredentials, err := r.renewVaultLeases(ctx, vaultClient, connector)
+	if err != nil {
+		return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
+	}
+
 	// Determine what needs to be reconciled
 	reconcileConnector, reconcileSchema, err := r.determineReconciliationNeeds(ctx, connector, forceReconcile)
 	if err != nil {
 		return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
 	}
+	if reissueCredentials {
+		reconcileConnector = true
+	}
+	publishConnectCard := connectCardOutdated(connector)
+	publishSSHPublicKey := sshPublicKeyOutdated(connector)
 
 	// Early return if nothing to do
-	if !reconcileConnector && !reconcileSchema {
+	if !reconcileConnector && !reconcileSchema && !publishConnectCard && !publishSSHPublicKey {
 		logger.Info("No changes detected and no failures, skipping reconcile")
-		return ctrl.Result{}, nil
+		if err := r.reconcileConnectionDetails(ctx, connector); err != nil {
+			return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
+		}
+		if err := r.updateLastReconcileTime(ctx, connector); err != nil {
+			return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
+		}
+		observeReconcileSuccess(connector)
+		return ctrl.Result{RequeueAfter: nextLeaseRenewal(connector, time.Now())}, nil
 	}
 
 	// Resolve secrets
-	resolvedConfig, resolvedAuth, err := r.resolveSecrets(ctx, connector)
+	phaseStart := time.Now()
+	secrets, err := r.resolveSecrets(ctx, vaultClient, connector)
+	observePhase(phaseSecretResolution, phaseStart)
 	if err != nil {
+		if condErr := r.updateVaultReadyCondition(ctx, connector, metav1.ConditionFalse, vaultSecretResolutionReason(err), err.Error()); condErr != nil {
+			logger.Error(condErr, "failed to update vault ready condition")
+		}
 		return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonVaultSecretsResolutionFailed, err)
 	}
+	// A token renewal failure is kept until the token renews, so VaultReady does not flap
+	if vaultClient.client != nil && connectorVaultRenewalErr(r.VaultClients, connector) == nil {
+		if err := r.updateVaultReadyCondition(ctx, connector, metav1.ConditionTrue, VaultReasonAuthenticated, msgVaultReady); err != nil {
+			return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
+		}
+	}
+
+	// Revoke the dynamic credentials issued for this reconcile unless they reach Fivetran
+	credentialsPushed := false
+	defer func() {
+		if !credentialsPushed {
+			revokeVaultLeases(ctx, vaultClient, secretLeaseIDs(secrets.leases))
+		}
+	}()
 
 	// Get connector ID for operations that need it
 	var connectorID string
@@ -127,16 +261,49 @@ func (r *FivetranConnectorReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		connectorID = connector.Status.ConnectorID
 	}
 
+	// Publish the group's SSH public key before the connector is created, so it can be authorized
+	// on the tunnel host before setup tests connect through it
+	if reconcileConnector || publishSSHPublicKey {
+		if err := r.reconcileSSHPublicKey(ctx, connector); err != nil {
+			return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
+		}
+	}
+
 	// Reconcile connector if needed
 	var setupTestWarnings []string
+	created := false
 	if reconcileConnector {
-		connectorID, err = r.reconcileConnector(ctx, connector, resolvedConfig, resolvedAuth)
+		created = connectorID == ""
+		phaseStart = time.Now()
+		connectorID, err = r.reconcileConnector(ctx, connector, secrets)
+		observePhase(phaseConnector, phaseStart)
 		if err != nil {
+			// Read the secrets from Vault again in case Fivetran rejected a stale cached copy
+			vault.InvalidateCached(vaultClient.client, secrets.cacheKeys)
 			return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
 		}
+		credentialsPushed = true
 
+		// Record the leases of the credentials now in use, revoking the ones they replaced
+		if err := r.updateVaultLeasesStatus(ctx, vaultClient, connector, secrets.leases); err != nil {
+			return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
+		}
+	}
+
+	// Publish a new Connect Card whenever the connector changed, before the setup tests that fail
+	// until its authorization is completed
+	if (reconcileConnector || publishConnectCard) && connectorID != "" {
+		if err := r.reconcileConnectCard(ctx, connector, connectorID); err != nil {
+			return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
+		}
+	}
+
+	if reconcileConnector {
+		phaseStart = time.Now()
 		setupTestWarnings, err = r.reconcileSetupTests(ctx, connector, connectorID)
+		observePhase(phaseSetupTests, phaseStart)
 		if err != nil {
+			vault.InvalidateCached(vaultClient.client, secrets.cacheKeys)
 			return r.handleError(ctx, connector, conditionTypeSetupTestReady, SetupTestsReasonReconciliationFailed, err)
 		}
 
@@ -147,7 +314,10 @@ func (r *FivetranConnectorReconciler) Reconcile(ctx context.Context, req ctrl.Re
 
 	// Configure schema if needed
 	if reconcileSchema && r.hasSchemaConfig(connector) {
-		if err := r.reconcileSchema(ctx, connector, connectorID); err != nil {
+		phaseStart = time.Now()
+		err := r.reconcileSchema(ctx, connector, connectorID)
+		observePhase(phaseSchema, phaseStart)
+		if err != nil {
 			return r.handleError(ctx, connector, conditionTypeSchemaReady, SchemaReasonReconciliationFailed, err)
 		}
 	} else {
@@ -156,12 +326,19 @@ func (r *FivetranConnectorReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		}
 	}
 
-	// Update connector again to set ScheduleType and pause state
-	// This is needed because ScheduleType is not available in createconnector API
 	if reconcileConnector {
-		logger.Info("Updating connector again to set ScheduleType and pause state")
-		_, err = r.updateConnector(ctx, connector, connectorID, resolvedConfig, resolvedAuth)
-		if err != nil {
+		// Unpause a created connector once its setup tests passed and its schema config is applied
+		if created {
+			phaseStart = time.Now()
+			err = r.applyPauseState(ctx, connector, connectorID)
+			observePhase(phaseConnector, phaseStart)
+			if err != nil {
+				return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
+			}
+		}
+
+		// Record which secret versions were applied
+		if err := r.updateVaultSecretVersionsStatus(ctx, connector, secrets.versions); err != nil {
 			return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
 		}
 	}
@@ -171,12 +348,94 @@ func (r *FivetranConnectorReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
 	}
 
+	// Publish the connection details for downstream jobs
+	if err := r.reconcileConnectionDetails(ctx, connector); err != nil {
+		return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
+	}
+
+	if err := r.updateLastReconcileTime(ctx, connector); err != nil {
+		return r.handleError(ctx, connector, conditionTypeConnectorReady, ConnectorReasonReconciliationFailed, err)
+	}
 	logger.Info("Reconciliation completed")
-	return ctrl.Result{}, nil
+	observeReconcileSuccess(connector)
+	return ctrl.Result{RequeueAfter: nextLeaseRenewal(connector, time.Now())}, nil
+}
+
+// VaultSecretName returns the name of the operator-wide Vault connection secret
+func VaultSecretName() string {
+	if name := os.Getenv(envFivetranVaultSecretName); name != "" {
+		return name
+	}
+	return defaultVaultSecretName
+}
+
+// vaultClientRef returns the Vault client configuration selected by a connector's spec.vaultRef,
+// or false when the connector uses the operator-wide client
+func vaultClientRef(connector *operatorv1alpha1.FivetranConnector) (vaultpkg.ClientRef, bool) {
+	ref := connector.Spec.VaultRef
+	if ref == nil {
+		return vaultpkg.ClientRef{}, false
+	}
+	return vaultpkg.ClientRef{
+		Namespace:      connector.Namespace,
+		SecretName:     ref.SecretName,
+		MountPath:      ref.MountPath,
+		VaultNamespace: ref.Namespace,
+		Role:           ref.Role,
+	}, true
+}
+
+// ConnectorVaultClient returns the Vault client resolving a connector's secrets
+func ConnectorVaultClient(ctx context.Context, clients *vaultpkg.ClientManager, connector *operatorv1alpha1.FivetranConnector) (*vaultpkg.VaultClient, error) {
+	if ref, ok := vaultClientRef(connector); ok {
+		return clients.ClientFor(ctx, ref)
+	}
+	return clients.Client(ctx, connector.Namespace, VaultSecretName())
+}
+
+// connectorVault gets the Vault client of a connector the first time it is needed, so connectors
+// that neither reference Vault nor hold Vault leases reconcile without one
+type connectorVault struct {
+	clients   *vaultpkg.ClientManager
+	connector *operatorv1alpha1.FivetranConnector
+	client    *vaultpkg.VaultClient
+}
+
+// Client returns the connector's Vault client, initializing it on first use
+func (v *connectorVault) Client(ctx context.Context) (*vaultpkg.VaultClient, error) {
+	if v.client != nil {
+		return v.client, nil
+	}
+	client, err := ConnectorVaultClient(ctx, v.clients, v.connector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrVaultClientInitializationFailed, err)
+	}
+	v.client = client
+	return client, nil
+}
+
+// usesVault reports whether a connector references Vault in its config or auth, or holds leases
+// of dynamic credentials, itself or through its replacement
+func usesVault(connector *operatorv1alpha1.FivetranConnector) bool {
+	if len(connector.Status.VaultLeases) > 0 {
+		return true
+	}
+	if replacement := connector.Status.Replacement; replacement != nil && len(replacement.VaultLeases) > 0 {
+		return true
+	}
+	return vault.ReferencesVault(connector.Spec.Connector.Config, connector.Spec.Connector.Auth)
+}
+
+// connectorVaultRenewalErr returns the last token renewal error of a connector's Vault client
+func connectorVaultRenewalErr(clients *vaultpkg.ClientManager, connector *operatorv1alpha1.FivetranConnector) error {
+	if ref, ok := vaultClientRef(connector); ok {
+		return clients.RenewalErrFor(ref)
+	}
+	return clients.RenewalErr()
 }
 
 // handleDeletion handles connector deletion
-func (r *FivetranConnectorReconciler) handleDeletion(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) error {
+func (r *FivetranConnectorReconciler) handleDeletion(ctx context.Context, vaultClient *connectorVault, connector *operatorv1alpha1.FivetranConnector) error {
 	logger := log.FromContext(ctx)
 	logger.Info("Handling deletion", "connector", connector.Name, "connectorId", connector.Status.ConnectorID)
 
@@ -184,12 +443,38 @@ func (r *FivetranConnectorReconciler) handleDeletion(ctx context.Context, connec
 		return nil
 	}
 
-	if connector.Status.ConnectorID != "" {
-		_, err := r.FivetranClient.Connections.DeleteConnection(ctx, connector.Status.ConnectorID)
+	if connector.Spec.DeletionPolicy == operatorv1alpha1.DeletionPolicyRetain {
+		// The retained connector keeps syncing with its dynamic credentials, so they are not revoked
+		logger.Info("Retaining Fivetran connector", "connectorID", connector.Status.ConnectorID)
+	} else {
+		// A connector claimed by another FivetranConnector is left to it
+		others, _, err := r.connectorIDClaims(ctx, connector)
 		if err != nil {
 			return err
 		}
-		logger.Info("Successfully deleted Fivetran connector", "connectorID", connector.Status.ConnectorID)
+		if len(others) > 0 {
+			logger.Info("Keeping Fivetran connector claimed by other FivetranConnectors",
+				"connectorID", connector.Status.ConnectorID, "others", others)
+		} else if connector.Status.ConnectorID != "" {
+			// The connector is only deleted once it can be recreated from its snapshot
+			if err := r.snapshotConnector(ctx, connector); err != nil {
+				return err
+			}
+			_, err = r.FivetranClient.Connections.DeleteConnection(ctx, connector.Status.ConnectorID)
+			if err != nil {
+				return err
+			}
+			connectorOperationsTotal.WithLabelValues(operationDelete).Inc()
+			logger.Info("Successfully deleted Fivetran connector", "connectorID", connector.Status.ConnectorID)
+		}
+
+		// The connector no longer uses its dynamic credentials
+		revokeVaultLeases(ctx, vaultClient, statusLeaseIDs(connector))
+
+		// A replacement still running its initial sync goes with the connector it was to replace
+		if err := r.deleteReplacement(ctx, vaultClient, connector); err != nil {
+			return err
+		}
 	}
 
 	controllerutil.RemoveFinalizer(connector, fivetranFinalizer)
@@ -205,9 +490,20 @@ func (r *FivetranConnectorReconciler) handleDeletion(ctx context.Context, connec
 // SetupWithManager sets up the controller with the Manager.
 func (r *FivetranConnectorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	// add a predicate to the controller to reconcile only when the generation of the CR changes or the force sync label is added
+	// Owned connection details objects never change generation, so only their creation and deletion reconcile
 	labelPredicate := kubeutils.CustomLabelKeyChangedPredicate{LabelKey: kubeutils.ForceReconcileLabel}
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &operatorv1alpha1.FivetranConnector{},
+		ConnectorIDIndexKey, connectorIDIndex); err != nil {
+		return fmt.Errorf("failed to index FivetranConnectors by connector ID: %w", err)
+	}
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&operatorv1alpha1.FivetranConnector{}).
-		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, labelPredicate)).
-		Complete(r)
+		Owns(&corev1.ConfigMap{}).
+		Owns(&corev1.Secret{}).
+		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, labelPredicate))
+	// Webhook events bypass the event filter, which only lets spec changes through
+	if r.WebhookEvents != nil {
+		builder = builder.WatchesRawSource(source.Channel(r.WebhookEvents, &handler.EnqueueRequestForObject{}))
+	}
+	return builder.WithOptions(controller.Options{NewQueue: r.newQueue}).Complete(r)
 }
@@ -0,0 +1,71 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// applyDataFreshness sets the DataFresh condition of a connector with spec.sla from when it last
+// synced successfully, and removes it from a connector without one. A connector that never synced
+// successfully is given the SLA's delay from its creation before it is stale. It returns whether
+// the conditions changed.
+func applyDataFreshness(connector *operatorv1alpha1.FivetranConnector, now time.Time) bool {
+	sla := connector.Spec.SLA
+	if sla == nil || sla.MaxDataDelayMinutes <= 0 {
+		return meta.RemoveStatusCondition(&connector.Status.Conditions, conditionTypeDataFresh)
+	}
+
+	maxDelay := time.Duration(sla.MaxDataDelayMinutes) * time.Minute
+	status, reason := metav1.ConditionTrue, DataFreshReasonWithinSLA
+	var message string
+	if synced := connector.Status.LastSyncedAt; synced != nil {
+		syncedAt := synced.UTC().Format(time.RFC3339)
+		message = fmt.Sprintf(msgDataFreshFormat, syncedAt, sla.MaxDataDelayMinutes)
+		if now.Sub(synced.Time) > maxDelay {
+			status, reason = metav1.ConditionFalse, DataFreshReasonSLABreached
+			message = fmt.Sprintf(msgDataStaleFormat, syncedAt, sla.MaxDataDelayMinutes)
+		}
+	} else {
+		message = fmt.Sprintf(msgDataNeverSyncedFormat, sla.MaxDataDelayMinutes)
+		if now.Sub(connector.CreationTimestamp.Time) > maxDelay {
+			status, reason = metav1.ConditionFalse, DataFreshReasonSLABreached
+		}
+	}
+
+	return meta.SetStatusCondition(&connector.Status.Conditions, metav1.Condition{
+		Type:    conditionTypeDataFresh,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+// isDataFresh reports whether a connector meets its SLA, and false for ok when it declares none
+func isDataFresh(connector *operatorv1alpha1.FivetranConnector) (fresh, ok bool) {
+	condition := meta.FindStatusCondition(connector.Status.Conditions, conditionTypeDataFresh)
+	if condition == nil {
+		return false, false
+	}
+	return condition.Status == metav1.ConditionTrue, true
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+)
+
+// Error codes recorded in status.lastError. They are part of the API: automation matches on them,
+// so existing codes must not change.
+const (
+	errorCodeFivetranClientNotInitialized = "FIVETRAN_CLIENT_NOT_INITIALIZED"
+	errorCodeFivetranRateLimited          = "FIVETRAN_RATE_LIMITED"
+	errorCodeFivetranUnavailable          = "FIVETRAN_UNAVAILABLE"
+	errorCodeFivetranUnauthorized         = "FIVETRAN_UNAUTHORIZED"
+	errorCodeFivetranNotFound             = "FIVETRAN_NOT_FOUND"
+	errorCodeFivetranInvalidRequest       = "FIVETRAN_INVALID_REQUEST"
+	errorCodeFivetranAPIError             = "FIVETRAN_API_ERROR"
+	errorCodeVaultClientInitFailed        = "VAULT_CLIENT_INIT_FAILED"
+	errorCodeVaultInvalidReference        = "VAULT_INVALID_REFERENCE"
+	errorCodeVaultSecretNotFound          = "VAULT_SECRET_NOT_FOUND"
+	errorCodeVaultKeyNotFound             = "VAULT_KEY_NOT_FOUND"
+	errorCodeVaultPermissionDenied        = "VAULT_PERMISSION_DENIED"
+	errorCodeVaultError                   = "VAULT_ERROR"
+	errorCodeSetupTestsFailed             = "SETUP_TESTS_FAILED"
+	errorCodeSchemaMismatch               = "SCHEMA_MISMATCH"
+	errorCodeMigrationNotAllowed          = "MIGRATION_NOT_ALLOWED"
+	errorCodeConnectorIDConflict          = "CONNECTOR_ID_CONFLICT"
+	errorCodeInternal                     = "INTERNAL"
+)
+
+// errorCode returns the error code of a reconcile error from its typed errors
+func errorCode(err error) string {
+	switch {
+	case errors.Is(err, ErrFivetranClientNotInitialized):
+		return errorCodeFivetranClientNotInitialized
+	case errors.Is(err, ErrVaultClientInitializationFailed):
+		return errorCodeVaultClientInitFailed
+	case errors.Is(err, ErrSetupTestsFailed):
+		return errorCodeSetupTestsFailed
+	case errors.Is(err, ErrSchemaMismatchAfterRetry):
+		return errorCodeSchemaMismatch
+	case errors.Is(err, ErrConnectorMigrationNotAllowed):
+		return errorCodeMigrationNotAllowed
+	case errors.Is(err, ErrConnectorIDConflict):
+		return errorCodeConnectorIDConflict
+	case errors.Is(err, fivetran.ErrCircuitOpen):
+		return errorCodeFivetranUnavailable
+	}
+
+	var vaultErr *vault.VaultError
+	if errors.As(err, &vaultErr) {
+		switch vaultSecretResolutionReason(err) {
+		case VaultReasonInvalidReference:
+			return errorCodeVaultInvalidReference
+		case VaultReasonSecretNotFound:
+			return errorCodeVaultSecretNotFound
+		case VaultReasonKeyNotFound:
+			return errorCodeVaultKeyNotFound
+		case VaultReasonPermissionDenied:
+			return errorCodeVaultPermissionDenied
+		default:
+			return errorCodeVaultError
+		}
+	}
+
+	if apiErr, ok := fivetran.AsAPIError(err); ok {
+		switch {
+		case apiErr.StatusCode == http.StatusTooManyRequests:
+			return errorCodeFivetranRateLimited
+		case apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden:
+			return errorCodeFivetranUnauthorized
+		case apiErr.StatusCode == http.StatusNotFound:
+			return errorCodeFivetranNotFound
+		case apiErr.StatusCode >= http.StatusInternalServerError:
+			return errorCodeFivetranUnavailable
+		case apiErr.StatusCode >= http.StatusBadRequest:
+			return errorCodeFivetranInvalidRequest
+		default:
+			return errorCodeFivetranAPIError
+		}
+	}
+	return errorCodeInternal
+}
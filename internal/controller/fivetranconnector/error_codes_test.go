@@ -0,0 +1,67 @@
+package fivetranconnector
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	vaultapi "github.com/hashicorp/vault/api"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+)
+
+func TestErrorCode(t *testing.T) {
+	apiError := func(status int) error {
+		return fmt.Errorf("updateConnector: %w", &fivetran.APIError{StatusCode: status, Code: "Code", Message: "message"})
+	}
+
+	// The codes are spelled out rather than taken from the constants, since changing one breaks
+	// automation matching on it
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{"fivetran client not initialized", ErrFivetranClientNotInitialized, "FIVETRAN_CLIENT_NOT_INITIALIZED"},
+		{"vault client init failed", fmt.Errorf("%w: login failed", ErrVaultClientInitializationFailed), "VAULT_CLIENT_INIT_FAILED"},
+		{"setup tests failed", fmt.Errorf("reconcileSetupTests: %w", ErrSetupTestsFailed), "SETUP_TESTS_FAILED"},
+		{"schema mismatch", ErrSchemaMismatchAfterRetry, "SCHEMA_MISMATCH"},
+		{"migration not allowed", ErrConnectorMigrationNotAllowed, "MIGRATION_NOT_ALLOWED"},
+		{"connector id conflict", ErrConnectorIDConflict, "CONNECTOR_ID_CONFLICT"},
+		{"circuit open", fmt.Errorf("getConnection: %w", fivetran.ErrCircuitOpen), "FIVETRAN_UNAVAILABLE"},
+		{"vault invalid reference", vault.NewInvalidReferenceError("config.password", "vault:sales", "missing key"), "VAULT_INVALID_REFERENCE"},
+		{"vault secret not found", vault.NewSecretNotFoundError("config.password", "vault:sales#password", "sales"), "VAULT_SECRET_NOT_FOUND"},
+		{"vault secret data nil", vault.NewSecretDataNilError("config.password", "vault:sales#password"), "VAULT_SECRET_NOT_FOUND"},
+		{"vault key not found", vault.NewKeyNotFoundError("config.password", "password", "sales", []string{"user"}), "VAULT_KEY_NOT_FOUND"},
+		{
+			"vault permission denied",
+			vault.NewVaultAPIError("config.password", "vault:sales#password", &vaultapi.ResponseError{StatusCode: http.StatusForbidden}),
+			"VAULT_PERMISSION_DENIED",
+		},
+		{
+			"vault api error",
+			vault.NewVaultAPIError("config.password", "vault:sales#password", &vaultapi.ResponseError{StatusCode: http.StatusInternalServerError}),
+			"VAULT_ERROR",
+		},
+		{"fivetran rate limited", apiError(http.StatusTooManyRequests), "FIVETRAN_RATE_LIMITED"},
+		{"fivetran unauthorized", apiError(http.StatusUnauthorized), "FIVETRAN_UNAUTHORIZED"},
+		{"fivetran forbidden", apiError(http.StatusForbidden), "FIVETRAN_UNAUTHORIZED"},
+		{"fivetran not found", apiError(http.StatusNotFound), "FIVETRAN_NOT_FOUND"},
+		{"fivetran server error", apiError(http.StatusInternalServerError), "FIVETRAN_UNAVAILABLE"},
+		{"fivetran bad gateway", apiError(http.StatusBadGateway), "FIVETRAN_UNAVAILABLE"},
+		{"fivetran bad request", apiError(http.StatusBadRequest), "FIVETRAN_INVALID_REQUEST"},
+		{"fivetran conflict", apiError(http.StatusConflict), "FIVETRAN_INVALID_REQUEST"},
+		{"fivetran other status", apiError(http.StatusMultipleChoices), "FIVETRAN_API_ERROR"},
+		{"untyped error", errors.New("failed to update status"), "INTERNAL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := errorCode(tt.err); code != tt.expected {
+				t.Errorf("expected code %s, got %s", tt.expected, code)
+			}
+		})
+	}
+}
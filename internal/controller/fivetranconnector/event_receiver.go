@@ -0,0 +1,200 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+// EventReceiverPath is the path of the webhook server that receives Fivetran webhook events
+const EventReceiverPath = "/fivetran/events"
+
+// maxEventBodyBytes bounds the body of a Fivetran webhook request, which is a small JSON object
+const maxEventBodyBytes = 1 << 20
+
+// Results of Fivetran webhook requests counted by webhookEventsTotal
+const (
+	eventResultRecorded         = "recorded"
+	eventResultUnmatched        = "unmatched"
+	eventResultInvalidSignature = "invalid_signature"
+	eventResultInvalid          = "invalid"
+	eventResultError            = "error"
+)
+
+// EventReceiver receives the events Fivetran sends to a webhook, verifies their signature, records
+// each as an event on the FivetranConnectors managing its connection, updates their sync status,
+// and enqueues them for reconciliation, so sync progress shows up without polling Fivetran
+type EventReceiver struct {
+	// Client finds the FivetranConnectors of a connection with the index registered by
+	// SetupWithManager, and updates their sync status
+	Client client.Client
+	// Recorder records the received events on the FivetranConnectors
+	Recorder record.EventRecorder
+	// Secret is the secret of the Fivetran webhook, which signs its requests
+	Secret []byte
+	// Enqueue is the channel the FivetranConnectorReconciler reads as WebhookEvents; nil enqueues none
+	Enqueue chan<- event.GenericEvent
+}
+
+var _ http.Handler = &EventReceiver{}
+
+// ServeHTTP handles a Fivetran webhook request. Events of connections no FivetranConnector manages
+// are acknowledged, so Fivetran does not retry them.
+func (e *EventReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	logger := log.FromContext(ctx).WithName("fivetran-events")
+
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxEventBodyBytes))
+	if err != nil {
+		observeWebhookEvent("", eventResultInvalid)
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+	received, err := fivetran.ParseWebhookEvent(body, req.Header.Get(fivetran.WebhookSignatureHeader), e.Secret)
+	if errors.Is(err, fivetran.ErrInvalidWebhookSignature) {
+		observeWebhookEvent("", eventResultInvalidSignature)
+		logger.Info("Rejected Fivetran webhook request with an invalid signature", "remoteAddr", req.RemoteAddr)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if err != nil {
+		observeWebhookEvent("", eventResultInvalid)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	connectors, err := ListByConnectorID(ctx, e.Client, received.ID())
+	if err != nil {
+		observeWebhookEvent(received.Event, eventResultError)
+		logger.Error(err, "failed to find FivetranConnectors of Fivetran webhook event", "connectorID", received.ID())
+		http.Error(w, "failed to find FivetranConnectors", http.StatusInternalServerError)
+		return
+	}
+	if len(connectors) == 0 {
+		observeWebhookEvent(received.Event, eventResultUnmatched)
+		logger.V(1).Info("Ignoring Fivetran webhook event of an unmanaged connector", "event", received.Event, "connectorID", received.ID())
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	for i := range connectors {
+		connector := &connectors[i]
+		e.recordEvent(connector, received)
+		if err := e.updateSyncStatus(ctx, connector, received); err != nil {
+			observeWebhookEvent(received.Event, eventResultError)
+			logger.Error(err, "failed to update sync status from Fivetran webhook event", "connector", connector.Name)
+			http.Error(w, "failed to update sync status", http.StatusInternalServerError)
+			return
+		}
+		// The status is already updated, so a reconcile that cannot be enqueued, as on replicas that
+		// are not the leader, is skipped rather than holding the request
+		if e.Enqueue != nil {
+			select {
+			case e.Enqueue <- event.GenericEvent{Object: connector}:
+			default:
+			}
+		}
+	}
+	observeWebhookEvent(received.Event, eventResultRecorded)
+	logger.Info("Recorded Fivetran webhook event", "event", received.Event, "connectorID", received.ID(), "connectors", len(connectors))
+	w.WriteHeader(http.StatusOK)
+}
+
+// updateSyncStatus records the sync status a Fivetran webhook event reports, and whether the data
+// meets its SLA, in the status of a FivetranConnector, retrying when a reconcile updated it concurrently
+func (e *EventReceiver) updateSyncStatus(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, webhookEvent *fivetran.WebhookEvent) error {
+	first := true
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if !first {
+			if err := e.Client.Get(ctx, client.ObjectKeyFromObject(connector), connector); err != nil {
+				return err
+			}
+		}
+		first = false
+		changed := applySyncEvent(connector, webhookEvent)
+		if applyDataFreshness(connector, time.Now()) {
+			changed = true
+		}
+		if !changed {
+			return nil
+		}
+		return e.Client.Status().Update(ctx, connector)
+	})
+}
+
+// recordEvent records a Fivetran webhook event on a FivetranConnector, as a Warning event when it
+// reports a failure
+func (e *EventReceiver) recordEvent(connector *operatorv1alpha1.FivetranConnector, event *fivetran.WebhookEvent) {
+	if e.Recorder == nil {
+		return
+	}
+	eventType := corev1.EventTypeNormal
+	if isFailureEvent(event) {
+		eventType = corev1.EventTypeWarning
+	}
+
+	message := fmt.Sprintf("Fivetran sent %s for connector %s", event.Event, event.ID())
+	if status := event.Status(); status != "" {
+		message += " with status " + status
+	}
+	if !event.Created.IsZero() {
+		message += " at " + event.Created.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	e.Recorder.Event(connector, eventType, webhookEventReason(event.Event), message)
+}
+
+// isFailureEvent reports whether a Fivetran webhook event reports a failure, such as a sync_end
+// with a FAILURE status or a connection_failure
+func isFailureEvent(event *fivetran.WebhookEvent) bool {
+	return strings.HasPrefix(event.Status(), "FAILURE") || strings.Contains(event.Event, "failure")
+}
+
+// webhookEventReason returns the event reason of a Fivetran webhook event, such as FivetranSyncEnd
+// for sync_end
+func webhookEventReason(event string) string {
+	var reason strings.Builder
+	reason.WriteString("Fivetran")
+	for _, word := range strings.Split(event, "_") {
+		if word == "" {
+			continue
+		}
+		reason.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return reason.String()
+}
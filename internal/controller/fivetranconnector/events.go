@@ -0,0 +1,53 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
+)
+
+// maxEventMessageLength is the number of bytes of an event message kept, matching the limit the
+// events API enforces on notes
+const maxEventMessageLength = 1024
+
+// warningEvent records a Warning event on the connector with resolved secrets masked in its message
+func (r *FivetranConnectorReconciler) warningEvent(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, reason, message string) {
+	r.event(ctx, connector, corev1.EventTypeWarning, reason, message)
+}
+
+// normalEvent records a Normal event on the connector with resolved secrets masked in its message
+func (r *FivetranConnectorReconciler) normalEvent(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, reason, message string) {
+	r.event(ctx, connector, corev1.EventTypeNormal, reason, message)
+}
+
+// event records an event on the connector with resolved secrets masked in its message
+func (r *FivetranConnectorReconciler) event(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, eventType, reason, message string) {
+	if r.Recorder == nil {
+		return
+	}
+	message = redact.FromContext(ctx).Redact(message)
+	if len(message) > maxEventMessageLength {
+		message = strings.ToValidUTF8(message[:maxEventMessageLength-3], "") + "..."
+	}
+	r.Recorder.Event(connector, eventType, reason, message)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+const (
+	// dependencyCheckInterval is how long the result of a dependency check is reused, so frequent
+	// probes do not use up the Fivetran API rate limit
+	dependencyCheckInterval = time.Minute
+	// dependencyCheckTimeout bounds a dependency check that the probe request does not bound
+	dependencyCheckTimeout = 10 * time.Second
+)
+
+// FivetranAPICheck returns a readiness check that fails while the Fivetran API cannot be reached
+// or rejects the client's credentials
+func FivetranAPICheck(fivetranClient *fivetran.Client) healthz.Checker {
+	check := &cachedCheck{check: func(ctx context.Context) error {
+		if err := fivetranClient.Ping(ctx); err != nil {
+			return fmt.Errorf("fivetran api check failed: %w", err)
+		}
+		return nil
+	}}
+	return check.Check
+}
+
+// VaultCheck returns a readiness check that fails while the operator-wide Vault client cannot log
+// in with the vault connection secret in namespace, or its token can no longer be renewed. It passes
+// while the secret does not exist, since the operator then runs without Vault.
+func VaultCheck(clients *vaultpkg.ClientManager, namespace string) healthz.Checker {
+	check := &cachedCheck{check: func(ctx context.Context) error {
+		if _, err := clients.Client(ctx, namespace, VaultSecretName()); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("vault check failed: %w", err)
+		}
+		if err := clients.RenewalErr(); err != nil {
+			return fmt.Errorf("vault check failed: %w", err)
+		}
+		return nil
+	}}
+	return check.Check
+}
+
+// cachedCheck runs a check at most once per dependencyCheckInterval, returning the last result
+// in between
+type cachedCheck struct {
+	check func(ctx context.Context) error
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	err       error
+}
+
+// Check implements healthz.Checker
+func (c *cachedCheck) Check(req *http.Request) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.checkedAt.IsZero() && time.Since(c.checkedAt) < dependencyCheckInterval {
+		return c.err
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), dependencyCheckTimeout)
+	defer cancel()
+	c.err = c.check(ctx)
+	c.checkedAt = time.Now()
+	return c.err
+}
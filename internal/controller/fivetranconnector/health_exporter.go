@@ -0,0 +1,130 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+// ConnectorHealthExporter periodically reads the state of every managed connector from Fivetran,
+// exports it as metrics so stale or broken connectors can be alerted on from Prometheus alone, and
+// records the connector's sync state, last successful sync, latest sync failure, open alerts and
+// data freshness in its status.
+type ConnectorHealthExporter struct {
+	Client         client.Client
+	FivetranClient *fivetran.Client
+	Namespace      string
+	Interval       time.Duration
+	// Recorder records an event for each alert Fivetran reports; nil records none
+	Recorder record.EventRecorder
+}
+
+// NeedLeaderElection ensures only the leader polls Fivetran, so replicas do not export duplicate
+// series
+func (*ConnectorHealthExporter) NeedLeaderElection() bool {
+	return true
+}
+
+// Start runs the exporter until the context is cancelled
+func (e *ConnectorHealthExporter) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("connector-health-exporter")
+	ctx = log.IntoContext(ctx, logger)
+	logger.Info("Starting connector health exporter", "interval", e.Interval)
+
+	ticker := time.NewTicker(e.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.export(ctx); err != nil {
+			logger.Error(err, "failed to export connector health")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// connectorHealth is the state of a connector read from Fivetran
+type connectorHealth struct {
+	namespace, name, connectorID string
+	syncState, setupState        string
+	paused                       bool
+	succeededAt                  time.Time
+	// dataFresh is whether the connector meets its SLA, and nil when it declares none
+	dataFresh *bool
+}
+
+// export reads the state of every managed connector, replaces the exported health metrics and
+// records new syncs and sync failures
+func (e *ConnectorHealthExporter) export(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
+	connectors := &operatorv1alpha1.FivetranConnectorList{}
+	if err := e.Client.List(ctx, connectors, client.InNamespace(e.Namespace)); err != nil {
+		return fmt.Errorf("export: failed to list connectors: %w", err)
+	}
+
+	var healths []connectorHealth
+	for i := range connectors.Items {
+		connector := &connectors.Items[i]
+		connectorID := connector.Status.ConnectorID
+		if connectorID == "" || !connector.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		resp, err := e.FivetranClient.Connections.GetConnection(ctx, connectorID)
+		if err != nil {
+			logger.Error(err, "failed to get connector state", "connector", connector.Name, "connectorId", connectorID)
+			continue
+		}
+		data := resp.Data.DetailsResponseDataCommon
+		if err := e.recordSyncStatus(ctx, connector, data); err != nil {
+			logger.Error(err, "failed to record sync status", "connector", connector.Name, "connectorId", connectorID)
+		}
+		if err := e.recordAlerts(ctx, connector, data.Status); err != nil {
+			logger.Error(err, "failed to record alerts", "connector", connector.Name, "connectorId", connectorID)
+		}
+		health := connectorHealth{
+			namespace:   connector.Namespace,
+			name:        connector.Name,
+			connectorID: connectorID,
+			syncState:   data.Status.SyncState,
+			setupState:  data.Status.SetupState,
+			paused:      data.Paused != nil && *data.Paused,
+			succeededAt: data.SucceededAt,
+		}
+		if fresh, ok := isDataFresh(connector); ok {
+			health.dataFresh = &fresh
+		}
+		healths = append(healths, health)
+	}
+
+	observeConnectorHealth(healths)
+	return nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+// renewVaultLeases renews the leases recorded in status that are due for renewal. It reports whether
+// the credentials must be re-issued and pushed to Fivetran because a lease could not be renewed or
+// is about to reach its maximum TTL.
+func (r *FivetranConnectorReconciler) renewVaultLeases(ctx context.Context, vaultClient *connectorVault, connector *operatorv1alpha1.FivetranConnector) (bool, error) {
+	logger := log.FromContext(ctx)
+	now := time.Now()
+	reissue := false
+	renewed := false
+
+	for i := range connector.Status.VaultLeases {
+		lease := &connector.Status.VaultLeases[i]
+		if now.Before(leaseRenewTime(*lease)) {
+			continue
+		}
+
+		if !lease.Renewable {
+			logger.Info("Vault lease is not renewable, re-issuing credentials", "path", lease.Path)
+			reissue = true
+			continue
+		}
+
+		client, err := vaultClient.Client(ctx)
+		if err != nil {
+			logger.Error(err, "failed to get vault client to renew lease, re-issuing credentials", "path", lease.Path)
+			reissue = true
+			continue
+		}
+		granted, err := vaultpkg.RenewLease(ctx, client, lease.LeaseID, lease.LeaseDuration)
+		if err != nil {
+			logger.Error(err, "failed to renew vault lease, re-issuing credentials", "path", lease.Path)
+			reissue = true
+			continue
+		}
+
+		// Vault caps renewals at the lease's max TTL, so a short grant means the lease is ending
+		if granted < lease.LeaseDuration/leaseRenewFraction {
+			logger.Info("Vault lease is reaching its max TTL, re-issuing credentials", "path", lease.Path, "granted", granted)
+			reissue = true
+			continue
+		}
+
+		logger.Info("Renewed vault lease", "path", lease.Path, "granted", granted)
+		lease.ExpireTime = metav1.NewTime(now.Add(time.Duration(granted) * time.Second))
+		renewTime := metav1.NewTime(now)
+		lease.LastRenewTime = &renewTime
+		renewed = true
+	}
+
+	if renewed {
+		if err := r.Status().Update(ctx, connector); err != nil {
+			return reissue, err
+		}
+	}
+	return reissue, nil
+}
+
+// updateVaultLeasesStatus records the leases of the credentials pushed to Fivetran and revokes the
+// leases they replaced
+func (r *FivetranConnectorReconciler) updateVaultLeasesStatus(ctx context.Context, vaultClient *connectorVault, connector *operatorv1alpha1.FivetranConnector, leases []vault.SecretLease) error {
+	if len(leases) == 0 && len(connector.Status.VaultLeases) == 0 {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	logger.Info("Updating vault leases status", "leases", len(leases))
+
+	current := make(map[string]bool, len(leases))
+	for _, lease := range leases {
+		current[lease.LeaseID] = true
+	}
+	var replaced []string
+	for _, lease := range connector.Status.VaultLeases {
+		if !current[lease.LeaseID] {
+			replaced = append(replaced, lease.LeaseID)
+		}
+	}
+
+	connector.Status.VaultLeases = toVaultLeasesStatus(leases, time.Now())
+	if err := r.Status().Update(ctx, connector); err != nil {
+		return err
+	}
+
+	revokeVaultLeases(ctx, vaultClient, replaced)
+	return nil
+}
+
+// revokeVaultLeases revokes leases that are no longer in use, on a best-effort basis since
+// unrevoked leases still expire on their own
+func revokeVaultLeases(ctx context.Context, vaultClient *connectorVault, leaseIDs []string) {
+	if len(leaseIDs) == 0 {
+		return
+	}
+	logger := log.FromContext(ctx)
+	client, err := vaultClient.Client(ctx)
+	if err != nil {
+		logger.Error(err, "failed to get vault client to revoke leases")
+		return
+	}
+	for _, leaseID := range leaseIDs {
+		if err := vaultpkg.RevokeLease(ctx, client, leaseID); err != nil {
+			logger.Error(err, "failed to revoke vault lease")
+		}
+	}
+}
+
+// secretLeaseIDs returns the IDs of the given leases
+func secretLeaseIDs(leases []vault.SecretLease) []string {
+	ids := make([]string, 0, len(leases))
+	for _, lease := range leases {
+		ids = append(ids, lease.LeaseID)
+	}
+	return ids
+}
+
+// statusLeaseIDs returns the IDs of the leases recorded in status
+func statusLeaseIDs(connector *operatorv1alpha1.FivetranConnector) []string {
+	ids := make([]string, 0, len(connector.Status.VaultLeases))
+	for _, lease := range connector.Status.VaultLeases {
+		ids = append(ids, lease.LeaseID)
+	}
+	return ids
+}
+
+// toVaultLeasesStatus converts resolved leases to their status representation
+func toVaultLeasesStatus(leases []vault.SecretLease, issued time.Time) []operatorv1alpha1.VaultLease {
+	if len(leases) == 0 {
+		return nil
+	}
+
+	result := make([]operatorv1alpha1.VaultLease, 0, len(leases))
+	for _, lease := range leases {
+		result = append(result, operatorv1alpha1.VaultLease{
+			Path:          lease.Path,
+			LeaseID:       lease.LeaseID,
+			LeaseDuration: lease.LeaseDuration,
+			Renewable:     lease.Renewable,
+			ExpireTime:    metav1.NewTime(issued.Add(time.Duration(lease.LeaseDuration) * time.Second)),
+		})
+	}
+	return result
+}
+
+// leaseRenewTime returns when a lease should be renewed, leaving a fraction of its duration as headroom
+func leaseRenewTime(lease operatorv1alpha1.VaultLease) time.Time {
+	headroom := time.Duration(lease.LeaseDuration) * time.Second / leaseRenewFraction
+	return lease.ExpireTime.Add(-headroom)
+}
+
+// nextLeaseRenewal returns how long until the next lease recorded in status is due for renewal, or
+// zero when there are no leases
+func nextLeaseRenewal(connector *operatorv1alpha1.FivetranConnector, now time.Time) time.Duration {
+	var next time.Duration
+	for _, lease := range connector.Status.VaultLeases {
+		wait := leaseRenewTime(lease).Sub(now)
+		if wait < minLeaseRenewalInterval {
+			wait = minLeaseRenewalInterval
+		}
+		if next == 0 || wait < next {
+			next = wait
+		}
+	}
+	return next
+}
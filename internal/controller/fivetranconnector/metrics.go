@@ -0,0 +1,200 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// Operations on Fivetran connectors counted by connectorOperationsTotal
+const (
+	operationAdopt  = "adopt"
+	operationCreate = "create"
+	operationDelete = "delete"
+)
+
+// Phases of a reconcile timed by reconcilePhaseDuration
+const (
+	phaseSecretResolution = "secret_resolution"
+	phaseConnector        = "connector"
+	phaseSetupTests       = "setup_tests"
+	phaseSchema           = "schema"
+)
+
+var (
+	reconcilesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fivetran_operator_connector_reconciles_total",
+		Help: "Number of completed FivetranConnector reconciles by the condition reason they ended with.",
+	}, []string{"reason"})
+
+	reconcileErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fivetran_operator_connector_reconcile_errors_total",
+		Help: "Number of failed FivetranConnector reconciles by the condition reason of the failure.",
+	}, []string{"reason"})
+
+	connectorsManaged = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "fivetran_operator_connectors_managed",
+		Help: "Number of FivetranConnectors managing a Fivetran connector.",
+	})
+
+	connectorOperationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fivetran_operator_connector_operations_total",
+		Help: "Number of Fivetran connectors adopted, created and deleted by the operator.",
+	}, []string{"operation"})
+
+	lastSuccessfulReconcile = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fivetran_operator_connector_last_successful_reconcile_timestamp_seconds",
+		Help: "Unix time of the last successful reconcile of each FivetranConnector.",
+	}, []string{"namespace", "name"})
+
+	reconcilePhaseDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "fivetran_operator_connector_reconcile_phase_duration_seconds",
+		Help:    "Duration of the phases of FivetranConnector reconciles, including failed ones.",
+		Buckets: prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{"phase"})
+
+	connectorSyncState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fivetran_operator_connector_sync_state",
+		Help: "Sync state of each managed Fivetran connector; the series of the current state is 1.",
+	}, []string{"namespace", "name", "connector_id", "sync_state"})
+
+	connectorSetupState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fivetran_operator_connector_setup_state",
+		Help: "Setup state of each managed Fivetran connector; the series of the current state is 1.",
+	}, []string{"namespace", "name", "connector_id", "setup_state"})
+
+	connectorPaused = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fivetran_operator_connector_paused",
+		Help: "Whether each managed Fivetran connector is paused.",
+	}, []string{"namespace", "name", "connector_id"})
+
+	connectorLastSuccessfulSync = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fivetran_operator_connector_last_successful_sync_timestamp_seconds",
+		Help: "Unix time of the last successful sync of each managed Fivetran connector.",
+	}, []string{"namespace", "name", "connector_id"})
+
+	connectorDataFresh = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fivetran_operator_connector_data_fresh",
+		Help: "Whether each managed Fivetran connector with spec.sla last synced successfully within its maximum data delay.",
+	}, []string{"namespace", "name", "connector_id"})
+
+	webhookEventsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fivetran_operator_webhook_events_total",
+		Help: "Number of Fivetran webhook requests received, by event type and result.",
+	}, []string{"event", "result"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(reconcilesTotal, reconcileErrorsTotal, connectorsManaged, connectorOperationsTotal,
+		lastSuccessfulReconcile, reconcilePhaseDuration, connectorSyncState, connectorSetupState, connectorPaused,
+		connectorLastSuccessfulSync, connectorDataFresh, webhookEventsTotal)
+}
+
+// observePhase records the duration of a reconcile phase that began at start
+func observePhase(phase string, start time.Time) {
+	reconcilePhaseDuration.WithLabelValues(phase).Observe(time.Since(start).Seconds())
+}
+
+// observeWebhookEvent records a Fivetran webhook request; event is empty for requests that could
+// not be decoded
+func observeWebhookEvent(event, result string) {
+	if event == "" {
+		event = "unknown"
+	}
+	webhookEventsTotal.WithLabelValues(event, result).Inc()
+}
+
+// managedConnectors is the set of FivetranConnectors counted by connectorsManaged
+var managedConnectors = struct {
+	sync.Mutex
+	names map[types.NamespacedName]struct{}
+}{names: make(map[types.NamespacedName]struct{})}
+
+// observeReconcileSuccess records a reconcile that completed without error
+func observeReconcileSuccess(connector *operatorv1alpha1.FivetranConnector) {
+	reconcilesTotal.WithLabelValues(ConnectorReasonSuccess).Inc()
+	lastSuccessfulReconcile.WithLabelValues(connector.Namespace, connector.Name).Set(float64(time.Now().Unix()))
+}
+
+// observeReconcileError records a reconcile that failed with the given condition reason
+func observeReconcileError(reason string) {
+	reconcilesTotal.WithLabelValues(reason).Inc()
+	reconcileErrorsTotal.WithLabelValues(reason).Inc()
+}
+
+// observeManagedConnector counts the FivetranConnector as managed while it has a Fivetran connector
+// and is not being deleted
+func observeManagedConnector(connector *operatorv1alpha1.FivetranConnector) {
+	name := types.NamespacedName{Namespace: connector.Namespace, Name: connector.Name}
+
+	managedConnectors.Lock()
+	defer managedConnectors.Unlock()
+	if connector.Status.ConnectorID != "" && connector.DeletionTimestamp.IsZero() {
+		managedConnectors.names[name] = struct{}{}
+	} else {
+		delete(managedConnectors.names, name)
+	}
+	connectorsManaged.Set(float64(len(managedConnectors.names)))
+}
+
+// forgetConnector removes the metrics of a deleted FivetranConnector
+func forgetConnector(name types.NamespacedName) {
+	managedConnectors.Lock()
+	defer managedConnectors.Unlock()
+	delete(managedConnectors.names, name)
+	connectorsManaged.Set(float64(len(managedConnectors.names)))
+	lastSuccessfulReconcile.DeleteLabelValues(name.Namespace, name.Name)
+}
+
+// observeConnectorHealth replaces the connector health metrics with the given states, dropping the
+// series of connectors that are gone
+func observeConnectorHealth(healths []connectorHealth) {
+	connectorSyncState.Reset()
+	connectorSetupState.Reset()
+	connectorPaused.Reset()
+	connectorLastSuccessfulSync.Reset()
+	connectorDataFresh.Reset()
+
+	for _, health := range healths {
+		connectorSyncState.WithLabelValues(health.namespace, health.name, health.connectorID, health.syncState).Set(1)
+		connectorSetupState.WithLabelValues(health.namespace, health.name, health.connectorID, health.setupState).Set(1)
+		paused := 0.0
+		if health.paused {
+			paused = 1
+		}
+		connectorPaused.WithLabelValues(health.namespace, health.name, health.connectorID).Set(paused)
+		// Connectors that never synced successfully have no last sync time
+		if !health.succeededAt.IsZero() {
+			connectorLastSuccessfulSync.WithLabelValues(health.namespace, health.name, health.connectorID).
+				Set(float64(health.succeededAt.Unix()))
+		}
+		if health.dataFresh != nil {
+			fresh := 0.0
+			if *health.dataFresh {
+				fresh = 1
+			}
+			connectorDataFresh.WithLabelValues(health.namespace, health.name, health.connectorID).Set(fresh)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/internal/kubeutils"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/notify"
+)
+
+// notifyFailure sends a notification for a failed condition unless the connector opted out
+func (r *FivetranConnectorReconciler) notifyFailure(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, condition metav1.Condition) {
+	if r.Notifier == nil || kubeutils.GetLabel(connector, labelNotifications) == notificationsDisabled {
+		return
+	}
+
+	var connectorURL string
+	if connector.Status.ConnectorID != "" {
+		connectorURL = r.connectorURL(connector, connector.Status.ConnectorID)
+	}
+	r.Notifier.Notify(ctx, notify.Notification{
+		Namespace:    connector.Namespace,
+		Name:         connector.Name,
+		ConnectorID:  connector.Status.ConnectorID,
+		ConnectorURL: connectorURL,
+		Condition:    condition.Type,
+		Reason:       condition.Reason,
+		Message:      condition.Message,
+		Time:         condition.LastTransitionTime.Time,
+	})
+}
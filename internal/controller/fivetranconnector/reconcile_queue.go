@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// Priorities of the values of the priority label. FivetranConnectors without the label, or with
+// another value, have the normal priority.
+var connectorPriorities = map[string]int{
+	"critical": 200,
+	"high":     100,
+	"normal":   0,
+	"low":      -100,
+}
+
+// ApplyWindow coalesces the events of a FivetranConnector arriving in quick succession, as when a
+// GitOps tool applies many FivetranConnectors at once, and spreads the reconciles they trigger over
+// time so the Fivetran API is not called for the whole fleet at once. Requeues after errors and
+// periodic resyncs keep their own delays.
+type ApplyWindow struct {
+	// Window is how long the events of a FivetranConnector are collected into one reconcile; zero
+	// reconciles on the first event
+	Window time.Duration
+	// Rate is the maximum number of FivetranConnectors reconciled per second for new events; zero
+	// or less does not limit the rate
+	Rate float64
+}
+
+// enabled reports whether the window changes when FivetranConnectors are reconciled
+func (w ApplyWindow) enabled() bool {
+	return w.Window > 0 || w.Rate > 0
+}
+
+// newQueue returns the work queue of the controller for controller.Options.NewQueue. It orders
+// ready requests by the priority label of their FivetranConnector and delays the requests added
+// for events by the apply window.
+func (r *FivetranConnectorReconciler) newQueue(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	return &reconcileQueue{
+		PriorityQueue: priorityqueue.New(controllerName, func(o *priorityqueue.Opts[reconcile.Request]) {
+			o.RateLimiter = rateLimiter
+		}),
+		reader:  r.Client,
+		window:  r.ApplyWindow,
+		now:     time.Now,
+		pending: make(map[reconcile.Request]time.Time),
+	}
+}
+
+// reconcileQueue hands out ready requests by the priority of their FivetranConnector, so that when
+// many FivetranConnectors need reconciling at once, as after a restart or a credential rotation,
+// critical ones go first. Requests added for events are delayed until the end of their apply window
+// and the next free slot of its rate, and a request added again before its reconcile starts is
+// coalesced into it.
+type reconcileQueue struct {
+	priorityqueue.PriorityQueue[reconcile.Request]
+	reader client.Reader
+	window ApplyWindow
+	now    func() time.Time
+
+	mu sync.Mutex
+	// pending holds when each delayed request becomes ready, until it is handed to a reconcile
+	pending map[reconcile.Request]time.Time
+	// nextSlot is the earliest time the next request may become ready under the rate
+	nextSlot time.Time
+}
+
+// Add adds the request for an event
+func (q *reconcileQueue) Add(req reconcile.Request) {
+	q.AddWithOpts(priorityqueue.AddOpts{}, req)
+}
+
+// AddAfter adds the request after a delay, outside the apply window
+func (q *reconcileQueue) AddAfter(req reconcile.Request, duration time.Duration) {
+	q.AddWithOpts(priorityqueue.AddOpts{After: duration}, req)
+}
+
+// AddRateLimited adds the request after its backoff, outside the apply window
+func (q *reconcileQueue) AddRateLimited(req reconcile.Request) {
+	q.AddWithOpts(priorityqueue.AddOpts{RateLimited: true}, req)
+}
+
+// AddWithOpts adds the requests with the priority of their FivetranConnector in place of the one
+// given, which event handlers lower for the initial list of FivetranConnectors. Requests without a
+// delay go through the apply window.
+func (q *reconcileQueue) AddWithOpts(opts priorityqueue.AddOpts, reqs ...reconcile.Request) {
+	for _, req := range reqs {
+		reqOpts := opts
+		reqOpts.Priority = q.priority(req)
+		if opts.After == 0 && !opts.RateLimited && q.window.enabled() {
+			after, waiting := q.delay(req)
+			if waiting {
+				continue
+			}
+			reqOpts.After = after
+		}
+		q.PriorityQueue.AddWithOpts(reqOpts, req)
+	}
+}
+
+// delay returns how long a request added for an event waits for its apply window, or true when it
+// is already waiting and the event is coalesced into it
+func (q *reconcileQueue) delay(req reconcile.Request) (time.Duration, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, waiting := q.pending[req]; waiting {
+		return 0, true
+	}
+	now := q.now()
+	readyAt := now.Add(q.window.Window)
+	if q.window.Rate > 0 {
+		if readyAt.Before(q.nextSlot) {
+			readyAt = q.nextSlot
+		}
+		q.nextSlot = readyAt.Add(time.Duration(float64(time.Second) / q.window.Rate))
+	}
+	q.pending[req] = readyAt
+	return readyAt.Sub(now), false
+}
+
+// Get hands the next request to a reconcile
+func (q *reconcileQueue) Get() (reconcile.Request, bool) {
+	req, _, shutdown := q.GetWithPriority()
+	return req, shutdown
+}
+
+// GetWithPriority hands the next request to a reconcile, so events arriving during the reconcile
+// open a new apply window
+func (q *reconcileQueue) GetWithPriority() (reconcile.Request, int, bool) {
+	req, priority, shutdown := q.PriorityQueue.GetWithPriority()
+	q.mu.Lock()
+	delete(q.pending, req)
+	q.mu.Unlock()
+	return req, priority, shutdown
+}
+
+// priority returns the priority of the FivetranConnector of a request from its priority label,
+// read from the cache. A FivetranConnector that cannot be read has the normal priority.
+func (q *reconcileQueue) priority(req reconcile.Request) int {
+	connector := &operatorv1alpha1.FivetranConnector{}
+	if err := q.reader.Get(context.Background(), req.NamespacedName, connector); err != nil {
+		return 0
+	}
+	return connectorPriorities[connector.Labels[labelPriority]]
+}
@@ -0,0 +1,246 @@
+package fivetranconnector
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// queuedRequest is a request added to a recordingQueue
+type queuedRequest struct {
+	req  reconcile.Request
+	opts priorityqueue.AddOpts
+}
+
+// recordingQueue records the requests added to it and hands them out in order
+type recordingQueue struct {
+	priorityqueue.PriorityQueue[reconcile.Request]
+	added []queuedRequest
+}
+
+func (q *recordingQueue) GetWithPriority() (reconcile.Request, int, bool) {
+	next := q.added[0]
+	q.added = q.added[1:]
+	return next.req, next.opts.Priority, false
+}
+
+func (q *recordingQueue) AddWithOpts(opts priorityqueue.AddOpts, reqs ...reconcile.Request) {
+	for _, req := range reqs {
+		q.added = append(q.added, queuedRequest{req: req, opts: opts})
+	}
+}
+
+// testClock is a settable clock for the queue
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := operatorv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	return scheme
+}
+
+func newTestQueue(t *testing.T, window ApplyWindow) (*reconcileQueue, *recordingQueue, *testClock) {
+	t.Helper()
+	recorder := &recordingQueue{}
+	clock := &testClock{now: time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)}
+	return &reconcileQueue{
+		PriorityQueue: recorder,
+		reader:        fake.NewClientBuilder().WithScheme(testScheme(t)).Build(),
+		window:        window,
+		now:           clock.Now,
+		pending:       make(map[reconcile.Request]time.Time),
+	}, recorder, clock
+}
+
+func request(name string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "connectors", Name: name}}
+}
+
+// delays returns the delays of the requests added to the queue
+func delays(added []queuedRequest) []time.Duration {
+	result := make([]time.Duration, 0, len(added))
+	for _, a := range added {
+		result = append(result, a.opts.After)
+	}
+	return result
+}
+
+func TestReconcileQueueWindowDelay(t *testing.T) {
+	queue, recorder, clock := newTestQueue(t, ApplyWindow{Window: 30 * time.Second})
+
+	queue.Add(request("orders"))
+	clock.Advance(10 * time.Second)
+	queue.Add(request("customers"))
+
+	expected := []time.Duration{30 * time.Second, 30 * time.Second}
+	if got := delays(recorder.added); !slices.Equal(got, expected) {
+		t.Errorf("expected delays %v, got %v", expected, got)
+	}
+}
+
+func TestReconcileQueueRateSpacing(t *testing.T) {
+	queue, recorder, _ := newTestQueue(t, ApplyWindow{Rate: 2})
+
+	for _, name := range []string{"orders", "customers", "invoices"} {
+		queue.Add(request(name))
+	}
+
+	expected := []time.Duration{0, 500 * time.Millisecond, time.Second}
+	if got := delays(recorder.added); !slices.Equal(got, expected) {
+		t.Errorf("expected delays %v, got %v", expected, got)
+	}
+}
+
+func TestReconcileQueueCoalescesAdds(t *testing.T) {
+	queue, recorder, clock := newTestQueue(t, ApplyWindow{Window: 30 * time.Second, Rate: 1})
+
+	queue.Add(request("orders"))
+	clock.Advance(5 * time.Second)
+	queue.Add(request("orders"))
+	queue.Add(request("orders"))
+
+	if len(recorder.added) != 1 {
+		t.Fatalf("expected repeated events to be coalesced into one request, got %d", len(recorder.added))
+	}
+	if recorder.added[0].opts.After != 30*time.Second {
+		t.Errorf("expected the request to wait for the window, got %v", recorder.added[0].opts.After)
+	}
+}
+
+// failingReader fails every read, as a cache that is not synced yet
+type failingReader struct{}
+
+func (failingReader) Get(context.Context, client.ObjectKey, client.Object, ...client.GetOption) error {
+	return errors.New("cache not synced")
+}
+
+func (failingReader) List(context.Context, client.ObjectList, ...client.ListOption) error {
+	return errors.New("cache not synced")
+}
+
+func TestReconcileQueuePriority(t *testing.T) {
+	labelled := func(name, priority string) *operatorv1alpha1.FivetranConnector {
+		connector := &operatorv1alpha1.FivetranConnector{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "connectors"},
+		}
+		if priority != "" {
+			connector.Labels = map[string]string{labelPriority: priority}
+		}
+		return connector
+	}
+	queue, recorder, _ := newTestQueue(t, ApplyWindow{})
+	queue.reader = fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(
+		labelled("orders", "critical"),
+		labelled("customers", "low"),
+		labelled("invoices", "urgent"),
+		labelled("payments", ""),
+	).Build()
+
+	// Event handlers lower the priority of the initial list of FivetranConnectors
+	queue.AddWithOpts(priorityqueue.AddOpts{Priority: -100},
+		request("orders"), request("customers"), request("invoices"), request("payments"), request("deleted"))
+
+	expected := map[string]int{"orders": 200, "customers": -100, "invoices": 0, "payments": 0, "deleted": 0}
+	for _, added := range recorder.added {
+		if added.opts.Priority != expected[added.req.Name] {
+			t.Errorf("expected priority %d for %s, got %d", expected[added.req.Name], added.req.Name, added.opts.Priority)
+		}
+	}
+	if len(recorder.added) != len(expected) {
+		t.Errorf("expected %d requests, got %d", len(expected), len(recorder.added))
+	}
+}
+
+func TestReconcileQueuePriorityWithoutCache(t *testing.T) {
+	queue, recorder, _ := newTestQueue(t, ApplyWindow{})
+	queue.reader = failingReader{}
+
+	queue.AddWithOpts(priorityqueue.AddOpts{Priority: 100}, request("orders"))
+
+	if len(recorder.added) != 1 || recorder.added[0].opts.Priority != 0 {
+		t.Errorf("expected the normal priority when the cache cannot be read, got %+v", recorder.added)
+	}
+}
+
+func TestReconcileQueueGetClearsPending(t *testing.T) {
+	queue, recorder, clock := newTestQueue(t, ApplyWindow{Window: 30 * time.Second})
+
+	queue.Add(request("orders"))
+	clock.Advance(30 * time.Second)
+	req, _, _ := queue.GetWithPriority()
+	if req != request("orders") {
+		t.Fatalf("expected orders, got %v", req)
+	}
+	if _, waiting := queue.pending[req]; waiting {
+		t.Error("expected the request handed out to no longer be pending")
+	}
+
+	// An event during the reconcile opens a new window instead of being coalesced
+	queue.Add(request("orders"))
+	if len(recorder.added) != 1 || recorder.added[0].opts.After != 30*time.Second {
+		t.Errorf("expected a new request waiting for a new window, got %+v", recorder.added)
+	}
+}
+
+func TestReconcileQueueDelay(t *testing.T) {
+	queue, recorder, clock := newTestQueue(t, ApplyWindow{Window: 10 * time.Second, Rate: 0.5})
+
+	queue.Add(request("orders"))
+	queue.Add(request("customers"))
+	clock.Advance(time.Second)
+	queue.Add(request("invoices"))
+	// Once the slots taken have passed, a request only waits for its window
+	clock.Advance(time.Minute)
+	queue.Add(request("payments"))
+
+	expected := []time.Duration{10 * time.Second, 12 * time.Second, 13 * time.Second, 10 * time.Second}
+	if got := delays(recorder.added); !slices.Equal(got, expected) {
+		t.Errorf("expected delays %v, got %v", expected, got)
+	}
+	if want := clock.now.Add(12 * time.Second); !queue.nextSlot.Equal(want) {
+		t.Errorf("expected the next slot at %v, got %v", want, queue.nextSlot)
+	}
+}
+
+func TestReconcileQueueDelayedAddsSkipWindow(t *testing.T) {
+	queue, recorder, _ := newTestQueue(t, ApplyWindow{Window: 30 * time.Second, Rate: 1})
+
+	queue.AddAfter(request("orders"), 5*time.Minute)
+	queue.AddRateLimited(request("customers"))
+
+	if len(queue.pending) != 0 {
+		t.Errorf("expected requeues not to wait for the window, got pending %v", queue.pending)
+	}
+	expected := []queuedRequest{
+		{req: request("orders"), opts: priorityqueue.AddOpts{After: 5 * time.Minute}},
+		{req: request("customers"), opts: priorityqueue.AddOpts{RateLimited: true}},
+	}
+	if !slices.Equal(recorder.added, expected) {
+		t.Errorf("expected %+v, got %+v", expected, recorder.added)
+	}
+}
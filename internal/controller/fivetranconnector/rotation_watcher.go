@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/internal/kubeutils"
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+// SecretRotationWatcher periodically compares the Vault secret versions recorded in connector
+// status with the latest versions in Vault, and labels connectors whose unpinned secrets have
+// changed for a forced reconcile so rotated credentials are pushed to Fivetran.
+type SecretRotationWatcher struct {
+	Client       client.Client
+	VaultClients *vaultpkg.ClientManager
+	Namespace    string
+	Interval     time.Duration
+}
+
+// NeedLeaderElection ensures only the leader labels connectors
+func (*SecretRotationWatcher) NeedLeaderElection() bool {
+	return true
+}
+
+// Start runs the watcher until the context is cancelled
+func (w *SecretRotationWatcher) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("secret-rotation-watcher")
+	ctx = log.IntoContext(ctx, logger)
+	logger.Info("Starting vault secret rotation watcher", "interval", w.Interval)
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := w.checkRotations(ctx); err != nil {
+				logger.Error(err, "failed to check vault secret rotations")
+			}
+		}
+	}
+}
+
+// checkRotations triggers a reconcile of every connector referencing a rotated secret
+func (w *SecretRotationWatcher) checkRotations(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
+	connectors := &operatorv1alpha1.FivetranConnectorList{}
+	if err := w.Client.List(ctx, connectors, client.InNamespace(w.Namespace)); err != nil {
+		return fmt.Errorf("checkRotations: failed to list connectors: %w", err)
+	}
+
+	// Look up each secret once per pass and Vault configuration, however many connectors reference it
+	latest := make(map[vaultpkg.ClientRef]map[string]int)
+	for i := range connectors.Items {
+		connector := &connectors.Items[i]
+		if !connector.DeletionTimestamp.IsZero() || kubeutils.HasLabel(connector, annotationForceReconcile) ||
+			len(connector.Status.VaultSecretVersions) == 0 {
+			continue
+		}
+
+		vaultClient, err := ConnectorVaultClient(ctx, w.VaultClients, connector)
+		if err != nil {
+			logger.Error(err, "failed to get vault client", "connector", connector.Name)
+			continue
+		}
+		ref, _ := vaultClientRef(connector)
+		if latest[ref] == nil {
+			latest[ref] = make(map[string]int)
+		}
+
+		if err := w.checkConnector(ctx, vaultClient, connector, latest[ref]); err != nil {
+			logger.Error(err, "failed to check secret rotations", "connector", connector.Name)
+		}
+	}
+
+	return nil
+}
+
+// checkConnector labels a connector for a forced reconcile when a secret it uses was rotated. The
+// rotated secrets are dropped from the secret cache first, so the reconcile reads the new versions
+// instead of a cached copy of the old ones.
+func (w *SecretRotationWatcher) checkConnector(ctx context.Context, vaultClient *vaultpkg.VaultClient, connector *operatorv1alpha1.FivetranConnector, latest map[string]int) error {
+	rotated, err := w.rotatedSecrets(ctx, vaultClient, connector, latest)
+	if err != nil {
+		return fmt.Errorf("checkConnector: failed to check secret versions: %w", err)
+	}
+	if len(rotated) == 0 {
+		return nil
+	}
+
+	for _, key := range rotated {
+		vaultClient.Cache.Invalidate(key)
+	}
+
+	log.FromContext(ctx).Info("Vault secret rotated, triggering reconcile", "connector", connector.Name, "secrets", rotated)
+	kubeutils.SetLabel(connector, annotationForceReconcile, "true")
+	if err := w.Client.Update(ctx, connector); err != nil {
+		return fmt.Errorf("checkConnector: failed to label connector for reconcile: %w", err)
+	}
+	return nil
+}
+
+// rotatedSecrets returns the unpinned secrets used by the connector that have a newer version, as
+// mount/path
+func (*SecretRotationWatcher) rotatedSecrets(ctx context.Context, vaultClient *vaultpkg.VaultClient, connector *operatorv1alpha1.FivetranConnector, latest map[string]int) ([]string, error) {
+	var rotated []string
+	for _, secret := range connector.Status.VaultSecretVersions {
+		if secret.Pinned {
+			continue
+		}
+
+		key := secret.Mount + "/" + secret.Path
+		version, ok := latest[key]
+		if !ok {
+			var err error
+			version, err = vaultpkg.LatestSecretVersion(ctx, vaultClient, secret.Mount, secret.Path)
+			if err != nil {
+				return nil, err
+			}
+			latest[key] = version
+		}
+
+		if version > secret.Version {
+			rotated = append(rotated, key)
+		}
+	}
+	return rotated, nil
+}
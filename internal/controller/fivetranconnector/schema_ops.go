@@ -65,6 +65,7 @@ func (r *FivetranConnectorReconciler) reconcileSchema(ctx context.Context, conne
 		logger.Info("Schema configuration doesn't match with the source, retrying once more",
 			"connectorId", connectorID,
 			"mismatches", mismatchDetails.String())
+		r.warningEvent(ctx, connector, eventReasonSchemaMismatch, "Schema does not match the spec after apply, reloading and retrying: "+mismatchDetails.String())
 
 		// Reload schema and apply
 		logger.Info("Reloading schema")
@@ -84,14 +85,19 @@ func (r *FivetranConnectorReconciler) reconcileSchema(ctx context.Context, conne
 
 		retryMatches, retryMismatchDetails := fivetran.CompareSchemaWithCR(schemaDetails, connector.Spec.ConnectorSchemas)
 		if !retryMatches {
+			r.warningEvent(ctx, connector, eventReasonSchemaMismatch, "Schema still does not match the spec after retry: "+retryMismatchDetails.String())
 			return fmt.Errorf("reconcileSchema compareSchemaWithCR retry: mismatches: %s - %w", retryMismatchDetails.String(), ErrSchemaMismatchAfterRetry)
 		}
 	}
 
+	// Record the scope of the applied schema, persisted with the SchemaReady condition
+	summary := fivetran.SummarizeSchema(schemaDetails)
+	connector.Status.SchemaSummary = &summary
+
 	if err := r.setCondition(ctx, connector, conditionTypeSchemaReady, metav1.ConditionTrue, SchemaReasonReconciliationSuccess, msgSchemaReady); err != nil {
 		return err
 	}
-	logger.Info("Schema configuration applied successfully", "connectorId", connectorID)
+	logger.Info("Schema configuration applied successfully", "connectorId", connectorID, "summary", summary)
 
 	return nil
 }
@@ -119,7 +125,7 @@ func (r *FivetranConnectorReconciler) reloadSchema(ctx context.Context, connecto
 func (r *FivetranConnectorReconciler) applySchema(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, connectorID string) error {
 	logger := log.FromContext(ctx)
 	logger.Info("Applying schema configuration", "connectorId", connectorID)
-	schema := r.convertSchema(connector.Spec.ConnectorSchemas)
+	schema := ToSchemaBuilder(connector.Spec.ConnectorSchemas)
 
 	_, err := r.FivetranClient.Schemas.UpdateSchema(ctx, connectorID, schema)
 	if err != nil {
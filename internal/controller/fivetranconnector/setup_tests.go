@@ -21,9 +21,12 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/fivetran/go-fivetran/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
 )
 
 // reconcileSetupTests runs setup tests
@@ -53,6 +56,9 @@ func (r *FivetranConnectorReconciler) reconcileSetupTests(ctx context.Context, c
 		return nil, fmt.Errorf("reconcileSetupTests: %w", err)
 	}
 
+	// Record every test result, so the failing check is visible without the Fivetran dashboard
+	connector.Status.SetupTests = setupTestResults(ctx, resp.Data.SetupTests, metav1.Now())
+
 	// Check test results
 
 	for _, test := range resp.Data.SetupTests {
@@ -61,11 +67,15 @@ func (r *FivetranConnectorReconciler) reconcileSetupTests(ctx context.Context, c
 		logger.Info("Setup test result", "title", test.Title, "status", test.Status, "message", test.Message, "details", test.Details)
 		if test.Status == setupTestStatusWarning {
 			warningMessages = append(warningMessages, fmt.Sprintf("%s: %s", test.Title, test.Message))
+			r.warningEvent(ctx, connector, eventReasonSetupTestWarning, setupTestEventMessage(test))
 		} else if test.Status != setupTestStatusPassed && test.Status != setupTestStatusSkipped {
 			setupTestErrors = append(setupTestErrors, fmt.Errorf("reconcileSetupTests failed: %s (status: %s) - %s", test.Title, test.Status, test.Message))
+			r.warningEvent(ctx, connector, eventReasonSetupTestFailed, setupTestEventMessage(test))
 		}
 	}
 
+	connector.Status.SetupTestWarnings = len(warningMessages)
+
 	if len(setupTestErrors) > 0 {
 		return warningMessages, fmt.Errorf("%w: %s", ErrSetupTestsFailed, errors.Join(setupTestErrors...).Error())
 	}
@@ -85,3 +95,27 @@ func (r *FivetranConnectorReconciler) reconcileSetupTests(ctx context.Context, c
 
 	return warningMessages, nil
 }
+
+// setupTestResults converts the results of a setup test run for the connector's status
+func setupTestResults(ctx context.Context, tests []common.SetupTestResponse, runTime metav1.Time) []operatorv1alpha1.SetupTestResult {
+	redactor := redact.FromContext(ctx)
+	results := make([]operatorv1alpha1.SetupTestResult, 0, len(tests))
+	for _, test := range tests {
+		results = append(results, operatorv1alpha1.SetupTestResult{
+			Title:       test.Title,
+			Status:      test.Status,
+			Message:     redactor.Redact(test.Message),
+			LastRunTime: runTime,
+		})
+	}
+	return results
+}
+
+// setupTestEventMessage describes a setup test result that did not pass
+func setupTestEventMessage(test common.SetupTestResponse) string {
+	message := fmt.Sprintf("Setup test %q %s: %s", test.Title, test.Status, test.Message)
+	if test.Details != "" {
+		message += " (" + test.Details + ")"
+	}
+	return message
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// networkingMethodSSHTunnel is the networking method of connectors reaching their source through
+// an SSH tunnel host
+const networkingMethodSSHTunnel = "SshTunnel"
+
+// reconcileSSHPublicKey publishes the public key of the connector's group when the connector uses
+// an SSH tunnel, and clears it once the connector no longer does
+func (r *FivetranConnectorReconciler) reconcileSSHPublicKey(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) error {
+	logger := log.FromContext(ctx)
+
+	groupID := connector.Spec.Connector.GroupID
+	if connector.Spec.Connector.NetworkingMethod != networkingMethodSSHTunnel {
+		if connector.Status.SSHPublicKey == "" {
+			return nil
+		}
+		logger.Info("Clearing SSH public key", "groupId", groupID)
+		connector.Status.SSHPublicKey = ""
+		return r.Status().Update(ctx, connector)
+	}
+
+	resp, err := r.FivetranClient.Groups.GetGroupPublicKey(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("reconcileSSHPublicKey: %w", err)
+	}
+	if resp.Data.PublicKey == connector.Status.SSHPublicKey {
+		return nil
+	}
+
+	logger.Info("Publishing SSH public key", "groupId", groupID)
+	connector.Status.SSHPublicKey = resp.Data.PublicKey
+	return r.Status().Update(ctx, connector)
+}
+
+// sshPublicKeyOutdated reports whether an SSH public key is published for a connector that does
+// not use an SSH tunnel, or is missing for one that does
+func sshPublicKeyOutdated(connector *operatorv1alpha1.FivetranConnector) bool {
+	sshTunnel := connector.Spec.Connector.NetworkingMethod == networkingMethodSSHTunnel
+	return sshTunnel != (connector.Status.SSHPublicKey != "")
+}
@@ -20,9 +20,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"strings"
 	"time"
 
+	vaultapi "github.com/hashicorp/vault/api"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -30,12 +36,33 @@ import (
 	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
 	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
 	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
 )
 
 // handleError handles errors by setting appropriate conditions and updating status
 func (r *FivetranConnectorReconciler) handleError(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, conditionType, reason string, err error) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
+
+	// Record the error's code for automation, persisted with the condition below
+	connector.Status.LastError = &operatorv1alpha1.ErrorStatus{
+		Code:    errorCode(err),
+		Message: redact.FromContext(ctx).Redact(err.Error()),
+		Time:    metav1.Now(),
+	}
+
+	// While the Fivetran API is failing, wait for the circuit breaker's probe instead of logging
+	// the same failure for every connector
+	if errors.Is(err, fivetran.ErrCircuitOpen) {
+		observeReconcileError(ConnectorReasonFivetranAPIUnavailable)
+		trace.SpanFromContext(ctx).SetStatus(codes.Error, err.Error())
+		retryAfter := fivetran.RetryAfter(err)
+		logger.Info("Fivetran API unavailable, waiting to retry", "retryAfter", retryAfter)
+		return ctrl.Result{RequeueAfter: retryAfter}, r.setCondition(ctx, connector, conditionType, metav1.ConditionFalse, ConnectorReasonFivetranAPIUnavailable, err.Error())
+	}
+
 	logger.Error(err, "Reconcile failed", "conditionType", conditionType, "reason", reason)
+	trace.SpanFromContext(ctx).SetStatus(codes.Error, redact.FromContext(ctx).Redact(err.Error()))
+	observeReconcileError(reason)
 
 	// Check if the error is a schema configuration error (should not requeue)
 	if errors.Is(err, ErrSchemaMismatchAfterRetry) {
@@ -48,7 +75,17 @@ func (r *FivetranConnectorReconciler) handleError(ctx context.Context, connector
 		if err := r.setCondition(ctx, connector, conditionTypeConnectorReady, metav1.ConditionTrue, ConnectorReasonSuccess, msgConnectorReady); err != nil {
 			return ctrl.Result{}, err
 		}
-		return ctrl.Result{}, r.setCondition(ctx, connector, conditionType, metav1.ConditionFalse, reason, err.Error())
+		// Setup tests only run after a connector change, so every failed run is notified, including
+		// ones that leave the condition False
+		previous := meta.FindStatusCondition(connector.Status.Conditions, conditionType)
+		alreadyFailed := previous != nil && previous.Status == metav1.ConditionFalse
+		if err := r.setCondition(ctx, connector, conditionType, metav1.ConditionFalse, reason, err.Error()); err != nil {
+			return ctrl.Result{}, err
+		}
+		if alreadyFailed {
+			r.notifyFailure(ctx, connector, *meta.FindStatusCondition(connector.Status.Conditions, conditionType))
+		}
+		return ctrl.Result{}, nil
 	}
 
 	// Check if the error is a vault resolution error
@@ -64,7 +101,12 @@ func (r *FivetranConnectorReconciler) handleError(ctx context.Context, connector
 	var fivetranErr *fivetran.APIError
 	if errors.As(err, &fivetranErr) {
 		if fivetranErr.IsRetryable() {
-			return ctrl.Result{RequeueAfter: 5 * time.Minute}, r.setCondition(ctx, connector, conditionType, metav1.ConditionFalse, reason, fivetranErr.Error())
+			requeueAfter := 5 * time.Minute
+			// Retry rate-limited requests as soon as Fivetran allows
+			if fivetranErr.RetryAfter > 0 {
+				requeueAfter = fivetranErr.RetryAfter
+			}
+			return ctrl.Result{RequeueAfter: requeueAfter}, r.setCondition(ctx, connector, conditionType, metav1.ConditionFalse, reason, fivetranErr.Error())
 		}
 		return ctrl.Result{}, r.setCondition(ctx, connector, conditionType, metav1.ConditionFalse, reason, fivetranErr.Error())
 	}
@@ -74,7 +116,8 @@ func (r *FivetranConnectorReconciler) handleError(ctx context.Context, connector
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, err
+	// The returned error is logged by controller-runtime, outside the redacting logger
+	return ctrl.Result{}, redact.FromContext(ctx).RedactError(err)
 }
 
 // updateSetupTestsCondition handles the setup tests condition logic
@@ -98,27 +141,104 @@ func (r *FivetranConnectorReconciler) updateSetupTestsCondition(ctx context.Cont
 	return r.setCondition(ctx, connector, conditionTypeSetupTestReady, metav1.ConditionTrue, reason, message)
 }
 
-// setCondition sets a condition on the connector
+// updateLastReconcileTime records the end of a successful reconcile, clearing the last error and
+// applying a changed connector URL template
+func (r *FivetranConnectorReconciler) updateLastReconcileTime(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) error {
+	now := metav1.Now()
+	connector.Status.LastReconcileTime = &now
+	connector.Status.LastError = nil
+	if connector.Status.ConnectorID != "" {
+		connector.Status.ConnectorURL = r.connectorURL(connector, connector.Status.ConnectorID)
+	}
+	return r.Status().Update(ctx, connector)
+}
+
+// updateVaultSecretVersionsStatus records the resolved Vault secret versions if they changed
+func (r *FivetranConnectorReconciler) updateVaultSecretVersionsStatus(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, versions []operatorv1alpha1.VaultSecretVersion) error {
+	if slices.Equal(connector.Status.VaultSecretVersions, versions) {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	logger.Info("Updating vault secret versions status", "versions", len(versions))
+	connector.Status.VaultSecretVersions = versions
+	return r.Status().Update(ctx, connector)
+}
+
+// vaultSecretResolutionReason returns the VaultReady reason describing why secrets could not be
+// resolved
+func vaultSecretResolutionReason(err error) string {
+	var responseErr *vaultapi.ResponseError
+	switch {
+	case errors.Is(err, vault.ErrInvalidVaultReference):
+		return VaultReasonInvalidReference
+	case errors.Is(err, vault.ErrKeyNotFound):
+		return VaultReasonKeyNotFound
+	case errors.Is(err, vault.ErrSecretNotFound), errors.Is(err, vault.ErrSecretDataNil):
+		return VaultReasonSecretNotFound
+	case errors.As(err, &responseErr) && responseErr.StatusCode == http.StatusForbidden:
+		return VaultReasonPermissionDenied
+	default:
+		return VaultReasonSecretResolutionFailed
+	}
+}
+
+// isVaultSecretResolutionReason reports whether a VaultReady reason records a secret resolution
+// failure rather than the state of the Vault client
+func isVaultSecretResolutionReason(reason string) bool {
+	switch reason {
+	case VaultReasonInvalidReference, VaultReasonSecretNotFound, VaultReasonKeyNotFound,
+		VaultReasonPermissionDenied, VaultReasonSecretResolutionFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// updateVaultReadyCondition sets the VaultReady condition, skipping the status update when it is unchanged
+func (r *FivetranConnectorReconciler) updateVaultReadyCondition(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, status metav1.ConditionStatus, reason, message string) error {
+	existing := meta.FindStatusCondition(connector.Status.Conditions, conditionTypeVaultReady)
+	if existing != nil && existing.Status == status && existing.Reason == reason && existing.Message == message {
+		return nil
+	}
+	return r.setCondition(ctx, connector, conditionTypeVaultReady, status, reason, message)
+}
+
+// setCondition sets a condition on the connector, notifying when it turns False
 func (r *FivetranConnectorReconciler) setCondition(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, conditionType string, status metav1.ConditionStatus, reason, message string) error {
 	condition := metav1.Condition{
 		Type:               conditionType,
 		Status:             status,
 		Reason:             reason,
-		Message:            message,
+		Message:            redact.FromContext(ctx).Redact(message),
 		LastTransitionTime: metav1.Now(),
 	}
 
+	// Notify once when a condition turns False, not on every reconcile that keeps failing
+	existing := meta.FindStatusCondition(connector.Status.Conditions, conditionType)
+	turnedFalse := status == metav1.ConditionFalse && (existing == nil || existing.Status != metav1.ConditionFalse)
+
 	if connector.Status.Conditions == nil {
 		connector.Status.Conditions = []metav1.Condition{}
 	}
 
+	replaced := false
 	for i, existingCondition := range connector.Status.Conditions {
 		if existingCondition.Type == condition.Type {
 			connector.Status.Conditions[i] = condition
-			return r.Status().Update(ctx, connector)
+			replaced = true
+			break
 		}
 	}
+	if !replaced {
+		connector.Status.Conditions = append(connector.Status.Conditions, condition)
+	}
 
-	connector.Status.Conditions = append(connector.Status.Conditions, condition)
-	return r.Status().Update(ctx, connector)
+	if err := r.Status().Update(ctx, connector); err != nil {
+		return err
+	}
+	if turnedFalse {
+		r.notifyFailure(ctx, connector, condition)
+	}
+	return nil
 }
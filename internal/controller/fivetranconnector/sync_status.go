@@ -0,0 +1,168 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/go-fivetran/connections"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+// Fivetran webhook events that change a connector's sync status
+const (
+	webhookEventSyncStart         = "sync_start"
+	webhookEventSyncEnd           = "sync_end"
+	webhookEventConnectionFailure = "connection_failure"
+)
+
+// Sync states a Fivetran webhook event moves a connector to
+const (
+	syncStateSyncing   = "syncing"
+	syncStateScheduled = "scheduled"
+	syncStatePaused    = "paused"
+)
+
+// syncStatusSuccessful is the status of the data of a sync_end event for a successful sync
+const syncStatusSuccessful = "SUCCESSFUL"
+
+// recordSyncStatus records the connector's sync state, when it last synced successfully, its
+// latest sync failure and whether its data meets its SLA in its status. The failure message is
+// captured when a new failure is first seen, since the tasks and warnings Fivetran reports
+// describe the connector's current state rather than a past sync.
+func (e *ConnectorHealthExporter) recordSyncStatus(ctx context.Context, connector *operatorv1alpha1.FivetranConnector, data connections.DetailsResponseDataCommon) error {
+	changed := false
+
+	if syncState := data.Status.SyncState; syncState != connector.Status.SyncState {
+		connector.Status.SyncState = syncState
+		changed = true
+	}
+
+	if !data.SucceededAt.IsZero() {
+		if synced := connector.Status.LastSyncedAt; synced == nil || synced.Time.Before(data.SucceededAt) {
+			syncedAt := metav1.NewTime(data.SucceededAt)
+			connector.Status.LastSyncedAt = &syncedAt
+			changed = true
+		}
+	}
+
+	if !data.FailedAt.IsZero() {
+		if existing := connector.Status.LastSyncError; existing == nil || existing.FailedAt.Time.Before(data.FailedAt) {
+			log.FromContext(ctx).Info("Recording sync failure", "connector", connector.Name, "failedAt", data.FailedAt)
+			connector.Status.LastSyncError = &operatorv1alpha1.SyncError{
+				Message:  syncErrorMessage(data.Status),
+				FailedAt: metav1.NewTime(data.FailedAt),
+			}
+			changed = true
+		}
+	}
+
+	if applyDataFreshness(connector, time.Now()) {
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return e.Client.Status().Update(ctx, connector)
+}
+
+// syncErrorMessage describes a sync failure from the tasks and warnings of the connector's status
+func syncErrorMessage(status connections.StatusResponse) string {
+	var parts []string
+	for _, items := range [][]common.CommonResponse{status.Tasks, status.Warnings} {
+		for _, item := range items {
+			parts = append(parts, fmt.Sprintf("%s: %s", item.Code, item.Message))
+		}
+	}
+	if len(parts) == 0 {
+		return msgSyncFailed
+	}
+	return strings.Join(parts, "; ")
+}
+
+// applySyncEvent records the sync status a Fivetran webhook event reports in the connector's
+// status, as recordSyncStatus does when polling. It returns false for events that do not change
+// the sync status. Sync times only move forward, so an event delivered late does not undo a newer one.
+func applySyncEvent(connector *operatorv1alpha1.FivetranConnector, event *fivetran.WebhookEvent) bool {
+	changed := false
+	setSyncState := func(syncState string) {
+		if connector.Status.SyncState != syncState {
+			connector.Status.SyncState = syncState
+			changed = true
+		}
+	}
+	recordFailure := func() {
+		if event.Created.IsZero() {
+			return
+		}
+		if existing := connector.Status.LastSyncError; existing == nil || existing.FailedAt.Time.Before(event.Created) {
+			connector.Status.LastSyncError = &operatorv1alpha1.SyncError{
+				Message:  webhookFailureMessage(event),
+				FailedAt: metav1.NewTime(event.Created),
+			}
+			changed = true
+		}
+	}
+
+	switch event.Event {
+	case webhookEventSyncStart:
+		setSyncState(syncStateSyncing)
+	case webhookEventSyncEnd:
+		if paused := connector.Spec.Connector.Paused; paused != nil && *paused {
+			setSyncState(syncStatePaused)
+		} else {
+			setSyncState(syncStateScheduled)
+		}
+		if isFailureEvent(event) {
+			recordFailure()
+		} else if event.Status() == syncStatusSuccessful && !event.Created.IsZero() {
+			if synced := connector.Status.LastSyncedAt; synced == nil || synced.Time.Before(event.Created) {
+				syncedAt := metav1.NewTime(event.Created)
+				connector.Status.LastSyncedAt = &syncedAt
+				changed = true
+			}
+		}
+	case webhookEventConnectionFailure:
+		recordFailure()
+	}
+	return changed
+}
+
+// webhookFailureMessage describes the failure a Fivetran webhook event reports from its status and
+// reason
+func webhookFailureMessage(event *fivetran.WebhookEvent) string {
+	var parts []string
+	if status := event.Status(); status != "" {
+		parts = append(parts, status)
+	}
+	if reason, ok := event.Data["reason"].(string); ok && reason != "" {
+		parts = append(parts, reason)
+	}
+	if len(parts) == 0 {
+		return msgSyncFailed
+	}
+	return strings.Join(parts, ": ")
+}
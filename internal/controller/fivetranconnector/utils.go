@@ -19,9 +19,11 @@ package fivetranconnector
 import (
 	"context"
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -32,6 +34,8 @@ import (
 	"github.com/redhat-data-and-ai/fivetran-operator/internal/kubeutils"
 	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
 	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/secrets"
 )
 
 // ensureFinalizer adds the finalizer if it doesn't exist
@@ -54,7 +58,10 @@ func (r *FivetranConnectorReconciler) handleExistingConnectorAdoptionIfNeeded(ct
 	if connector.Status.ConnectorID == "" {
 		if adoptConnectorID := kubeutils.GetAnnotation(connector, annotationAdoptExistingConnectorID); adoptConnectorID != "" {
 			logger.Info("Found adoption annotation, handling connector adoption", "adoptConnectorID", adoptConnectorID)
-			if err := r.handleExistingConnectorAdoption(ctx, connector, adoptConnectorID); err != nil {
+			start := time.Now()
+			err := r.handleExistingConnectorAdoption(ctx, connector, adoptConnectorID)
+			observePhase(phaseConnector, start)
+			if err != nil {
 				return false, err
 			}
 			return true, nil
@@ -95,7 +102,7 @@ func (r *FivetranConnectorReconciler) determineReconciliationNeeds(ctx context.C
 	return reconcileConnector, reconcileSchema, nil
 }
 
-// cleanupAnnotationsAndLabels removes force reconcile labels and adoption annotations
+// cleanupAnnotationsAndLabels removes force reconcile labels and adoption and migration annotations
 func (r *FivetranConnectorReconciler) cleanupAnnotationsAndLabels(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) error {
 	logger := log.FromContext(ctx)
 	logger.Info("Cleaning up annotations and labels")
@@ -115,40 +122,124 @@ func (r *FivetranConnectorReconciler) cleanupAnnotationsAndLabels(ctx context.Co
 		}
 	}
 
+	// Remove migration annotation once the connector is replaced, so later moves need it again
+	if kubeutils.HasAnnotation(connector, annotationMigrateConnector) {
+		kubeutils.RemoveAnnotation(connector, annotationMigrateConnector)
+		if err := r.Update(ctx, connector); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// resolveSecrets resolves vault secrets in connector config and auth
-func (r *FivetranConnectorReconciler) resolveSecrets(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) (*runtime.RawExtension, *runtime.RawExtension, error) {
+// resolvedSecrets holds the connector config and auth with vault references resolved, along with
+// the versions of the secrets read and the leases of the dynamic credentials issued
+type resolvedSecrets struct {
+	config   *runtime.RawExtension
+	auth     *runtime.RawExtension
+	versions []operatorv1alpha1.VaultSecretVersion
+	leases   []vault.SecretLease
+	// cacheKeys identifies the KV secrets read in the Vault secret cache
+	cacheKeys []string
+	// hash is the hash of the resolved config and auth, recorded once they are sent to Fivetran
+	hash string
+}
+
+// resolveSecrets resolves vault and other secret references in connector config and auth. Both are
+// resolved together, so a vaultDynamic: path referenced by config and auth issues one set of credentials.
+func (r *FivetranConnectorReconciler) resolveSecrets(ctx context.Context, vaultClient *connectorVault, connector *operatorv1alpha1.FivetranConnector) (*resolvedSecrets, error) {
 	logger := log.FromContext(ctx)
 	logger.Info("Resolving vault secrets")
+	ctx = secrets.WithNamespace(ctx, connector.Namespace)
 
-	var resolvedConfig, resolvedAuth *runtime.RawExtension
-	var allErrors []error
-
+	resolved := &resolvedSecrets{}
 	if connector.Spec.Connector.Config != nil {
-		configCopy := connector.Spec.Connector.Config.DeepCopy()
-		if err := vault.ResolveSecrets(ctx, r.VaultClient, configCopy); err != nil {
-			allErrors = append(allErrors, fmt.Errorf("resolveSecrets: config secrets: %w", err))
-		} else {
-			resolvedConfig = configCopy
-		}
+		resolved.config = connector.Spec.Connector.Config.DeepCopy()
 	}
-
 	if connector.Spec.Connector.Auth != nil {
-		authCopy := connector.Spec.Connector.Auth.DeepCopy()
-		if err := vault.ResolveSecrets(ctx, r.VaultClient, authCopy); err != nil {
-			allErrors = append(allErrors, fmt.Errorf("resolveSecrets: auth secrets: %w", err))
-		} else {
-			resolvedAuth = authCopy
+		resolved.auth = connector.Spec.Connector.Auth.DeepCopy()
+	}
+
+	results, err := r.SecretResolvers.ResolveAll(ctx, vaultClient.Client, resolved.config, resolved.auth)
+	if err != nil {
+		return nil, fmt.Errorf("resolveSecrets: %w", err)
+	}
+
+	var secretVersions []vault.SecretVersion
+	for i, section := range []string{"config", "auth"} {
+		result := results[i]
+		secretVersions = append(secretVersions, result.Versions...)
+		redact.FromContext(ctx).Add(result.SensitiveValues...)
+		resolved.leases = append(resolved.leases, result.Leases...)
+		resolved.cacheKeys = append(resolved.cacheKeys, result.CacheKeys...)
+		r.auditSecretReferences(ctx, section, result.References)
+	}
+
+	resolved.versions = toVaultSecretVersionsStatus(secretVersions)
+	resolved.hash = calculateSecretsHash(connector, resolved.config, resolved.auth)
+	addSensitiveConfig(redact.FromContext(ctx), resolved.config, resolved.auth)
+	return resolved, nil
+}
+
+// auditSecretReferences logs the references resolved in one section of a connector when secret
+// auditing is enabled. Only the config key and the reference are logged, never the value.
+func (r *FivetranConnectorReconciler) auditSecretReferences(ctx context.Context, section string, references []vault.ResolvedReference) {
+	if !r.SecretAudit {
+		return
+	}
+	logger := log.FromContext(ctx)
+	for _, ref := range references {
+		logger.Info("Secret reference resolved", "audit", true, "section", section, "keyPath", ref.KeyPath, "reference", ref.Reference)
+	}
+}
+
+// addSensitiveConfig records the values of sensitive keys in resolved config for redaction, covering
+// credentials set in plaintext
+func addSensitiveConfig(redactor *redact.Redactor, configs ...*runtime.RawExtension) {
+	for _, config := range configs {
+		if config == nil || len(config.Raw) == 0 {
+			continue
+		}
+		var data any
+		if err := json.Unmarshal(config.Raw, &data); err != nil {
+			continue
 		}
+		redactor.AddConfig(data)
+	}
+}
+
+// toVaultSecretVersionsStatus converts resolved secret versions to their status representation, dropping duplicates
+func toVaultSecretVersionsStatus(versions []vault.SecretVersion) []operatorv1alpha1.VaultSecretVersion {
+	if len(versions) == 0 {
+		return nil
 	}
 
-	if len(allErrors) > 0 {
-		return nil, nil, errors.Join(allErrors...)
+	seen := make(map[vault.SecretVersion]bool, len(versions))
+	result := make([]operatorv1alpha1.VaultSecretVersion, 0, len(versions))
+	for _, v := range versions {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, operatorv1alpha1.VaultSecretVersion{
+			Mount:   v.Mount,
+			Path:    v.Path,
+			Version: v.Version,
+			Pinned:  v.Pinned,
+		})
 	}
 
-	return resolvedConfig, resolvedAuth, nil
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Mount != result[j].Mount {
+			return result[i].Mount < result[j].Mount
+		}
+		if result[i].Path != result[j].Path {
+			return result[i].Path < result[j].Path
+		}
+		return result[i].Version < result[j].Version
+	})
+	return result
 }
 
 // hasSchemaConfig checks if connector has schema configuration
@@ -169,20 +260,30 @@ func (*FivetranConnectorReconciler) hasFailedConditions(connector *operatorv1alp
 	}
 
 	for _, condition := range connector.Status.Conditions {
-		if condition.Status == metav1.ConditionFalse {
-			return true
+		if condition.Status != metav1.ConditionFalse {
+			continue
 		}
+		// Alerts and stale data are reported by Fivetran, not by a failed reconcile, so they are not retried
+		if condition.Type == conditionTypeConnectorAlerts || condition.Type == conditionTypeDataFresh {
+			continue
+		}
+		// A failed token renewal is a warning while the token is still valid, not a failed reconcile
+		if condition.Type == conditionTypeVaultReady && condition.Reason == VaultReasonTokenRenewalFailed {
+			continue
+		}
+		return true
 	}
 	return false
 }
 
-// toFivetranConnector converts the K8s connector to Fivetran connector format
-func (*FivetranConnectorReconciler) toFivetranConnector(connector *operatorv1alpha1.FivetranConnector, resolvedConfig, resolvedAuth *runtime.RawExtension) (*fivetran.Connector, error) {
+// ToFivetranConnector converts the K8s connector, with its secrets resolved in resolvedConfig and
+// resolvedAuth, to the Fivetran connector format sent to the Fivetran API
+func ToFivetranConnector(connector *operatorv1alpha1.FivetranConnector, resolvedConfig, resolvedAuth *runtime.RawExtension) (*fivetran.Connector, error) {
 	// Convert RawExtension to map[string]any for config
 	var config map[string]any
 	if resolvedConfig != nil && len(resolvedConfig.Raw) > 0 {
 		if err := json.Unmarshal(resolvedConfig.Raw, &config); err != nil {
-			return nil, fmt.Errorf("toFivetranConnector: failed to unmarshal config: %w", err)
+			return nil, fmt.Errorf("ToFivetranConnector: failed to unmarshal config: %w", err)
 		}
 	}
 
@@ -190,7 +291,7 @@ func (*FivetranConnectorReconciler) toFivetranConnector(connector *operatorv1alp
 	var auth map[string]any
 	if resolvedAuth != nil && len(resolvedAuth.Raw) > 0 {
 		if err := json.Unmarshal(resolvedAuth.Raw, &auth); err != nil {
-			return nil, fmt.Errorf("toFivetranConnector: failed to unmarshal auth: %w", err)
+			return nil, fmt.Errorf("ToFivetranConnector: failed to unmarshal auth: %w", err)
 		}
 	}
 
@@ -218,8 +319,8 @@ func (*FivetranConnectorReconciler) toFivetranConnector(connector *operatorv1alp
 	return fivetranConnector, nil
 }
 
-// convertSchema converts the API schema to Fivetran schema format
-func (r *FivetranConnectorReconciler) convertSchema(apiSchema *operatorv1alpha1.ConnectorSchemaConfig) *fivetran.SchemaBuilder {
+// ToSchemaBuilder converts the API schema to the Fivetran schema format sent to the Fivetran API
+func ToSchemaBuilder(apiSchema *operatorv1alpha1.ConnectorSchemaConfig) *fivetran.SchemaBuilder {
 	if apiSchema == nil {
 		return nil
 	}
@@ -240,7 +341,7 @@ func (r *FivetranConnectorReconciler) convertSchema(apiSchema *operatorv1alpha1.
 			}
 
 			builder.AddSchema(schemaName, schema.Enabled)
-			r.processSchemaTable(builder, schemaName, schema.Tables)
+			processSchemaTable(builder, schemaName, schema.Tables)
 		}
 	}
 
@@ -248,19 +349,19 @@ func (r *FivetranConnectorReconciler) convertSchema(apiSchema *operatorv1alpha1.
 }
 
 // processSchemaTable processes schema table configuration
-func (r *FivetranConnectorReconciler) processSchemaTable(builder *fivetran.SchemaBuilder, schemaName string, tables map[string]*operatorv1alpha1.TableObject) {
+func processSchemaTable(builder *fivetran.SchemaBuilder, schemaName string, tables map[string]*operatorv1alpha1.TableObject) {
 	for tableName, table := range tables {
 		if table == nil {
 			continue
 		}
 
 		builder.AddTable(schemaName, tableName, table.Enabled, table.SyncMode)
-		r.processTableColumns(builder, schemaName, tableName, table.Columns)
+		processTableColumns(builder, schemaName, tableName, table.Columns)
 	}
 }
 
 // processTableColumns processes table column configuration
-func (*FivetranConnectorReconciler) processTableColumns(builder *fivetran.SchemaBuilder, schemaName, tableName string, columns map[string]*operatorv1alpha1.ColumnObject) {
+func processTableColumns(builder *fivetran.SchemaBuilder, schemaName, tableName string, columns map[string]*operatorv1alpha1.ColumnObject) {
 	for columnName, column := range columns {
 		if column == nil {
 			continue
@@ -286,6 +387,20 @@ func (*FivetranConnectorReconciler) calculateConnectorHash(connector *operatorv1
 	return fmt.Sprintf("%x", hash), nil
 }
 
+// calculateSecretsHash calculates a hash of resolved connector config and auth. Since they hold
+// secret values, the hash is salted with the connector UID and uses SHA-256.
+func calculateSecretsHash(connector *operatorv1alpha1.FivetranConnector, configs ...*runtime.RawExtension) string {
+	hash := sha256.New()
+	hash.Write([]byte(connector.UID))
+	for _, config := range configs {
+		hash.Write([]byte{0})
+		if config != nil {
+			hash.Write(config.Raw)
+		}
+	}
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
+
 // calculateSchemaHash calculates a hash of the schema configuration
 func (*FivetranConnectorReconciler) calculateSchemaHash(connector *operatorv1alpha1.FivetranConnector) (string, error) {
 	if connector.Spec.ConnectorSchemas == nil {
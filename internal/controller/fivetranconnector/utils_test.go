@@ -0,0 +1,73 @@
+package fivetranconnector
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+func TestHasFailedConditions(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		expected   bool
+	}{
+		{
+			name:     "no conditions",
+			expected: false,
+		},
+		{
+			name: "all true",
+			conditions: []metav1.Condition{
+				{Type: "ConnectorReady", Status: metav1.ConditionTrue, Reason: "ReconciledSuccessfully"},
+				{Type: "VaultReady", Status: metav1.ConditionTrue, Reason: "Authenticated"},
+			},
+			expected: false,
+		},
+		{
+			name: "failed reconcile",
+			conditions: []metav1.Condition{
+				{Type: "ConnectorReady", Status: metav1.ConditionFalse, Reason: "ReconciliationFailed"},
+			},
+			expected: true,
+		},
+		{
+			name: "failed secret resolution",
+			conditions: []metav1.Condition{
+				{Type: "ConnectorReady", Status: metav1.ConditionTrue, Reason: "ReconciledSuccessfully"},
+				{Type: "VaultReady", Status: metav1.ConditionFalse, Reason: "SecretNotFound"},
+			},
+			expected: true,
+		},
+		{
+			name: "failed token renewal",
+			conditions: []metav1.Condition{
+				{Type: "ConnectorReady", Status: metav1.ConditionTrue, Reason: "ReconciledSuccessfully"},
+				{Type: "VaultReady", Status: metav1.ConditionFalse, Reason: "TokenRenewalFailed"},
+			},
+			expected: false,
+		},
+		{
+			name: "alerts and stale data",
+			conditions: []metav1.Condition{
+				{Type: "ConnectorAlerts", Status: metav1.ConditionFalse, Reason: "OpenWarnings"},
+				{Type: "DataFresh", Status: metav1.ConditionFalse, Reason: "SLABreached"},
+			},
+			expected: false,
+		},
+	}
+
+	r := &FivetranConnectorReconciler{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connector := &operatorv1alpha1.FivetranConnector{
+				Status: operatorv1alpha1.FivetranConnectorStatus{Conditions: tt.conditions},
+			}
+			if got := r.hasFailedConditions(connector); got != tt.expected {
+				t.Errorf("expected hasFailedConditions %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetranconnectorsummary
+
+import (
+	"context"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// FivetranConnectorSummaryReconciler aggregates the health of FivetranConnectors into
+// FivetranConnectorSummaries
+type FivetranConnectorSummaryReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=operator.dataverse.redhat.com,namespace=fivetran-operator,resources=fivetranconnectorsummaries,verbs=get;list;watch
+// +kubebuilder:rbac:groups=operator.dataverse.redhat.com,namespace=fivetran-operator,resources=fivetranconnectorsummaries/status,verbs=get;update;patch
+
+func (r *FivetranConnectorSummaryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	summary := &operatorv1alpha1.FivetranConnectorSummary{}
+	if err := r.Get(ctx, req.NamespacedName, summary); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	connectors := &operatorv1alpha1.FivetranConnectorList{}
+	if err := r.List(ctx, connectors, client.InNamespace(summary.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	status := summarize(summary.Spec.GroupID, connectors.Items)
+	status.ObservedGeneration = summary.Generation
+	if equality.Semantic.DeepEqual(status, summary.Status) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Updating connector summary", "connectors", status.Connectors, "ready", status.Ready, "failed", status.Failed)
+	summary.Status = status
+	return ctrl.Result{}, r.Status().Update(ctx, summary)
+}
+
+// summarize counts the ready, failed and paused connectors of a group, or of every group when
+// groupID is empty, and finds the False condition that has been failing the longest
+func summarize(groupID string, connectors []operatorv1alpha1.FivetranConnector) operatorv1alpha1.FivetranConnectorSummaryStatus {
+	var status operatorv1alpha1.FivetranConnectorSummaryStatus
+	for i := range connectors {
+		connector := &connectors[i]
+		if groupID != "" && connector.Spec.Connector.GroupID != groupID {
+			continue
+		}
+
+		status.Connectors++
+		if paused := connector.Spec.Connector.Paused; paused != nil && *paused {
+			status.Paused++
+		}
+
+		failed := false
+		ready := len(connector.Status.Conditions) > 0
+		for _, condition := range connector.Status.Conditions {
+			if condition.Status != metav1.ConditionTrue {
+				ready = false
+			}
+			if condition.Status != metav1.ConditionFalse {
+				continue
+			}
+			failed = true
+			if worseCondition(connector.Name, condition, status.WorstCondition) {
+				status.WorstCondition = &operatorv1alpha1.SummaryCondition{
+					Connector:          connector.Name,
+					Type:               condition.Type,
+					Reason:             condition.Reason,
+					Message:            condition.Message,
+					LastTransitionTime: condition.LastTransitionTime,
+				}
+			}
+		}
+
+		switch {
+		case failed:
+			status.Failed++
+			status.FailedConnectors = append(status.FailedConnectors, connector.Name)
+		case ready:
+			status.Ready++
+		}
+	}
+	sort.Strings(status.FailedConnectors)
+	return status
+}
+
+// worseCondition reports whether a False condition of a connector has been failing longer than the
+// current worst condition, breaking ties by connector name and condition type so the result does
+// not depend on list order
+func worseCondition(connector string, condition metav1.Condition, worst *operatorv1alpha1.SummaryCondition) bool {
+	if worst == nil {
+		return true
+	}
+	if !condition.LastTransitionTime.Equal(&worst.LastTransitionTime) {
+		return condition.LastTransitionTime.Before(&worst.LastTransitionTime)
+	}
+	if connector != worst.Connector {
+		return connector < worst.Connector
+	}
+	return condition.Type < worst.Type
+}
+
+// summariesForConnector maps a FivetranConnector to the summaries of its namespace
+func (r *FivetranConnectorSummaryReconciler) summariesForConnector(ctx context.Context, obj client.Object) []reconcile.Request {
+	summaries := &operatorv1alpha1.FivetranConnectorSummaryList{}
+	if err := r.List(ctx, summaries, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list connector summaries", "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(summaries.Items))
+	for _, summary := range summaries.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: summary.Namespace,
+			Name:      summary.Name,
+		}})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *FivetranConnectorSummaryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Connector status changes do not change the connector's generation, so every connector event
+	// reconciles the summaries of its namespace
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&operatorv1alpha1.FivetranConnectorSummary{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&operatorv1alpha1.FivetranConnector{}, handler.EnqueueRequestsFromMapFunc(r.summariesForConnector)).
+		Complete(r)
+}
@@ -0,0 +1,401 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fivetrandestination
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/fivetran/go-fivetran/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/internal/kubeutils"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+const (
+	fivetranFinalizer         = "fivetran.dataverse.redhat.com/finalizer"
+	annotationDestinationHash = "operator.dataverse.redhat.com/destination-hash"
+
+	// Condition types
+	conditionTypeDestinationReady = "DestinationReady"
+	conditionTypeSetupTestReady   = "SetupTestReady"
+
+	DestinationReasonSuccess                      = "ReconciledSuccessfully"
+	DestinationReasonReconciliationFailed         = "ReconciliationFailed"
+	DestinationReasonFivetranClientNotInitialized = "FivetranClientNotInitialized"
+	DestinationReasonSecretsResolutionFailed      = "SecretsResolutionFailed"
+	DestinationReasonFinalizerUpdateFailed        = "FinalizerUpdateFailed"
+	DestinationReasonDeletionFailed               = "DeletionFailed"
+
+	SetupTestsReasonReconciliationFailed              = "ReconciliationFailed"
+	SetupTestsReasonReconciliationSuccess             = "ReconciledSuccessfully"
+	SetupTestsReasonReconciliationSuccessWithWarnings = "ReconciledSuccessfullyWithWarnings"
+	SetupTestsReasonSkipped                           = "Skipped"
+
+	// Event reasons
+	eventReasonSetupTestFailed  = "SetupTestFailed"
+	eventReasonSetupTestWarning = "SetupTestWarning"
+
+	// Setup test status constants
+	setupTestStatusPassed  = "PASSED"
+	setupTestStatusSkipped = "SKIPPED"
+	setupTestStatusWarning = "WARNING"
+
+	// Status messages
+	msgDestinationReady                = "Destination is ready"
+	msgSetupTestsCompletedSuccessfully = "Setup tests completed successfully"
+	msgSetupTestsWarningsFormat        = "Setup tests completed with warnings: %s"
+	msgSetupTestsSkipped               = "Setup tests skipped"
+)
+
+var (
+	ErrFivetranClientNotInitialized = errors.New("fivetran client is not initialized")
+	ErrSetupTestsFailed             = errors.New("setup tests failed")
+	// ErrDynamicCredentialsNotSupported is returned for destinations referencing vaultDynamic:
+	// paths, since the leases of their credentials would never be renewed
+	ErrDynamicCredentialsNotSupported = errors.New("vaultDynamic: references are not supported in destination config")
+)
+
+// FivetranDestinationReconciler reconciles a FivetranDestination object
+type FivetranDestinationReconciler struct {
+	client.Client
+	Scheme         *runtime.Scheme
+	FivetranClient *fivetran.Client
+	// VaultClients provides the Vault client vault: references in the destination config are read
+	// with, using the connection secret named VaultSecretName in the destination's namespace
+	VaultClients    *vaultpkg.ClientManager
+	VaultSecretName string
+	// SecretResolvers resolves secret references of schemes other than vault: and vaultDynamic:
+	SecretResolvers *vault.Registry
+	// Recorder emits the events that detail setup test failures
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=operator.dataverse.redhat.com,namespace=fivetran-operator,resources=fivetrandestinations,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=operator.dataverse.redhat.com,namespace=fivetran-operator,resources=fivetrandestinations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=operator.dataverse.redhat.com,namespace=fivetran-operator,resources=fivetrandestinations/finalizers,verbs=update
+
+func (r *FivetranDestinationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// Mask resolved secret values in every log line and condition message of this reconcile
+	redactor := redact.New()
+	ctx = redact.IntoContext(ctx, redactor)
+	ctx = log.IntoContext(ctx, redact.NewLogger(log.FromContext(ctx), redactor))
+	logger := log.FromContext(ctx)
+
+	destination := &operatorv1alpha1.FivetranDestination{}
+	if err := r.Get(ctx, req.NamespacedName, destination); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if r.FivetranClient == nil {
+		return r.handleError(ctx, destination, conditionTypeDestinationReady, DestinationReasonFivetranClientNotInitialized, ErrFivetranClientNotInitialized)
+	}
+
+	if !destination.DeletionTimestamp.IsZero() {
+		if err := r.handleDeletion(ctx, destination); err != nil {
+			return r.handleError(ctx, destination, conditionTypeDestinationReady, DestinationReasonDeletionFailed, err)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(destination, fivetranFinalizer) {
+		controllerutil.AddFinalizer(destination, fivetranFinalizer)
+		if err := r.Update(ctx, destination); err != nil {
+			return r.handleError(ctx, destination, conditionTypeDestinationReady, DestinationReasonFinalizerUpdateFailed, err)
+		}
+	}
+
+	desired, err := r.desiredDestination(ctx, destination)
+	if err != nil {
+		return r.handleError(ctx, destination, conditionTypeDestinationReady, DestinationReasonSecretsResolutionFailed, err)
+	}
+
+	changed, err := r.reconcileDestination(ctx, destination, desired)
+	if err != nil {
+		return r.handleError(ctx, destination, conditionTypeDestinationReady, DestinationReasonReconciliationFailed, err)
+	}
+	meta.SetStatusCondition(&destination.Status.Conditions, metav1.Condition{
+		Type:    conditionTypeDestinationReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  DestinationReasonSuccess,
+		Message: msgDestinationReady,
+	})
+
+	// Setup tests run when the destination or its spec changes and are retried until they pass
+	if changed || destination.Status.ObservedGeneration != destination.Generation ||
+		!meta.IsStatusConditionTrue(destination.Status.Conditions, conditionTypeSetupTestReady) {
+		if err := r.reconcileSetupTests(ctx, destination, desired); err != nil {
+			return r.handleError(ctx, destination, conditionTypeSetupTestReady, SetupTestsReasonReconciliationFailed, err)
+		}
+	}
+
+	destination.Status.ObservedGeneration = destination.Generation
+	if err := r.Status().Update(ctx, destination); err != nil {
+		return ctrl.Result{}, err
+	}
+	logger.Info("Reconciliation completed", "destinationId", destination.Status.DestinationID)
+	return ctrl.Result{}, nil
+}
+
+// desiredDestination builds the destination to send to Fivetran from the spec, with the secret
+// references of its config resolved
+func (r *FivetranDestinationReconciler) desiredDestination(ctx context.Context, destination *operatorv1alpha1.FivetranDestination) (*fivetran.Destination, error) {
+	spec := destination.Spec.Destination
+	desired := &fivetran.Destination{
+		GroupID:                   spec.GroupID,
+		Service:                   spec.Service,
+		Region:                    spec.Region,
+		TimeZoneOffset:            spec.TimeZoneOffset,
+		TrustCertificates:         spec.TrustCertificates,
+		TrustFingerprints:         spec.TrustFingerprints,
+		DaylightSavingTimeEnabled: spec.DaylightSavingTimeEnabled,
+		NetworkingMethod:          spec.NetworkingMethod,
+		PrivateLinkID:             spec.PrivateLinkID,
+		HybridDeploymentAgentID:   spec.HybridDeploymentAgentID,
+	}
+	if spec.Config == nil {
+		return desired, nil
+	}
+
+	config := spec.Config.DeepCopy()
+	results, err := r.SecretResolvers.ResolveAll(ctx, r.vaultClient(destination.Namespace), config)
+	if err != nil {
+		return nil, err
+	}
+	redact.FromContext(ctx).Add(results[0].SensitiveValues...)
+	if len(results[0].Leases) > 0 {
+		return nil, ErrDynamicCredentialsNotSupported
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal(config.Raw, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal destination config: %w", err)
+	}
+	desired.Config = &data
+	return desired, nil
+}
+
+// vaultClient returns the ClientFunc reading the vault: references of a destination's config, or
+// nil when Vault is not configured
+func (r *FivetranDestinationReconciler) vaultClient(namespace string) vault.ClientFunc {
+	if r.VaultClients == nil {
+		return nil
+	}
+	return func(ctx context.Context) (*vaultpkg.VaultClient, error) {
+		return r.VaultClients.Client(ctx, namespace, r.VaultSecretName)
+	}
+}
+
+// reconcileDestination creates the Fivetran destination, or updates it when the spec or the
+// resolved secrets changed since they were last applied, and reports whether it did either
+func (r *FivetranDestinationReconciler) reconcileDestination(ctx context.Context, destination *operatorv1alpha1.FivetranDestination, desired *fivetran.Destination) (bool, error) {
+	logger := log.FromContext(ctx)
+	hash, err := destinationHash(destination, desired)
+	if err != nil {
+		return false, err
+	}
+
+	if destination.Status.DestinationID == "" {
+		logger.Info("Creating Fivetran destination", "groupId", desired.GroupID, "service", desired.Service)
+		resp, err := r.FivetranClient.Destinations.CreateDestination(ctx, desired)
+		if err != nil {
+			return false, fmt.Errorf("failed to create destination: %w", err)
+		}
+		destination.Status.DestinationID = resp.Data.ID
+		// The ID is recorded before anything else can fail. A destination whose ID could not be
+		// recorded is deleted, so the next reconcile does not create a second one.
+		if err := r.Status().Update(ctx, destination); err != nil {
+			if _, deleteErr := r.FivetranClient.Destinations.DeleteDestination(ctx, resp.Data.ID); deleteErr != nil {
+				logger.Error(deleteErr, "failed to delete destination whose ID could not be recorded", "destinationId", resp.Data.ID)
+			}
+			destination.Status.DestinationID = ""
+			return false, err
+		}
+	} else if kubeutils.GetAnnotation(destination, annotationDestinationHash) != hash {
+		logger.Info("Updating Fivetran destination", "destinationId", destination.Status.DestinationID)
+		if _, err := r.FivetranClient.Destinations.UpdateDestination(ctx, destination.Status.DestinationID, desired); err != nil {
+			return false, fmt.Errorf("failed to update destination: %w", err)
+		}
+	} else {
+		return false, nil
+	}
+
+	// Updating the object replaces its status with the stored one, which holds every change so far
+	kubeutils.SetAnnotation(destination, annotationDestinationHash, hash)
+	return true, r.Update(ctx, destination)
+}
+
+// destinationHash hashes the destination sent to Fivetran, including its resolved secrets, so a
+// changed spec or rotated secret is applied once
+func destinationHash(destination *operatorv1alpha1.FivetranDestination, desired *fivetran.Destination) (string, error) {
+	data, err := json.Marshal(desired)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	hash.Write([]byte(destination.UID))
+	hash.Write([]byte{0})
+	hash.Write(data)
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+// reconcileSetupTests runs the setup tests of the destination, which verify its credentials and
+// networking, and records their results in the SetupTestReady condition. Certificates and
+// fingerprints are only trusted when the spec asks for it.
+func (r *FivetranDestinationReconciler) reconcileSetupTests(ctx context.Context, destination *operatorv1alpha1.FivetranDestination, desired *fivetran.Destination) error {
+	logger := log.FromContext(ctx)
+	spec := destination.Spec.Destination
+	if spec.RunSetupTests != nil && !*spec.RunSetupTests {
+		destination.Status.SetupTests = nil
+		meta.SetStatusCondition(&destination.Status.Conditions, metav1.Condition{
+			Type:    conditionTypeSetupTestReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  SetupTestsReasonSkipped,
+			Message: msgSetupTestsSkipped,
+		})
+		return nil
+	}
+
+	logger.Info("Running destination setup tests", "destinationId", destination.Status.DestinationID)
+	resp, err := r.FivetranClient.Destinations.RunDestinationSetupTests(ctx, destination.Status.DestinationID,
+		ptr.Deref(desired.TrustCertificates, false), ptr.Deref(desired.TrustFingerprints, false))
+	if err != nil {
+		return fmt.Errorf("reconcileSetupTests: %w", err)
+	}
+
+	redactor := redact.FromContext(ctx)
+	now := metav1.Now()
+	destination.Status.SetupTests = make([]operatorv1alpha1.SetupTestResult, 0, len(resp.Data.SetupTests))
+	var failures, warnings []string
+	for _, test := range resp.Data.SetupTests {
+		destination.Status.SetupTests = append(destination.Status.SetupTests, operatorv1alpha1.SetupTestResult{
+			Title:       test.Title,
+			Status:      test.Status,
+			Message:     redactor.Redact(test.Message),
+			LastRunTime: now,
+		})
+		switch test.Status {
+		case setupTestStatusPassed, setupTestStatusSkipped:
+		case setupTestStatusWarning:
+			warnings = append(warnings, fmt.Sprintf("%s: %s", test.Title, test.Message))
+			r.warningEvent(ctx, destination, eventReasonSetupTestWarning, setupTestEventMessage(test))
+		default:
+			failures = append(failures, fmt.Sprintf("%s (status: %s) - %s", test.Title, test.Status, test.Message))
+			r.warningEvent(ctx, destination, eventReasonSetupTestFailed, setupTestEventMessage(test))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%w: %s", ErrSetupTestsFailed, strings.Join(failures, "; "))
+	}
+	reason, message := SetupTestsReasonReconciliationSuccess, msgSetupTestsCompletedSuccessfully
+	if len(warnings) > 0 {
+		reason, message = SetupTestsReasonReconciliationSuccessWithWarnings, fmt.Sprintf(msgSetupTestsWarningsFormat, strings.Join(warnings, "; "))
+	}
+	meta.SetStatusCondition(&destination.Status.Conditions, metav1.Condition{
+		Type:    conditionTypeSetupTestReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  reason,
+		Message: redactor.Redact(message),
+	})
+	return nil
+}
+
+// setupTestEventMessage describes a setup test result that did not pass
+func setupTestEventMessage(test common.SetupTestResponse) string {
+	message := fmt.Sprintf("Setup test %q %s: %s", test.Title, test.Status, test.Message)
+	if test.Details != "" {
+		message += " (" + test.Details + ")"
+	}
+	return message
+}
+
+// warningEvent records a Warning event on the destination with resolved secrets masked in its message
+func (r *FivetranDestinationReconciler) warningEvent(ctx context.Context, destination *operatorv1alpha1.FivetranDestination, reason, message string) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Event(destination, corev1.EventTypeWarning, reason, redact.FromContext(ctx).Redact(message))
+}
+
+// handleDeletion deletes the Fivetran destination and removes the finalizer
+func (r *FivetranDestinationReconciler) handleDeletion(ctx context.Context, destination *operatorv1alpha1.FivetranDestination) error {
+	if !controllerutil.ContainsFinalizer(destination, fivetranFinalizer) {
+		return nil
+	}
+
+	if destinationID := destination.Status.DestinationID; destinationID != "" {
+		log.FromContext(ctx).Info("Deleting Fivetran destination", "destinationId", destinationID)
+		_, err := r.FivetranClient.Destinations.DeleteDestination(ctx, destinationID)
+		if apiErr, ok := fivetran.AsAPIError(err); ok && apiErr.StatusCode == http.StatusNotFound {
+			err = nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete destination: %w", err)
+		}
+	}
+
+	controllerutil.RemoveFinalizer(destination, fivetranFinalizer)
+	return r.Update(ctx, destination)
+}
+
+// handleError records a failed reconcile in a False condition and returns the error, so the
+// reconcile is retried with backoff
+func (r *FivetranDestinationReconciler) handleError(ctx context.Context, destination *operatorv1alpha1.FivetranDestination, conditionType, reason string, err error) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.Error(err, "Reconciliation failed", "condition", conditionType, "reason", reason)
+
+	meta.SetStatusCondition(&destination.Status.Conditions, metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: redact.FromContext(ctx).Redact(err.Error()),
+	})
+	destination.Status.ObservedGeneration = destination.Generation
+	if updateErr := r.Status().Update(ctx, destination); updateErr != nil {
+		logger.Error(updateErr, "failed to update destination status")
+	}
+	return ctrl.Result{}, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *FivetranDestinationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&operatorv1alpha1.FivetranDestination{}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
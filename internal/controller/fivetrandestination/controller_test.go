@@ -0,0 +1,257 @@
+package fivetrandestination
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/go-fivetran/destinations"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+// fakeDestinations records the calls made to a fivetran.DestinationsService
+type fakeDestinations struct {
+	created, updated, deleted int
+	// trust holds the trust settings of each setup test run
+	trust      [][2]bool
+	setupTests []common.SetupTestResponse
+	deleteErr  error
+}
+
+func (f *fakeDestinations) CreateDestination(_ context.Context, _ *fivetran.Destination) (destinations.DestinationDetailsWithSetupTestsCustomResponse, error) {
+	f.created++
+	var resp destinations.DestinationDetailsWithSetupTestsCustomResponse
+	resp.Data.ID = "destination_1"
+	return resp, nil
+}
+
+func (f *fakeDestinations) GetDestination(_ context.Context, _ string) (destinations.DestinationDetailsCustomResponse, error) {
+	return destinations.DestinationDetailsCustomResponse{}, nil
+}
+
+func (f *fakeDestinations) UpdateDestination(_ context.Context, _ string, _ *fivetran.Destination) (destinations.DestinationDetailsWithSetupTestsCustomResponse, error) {
+	f.updated++
+	return destinations.DestinationDetailsWithSetupTestsCustomResponse{}, nil
+}
+
+func (f *fakeDestinations) DeleteDestination(_ context.Context, _ string) (common.CommonResponse, error) {
+	f.deleted++
+	return common.CommonResponse{}, f.deleteErr
+}
+
+func (f *fakeDestinations) RunDestinationSetupTests(_ context.Context, _ string, trustCertificates, trustFingerprints bool) (destinations.DestinationDetailsWithSetupTestsResponse, error) {
+	f.trust = append(f.trust, [2]bool{trustCertificates, trustFingerprints})
+	var resp destinations.DestinationDetailsWithSetupTestsResponse
+	resp.Data.SetupTests = f.setupTests
+	return resp, nil
+}
+
+func newTestReconciler(t *testing.T, service *fakeDestinations, objects ...client.Object) *FivetranDestinationReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := operatorv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&operatorv1alpha1.FivetranDestination{}).
+		WithObjects(objects...).
+		Build()
+	return &FivetranDestinationReconciler{
+		Client:         k8sClient,
+		Scheme:         scheme,
+		FivetranClient: &fivetran.Client{Destinations: service},
+	}
+}
+
+func testDestination() *operatorv1alpha1.FivetranDestination {
+	return &operatorv1alpha1.FivetranDestination{
+		ObjectMeta: metav1.ObjectMeta{Name: "warehouse", Namespace: "destinations", UID: "9a1e5c3d"},
+		Spec: operatorv1alpha1.FivetranDestinationSpec{
+			Destination: operatorv1alpha1.Destination{
+				GroupID: "group_id",
+				Service: "snowflake",
+				Config:  &runtime.RawExtension{Raw: []byte(`{"host":"warehouse.example.com"}`)},
+			},
+		},
+	}
+}
+
+func reconcileDestination(t *testing.T, r *FivetranDestinationReconciler) (*operatorv1alpha1.FivetranDestination, error) {
+	t.Helper()
+	key := types.NamespacedName{Namespace: "destinations", Name: "warehouse"}
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	destination := &operatorv1alpha1.FivetranDestination{}
+	if getErr := r.Get(context.Background(), key, destination); getErr != nil {
+		t.Fatalf("failed to get destination: %v", getErr)
+	}
+	return destination, err
+}
+
+func TestReconcileSetupTests(t *testing.T) {
+	tests := []struct {
+		name         string
+		setupTests   []common.SetupTestResponse
+		expectErr    bool
+		expectStatus metav1.ConditionStatus
+		expectReason string
+	}{
+		{
+			name:         "passed",
+			setupTests:   []common.SetupTestResponse{{Title: "Host Connection", Status: "PASSED"}},
+			expectStatus: metav1.ConditionTrue,
+			expectReason: "ReconciledSuccessfully",
+		},
+		{
+			name:         "warning",
+			setupTests:   []common.SetupTestResponse{{Title: "Permissions", Status: "WARNING", Message: "Missing grant"}},
+			expectStatus: metav1.ConditionTrue,
+			expectReason: "ReconciledSuccessfullyWithWarnings",
+		},
+		{
+			name:         "failed",
+			setupTests:   []common.SetupTestResponse{{Title: "Host Connection", Status: "FAILED", Message: "Connection refused"}},
+			expectErr:    true,
+			expectStatus: metav1.ConditionFalse,
+			expectReason: "ReconciliationFailed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &fakeDestinations{setupTests: tt.setupTests}
+			r := newTestReconciler(t, service, testDestination())
+
+			destination, err := reconcileDestination(t, r)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+			}
+			if tt.expectErr && !errors.Is(err, ErrSetupTestsFailed) {
+				t.Errorf("expected ErrSetupTestsFailed, got %v", err)
+			}
+
+			if destination.Status.DestinationID != "destination_1" || service.created != 1 {
+				t.Errorf("expected destination_1 to be created once, got %q after %d creates", destination.Status.DestinationID, service.created)
+			}
+			if !meta.IsStatusConditionTrue(destination.Status.Conditions, "DestinationReady") {
+				t.Errorf("expected DestinationReady True, got %+v", destination.Status.Conditions)
+			}
+			condition := meta.FindStatusCondition(destination.Status.Conditions, "SetupTestReady")
+			if condition == nil || condition.Status != tt.expectStatus || condition.Reason != tt.expectReason {
+				t.Errorf("expected SetupTestReady %s/%s, got %+v", tt.expectStatus, tt.expectReason, condition)
+			}
+			if len(destination.Status.SetupTests) != len(tt.setupTests) {
+				t.Errorf("expected %d setup test results, got %+v", len(tt.setupTests), destination.Status.SetupTests)
+			}
+		})
+	}
+}
+
+func TestReconcileTrustsOnlyWhenAsked(t *testing.T) {
+	trusted := true
+	tests := []struct {
+		name              string
+		trustCertificates *bool
+		trustFingerprints *bool
+		expect            [2]bool
+	}{
+		{name: "unset", expect: [2]bool{false, false}},
+		{name: "certificates", trustCertificates: &trusted, expect: [2]bool{true, false}},
+		{name: "both", trustCertificates: &trusted, trustFingerprints: &trusted, expect: [2]bool{true, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			destination := testDestination()
+			destination.Spec.Destination.TrustCertificates = tt.trustCertificates
+			destination.Spec.Destination.TrustFingerprints = tt.trustFingerprints
+			service := &fakeDestinations{}
+			r := newTestReconciler(t, service, destination)
+
+			if _, err := reconcileDestination(t, r); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(service.trust) != 1 || service.trust[0] != tt.expect {
+				t.Errorf("expected one setup test run with trust %v, got %v", tt.expect, service.trust)
+			}
+		})
+	}
+}
+
+func TestReconcileAppliesChangesOnce(t *testing.T) {
+	service := &fakeDestinations{setupTests: []common.SetupTestResponse{{Title: "Host Connection", Status: "PASSED"}}}
+	r := newTestReconciler(t, service, testDestination())
+
+	if _, err := reconcileDestination(t, r); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	destination, err := reconcileDestination(t, r)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if service.created != 1 || service.updated != 0 || len(service.trust) != 1 {
+		t.Errorf("expected an unchanged destination to be left alone, got %d creates, %d updates and %d setup test runs",
+			service.created, service.updated, len(service.trust))
+	}
+
+	destination.Spec.Destination.Region = "GCP_US_EAST4"
+	if err := r.Update(context.Background(), destination); err != nil {
+		t.Fatalf("failed to update destination: %v", err)
+	}
+	if _, err := reconcileDestination(t, r); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if service.created != 1 || service.updated != 1 || len(service.trust) != 2 {
+		t.Errorf("expected a changed destination to be updated and tested once, got %d creates, %d updates and %d setup test runs",
+			service.created, service.updated, len(service.trust))
+	}
+}
+
+func TestReconcileDeletion(t *testing.T) {
+	tests := []struct {
+		name      string
+		deleteErr error
+		expectErr bool
+	}{
+		{name: "deleted"},
+		{name: "already gone", deleteErr: &fivetran.APIError{StatusCode: http.StatusNotFound}},
+		{name: "failed", deleteErr: &fivetran.APIError{StatusCode: http.StatusInternalServerError}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			destination := testDestination()
+			destination.Finalizers = []string{fivetranFinalizer}
+			destination.Status.DestinationID = "destination_1"
+			service := &fakeDestinations{deleteErr: tt.deleteErr}
+			r := newTestReconciler(t, service, destination)
+			if err := r.Delete(context.Background(), destination); err != nil {
+				t.Fatalf("failed to delete destination: %v", err)
+			}
+
+			key := types.NamespacedName{Namespace: "destinations", Name: "warehouse"}
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+			}
+			if service.deleted != 1 {
+				t.Errorf("expected the Fivetran destination to be deleted once, got %d", service.deleted)
+			}
+			getErr := r.Get(context.Background(), key, &operatorv1alpha1.FivetranDestination{})
+			if removed := apierrors.IsNotFound(getErr); removed == tt.expectErr {
+				t.Errorf("expected the FivetranDestination removed %v, got %v", !tt.expectErr, getErr)
+			}
+		})
+	}
+}
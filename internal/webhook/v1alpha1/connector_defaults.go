@@ -0,0 +1,137 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+var (
+	syncFrequencies        = []int{1, 5, 15, 30, 60, 120, 180, 360, 480, 720, 1440}
+	scheduleTypes          = []string{"auto", "manual"}
+	dataDelaySensitivities = []string{"LOW", "NORMAL", "HIGH", dataDelaySensitivityCustom, "SYNC_FREQUENCY"}
+)
+
+// +kubebuilder:rbac:groups=operator.dataverse.redhat.com,namespace=fivetran-operator,resources=fivetrangroupdefaults,verbs=get;list;watch
+
+// +kubebuilder:webhook:path=/mutate-operator-dataverse-redhat-com-v1alpha1-fivetranconnector,mutating=true,failurePolicy=fail,sideEffects=None,groups=operator.dataverse.redhat.com,resources=fivetranconnectors,verbs=create;update,versions=v1alpha1,name=mfivetranconnector-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// FivetranConnectorCustomDefaulter fills the sync, trust and data delay settings a FivetranConnector
+// leaves unset, from the FivetranGroupDefaults of its group, then from the operator's defaults.
+type FivetranConnectorCustomDefaulter struct {
+	// Client reads the FivetranGroupDefaults of the connector's namespace
+	Client client.Reader
+	// Defaults are the operator's defaults
+	Defaults operatorv1alpha1.ConnectorDefaults
+}
+
+var _ webhook.CustomDefaulter = &FivetranConnectorCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the type FivetranConnector.
+func (d *FivetranConnectorCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	connector, ok := obj.(*operatorv1alpha1.FivetranConnector)
+	if !ok {
+		return fmt.Errorf("expected a FivetranConnector object but got %T", obj)
+	}
+	if !connector.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	fivetranconnectorlog.Info("Defaulting for FivetranConnector", "name", connector.GetName())
+
+	groupDefaults, err := d.groupDefaults(ctx, connector.Namespace, connector.Spec.Connector.GroupID)
+	if err != nil {
+		return err
+	}
+	if groupDefaults != nil {
+		applyDefaults(&connector.Spec.Connector, groupDefaults.Spec.Connector)
+	}
+	applyDefaults(&connector.Spec.Connector, d.Defaults)
+	return nil
+}
+
+// groupDefaults returns the FivetranGroupDefaults of a group in a namespace, the first by name when
+// there are several, or nil when there is none
+func (d *FivetranConnectorCustomDefaulter) groupDefaults(ctx context.Context, namespace, groupID string) (*operatorv1alpha1.FivetranGroupDefaults, error) {
+	list := &operatorv1alpha1.FivetranGroupDefaultsList{}
+	if err := d.Client.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list FivetranGroupDefaults: %w", err)
+	}
+
+	var matching []*operatorv1alpha1.FivetranGroupDefaults
+	for i := range list.Items {
+		if list.Items[i].Spec.GroupID == groupID {
+			matching = append(matching, &list.Items[i])
+		}
+	}
+	if len(matching) == 0 {
+		return nil, nil
+	}
+	sort.Slice(matching, func(i, j int) bool { return matching[i].Name < matching[j].Name })
+	return matching[0], nil
+}
+
+// applyDefaults sets the fields of a connector left unset to their defaults. A data delay threshold
+// is only defaulted for a CUSTOM data delay sensitivity.
+func applyDefaults(connector *operatorv1alpha1.Connector, defaults operatorv1alpha1.ConnectorDefaults) {
+	if connector.SyncFrequency == 0 {
+		connector.SyncFrequency = defaults.SyncFrequency
+	}
+	if connector.ScheduleType == "" {
+		connector.ScheduleType = defaults.ScheduleType
+	}
+	if connector.TrustCertificates == nil && defaults.TrustCertificates != nil {
+		trust := *defaults.TrustCertificates
+		connector.TrustCertificates = &trust
+	}
+	if connector.TrustFingerprints == nil && defaults.TrustFingerprints != nil {
+		trust := *defaults.TrustFingerprints
+		connector.TrustFingerprints = &trust
+	}
+	if connector.DataDelaySensitivity == "" {
+		connector.DataDelaySensitivity = defaults.DataDelaySensitivity
+	}
+	if connector.DataDelayThreshold == 0 && connector.DataDelaySensitivity == dataDelaySensitivityCustom {
+		connector.DataDelayThreshold = defaults.DataDelayThreshold
+	}
+}
+
+// validateDefaults checks the operator's defaults against the values the CRD accepts, so invalid
+// defaults fail at startup rather than every admission
+func validateDefaults(defaults operatorv1alpha1.ConnectorDefaults) error {
+	if defaults.SyncFrequency != 0 && !slices.Contains(syncFrequencies, defaults.SyncFrequency) {
+		return fmt.Errorf("invalid default sync frequency %d, must be one of %v", defaults.SyncFrequency, syncFrequencies)
+	}
+	if defaults.ScheduleType != "" && !slices.Contains(scheduleTypes, defaults.ScheduleType) {
+		return fmt.Errorf("invalid default schedule type %q, must be one of %v", defaults.ScheduleType, scheduleTypes)
+	}
+	if defaults.DataDelaySensitivity != "" && !slices.Contains(dataDelaySensitivities, defaults.DataDelaySensitivity) {
+		return fmt.Errorf("invalid default data delay sensitivity %q, must be one of %v", defaults.DataDelaySensitivity, dataDelaySensitivities)
+	}
+	if defaults.DataDelayThreshold < 0 {
+		return fmt.Errorf("invalid default data delay threshold %d, must not be negative", defaults.DataDelayThreshold)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestDefault(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := operatorv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	groupDefaults := func(namespace, name, groupID string, defaults operatorv1alpha1.ConnectorDefaults) *operatorv1alpha1.FivetranGroupDefaults {
+		return &operatorv1alpha1.FivetranGroupDefaults{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       operatorv1alpha1.FivetranGroupDefaultsSpec{GroupID: groupID, Connector: defaults},
+		}
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		groupDefaults("connectors", "marketing", "group_a", operatorv1alpha1.ConnectorDefaults{
+			SyncFrequency:        60,
+			TrustFingerprints:    boolPtr(false),
+			DataDelaySensitivity: dataDelaySensitivityCustom,
+			DataDelayThreshold:   120,
+		}),
+		// Only the first defaults of a group by name apply
+		groupDefaults("connectors", "zz-marketing", "group_a", operatorv1alpha1.ConnectorDefaults{SyncFrequency: 5}),
+		groupDefaults("other", "marketing", "group_a", operatorv1alpha1.ConnectorDefaults{ScheduleType: "auto"}),
+	).Build()
+	defaulter := &FivetranConnectorCustomDefaulter{Client: k8sClient, Defaults: operatorv1alpha1.ConnectorDefaults{
+		SyncFrequency:     1440,
+		ScheduleType:      "manual",
+		TrustCertificates: boolPtr(true),
+		TrustFingerprints: boolPtr(true),
+	}}
+
+	t.Run("group defaults before operator defaults", func(t *testing.T) {
+		connector := newConnector("connectors", "users", "group_a", `{}`)
+		if err := defaulter.Default(context.Background(), connector); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := connector.Spec.Connector
+		if got.SyncFrequency != 60 || got.ScheduleType != "manual" || !*got.TrustCertificates || *got.TrustFingerprints ||
+			got.DataDelaySensitivity != dataDelaySensitivityCustom || got.DataDelayThreshold != 120 {
+			t.Errorf("unexpected defaults: %+v", got)
+		}
+	})
+
+	t.Run("connector settings are kept", func(t *testing.T) {
+		connector := newConnector("connectors", "users", "group_a", `{}`)
+		connector.Spec.Connector.SyncFrequency = 15
+		connector.Spec.Connector.TrustFingerprints = boolPtr(true)
+		connector.Spec.Connector.DataDelaySensitivity = "LOW"
+		if err := defaulter.Default(context.Background(), connector); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := connector.Spec.Connector
+		if got.SyncFrequency != 15 || !*got.TrustFingerprints || got.DataDelaySensitivity != "LOW" || got.DataDelayThreshold != 0 {
+			t.Errorf("unexpected defaults: %+v", got)
+		}
+	})
+
+	t.Run("operator defaults without group defaults", func(t *testing.T) {
+		connector := newConnector("connectors", "users", "group_b", `{}`)
+		if err := defaulter.Default(context.Background(), connector); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := connector.Spec.Connector
+		if got.SyncFrequency != 1440 || got.ScheduleType != "manual" || !*got.TrustCertificates || !*got.TrustFingerprints ||
+			got.DataDelaySensitivity != "" {
+			t.Errorf("unexpected defaults: %+v", got)
+		}
+	})
+}
+
+func TestValidateDefaults(t *testing.T) {
+	valid := operatorv1alpha1.ConnectorDefaults{SyncFrequency: 360, ScheduleType: "auto", DataDelaySensitivity: "HIGH"}
+	if err := validateDefaults(valid); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validateDefaults(operatorv1alpha1.ConnectorDefaults{}); err != nil {
+		t.Errorf("unexpected error for no defaults: %v", err)
+	}
+
+	for _, invalid := range []operatorv1alpha1.ConnectorDefaults{
+		{SyncFrequency: 90},
+		{ScheduleType: "daily"},
+		{DataDelaySensitivity: "MEDIUM"},
+		{DataDelayThreshold: -1},
+	} {
+		if err := validateDefaults(invalid); err == nil {
+			t.Errorf("expected error for %+v", invalid)
+		}
+	}
+}
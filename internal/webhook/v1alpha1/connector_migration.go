@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// annotationMigrateConnector set to "true" admits updates of group_id and service, which the
+// operator applies by retiring the Fivetran connector according to the deletion policy and
+// creating a new one
+const annotationMigrateConnector = "operator.dataverse.redhat.com/migrate-connector"
+
+// annotationReplaceConnector set to "true" admits the same updates, which the operator applies by
+// creating the new connector alongside the current one before retiring it
+const annotationReplaceConnector = "operator.dataverse.redhat.com/replace-connector"
+
+// validateImmutableFields returns an error for each of group_id and service an update changes.
+// Fivetran cannot move a connector to another group or change its service, so the change needs
+// the migrate or replace annotation.
+func validateImmutableFields(oldConnector, connector *operatorv1alpha1.Connector, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if oldConnector.GroupID != connector.GroupID {
+		allErrs = append(allErrs, immutableField(path.Child("group_id")))
+	}
+	if oldConnector.Service != connector.Service {
+		allErrs = append(allErrs, immutableField(path.Child("service")))
+	}
+	return allErrs
+}
+
+// immutableField returns the error for a change of an immutable field, naming the annotations that
+// admit it
+func immutableField(path *field.Path) *field.Error {
+	return field.Forbidden(path, fmt.Sprintf("field is immutable; set the %s or %s annotation to \"true\" to replace "+
+		"the Fivetran connector according to spec.deletionPolicy", annotationMigrateConnector, annotationReplaceConnector))
+}
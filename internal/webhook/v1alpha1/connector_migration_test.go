@@ -0,0 +1,79 @@
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+func TestValidateImmutableFields(t *testing.T) {
+	existing := newConnector("connectors", "users", "group_a", `{"schema_prefix":"users"}`)
+	validator := newValidator(t, existing)
+
+	tests := []struct {
+		name      string
+		change    func(connector *operatorv1alpha1.FivetranConnector)
+		errFields []string
+	}{
+		{
+			name: "other fields",
+			change: func(connector *operatorv1alpha1.FivetranConnector) {
+				connector.Spec.Connector.SyncFrequency = 60
+			},
+		},
+		{
+			name: "group",
+			change: func(connector *operatorv1alpha1.FivetranConnector) {
+				connector.Spec.Connector.GroupID = "group_b"
+			},
+			errFields: []string{"spec.connector.group_id"},
+		},
+		{
+			name: "group and service",
+			change: func(connector *operatorv1alpha1.FivetranConnector) {
+				connector.Spec.Connector.GroupID = "group_b"
+				connector.Spec.Connector.Service = "mysql"
+			},
+			errFields: []string{"spec.connector.group_id", "spec.connector.service"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := existing.DeepCopy()
+			tt.change(updated)
+			_, err := validator.ValidateUpdate(context.Background(), existing, updated)
+			if len(tt.errFields) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if !apierrors.IsInvalid(err) {
+				t.Fatalf("expected an Invalid error, got %v", err)
+			}
+			causes := err.(*apierrors.StatusError).ErrStatus.Details.Causes
+			if len(causes) != len(tt.errFields) {
+				t.Fatalf("expected %d causes, got %v", len(tt.errFields), causes)
+			}
+			for _, expected := range tt.errFields {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error for %s, got %v", expected, err)
+				}
+			}
+
+			// Either annotation admits the migration
+			for _, annotation := range []string{annotationMigrateConnector, annotationReplaceConnector} {
+				updated.Annotations = map[string]string{annotation: "true"}
+				if _, err := validator.ValidateUpdate(context.Background(), existing, updated); err != nil {
+					t.Errorf("expected the change annotated with %s to be admitted, got %v", annotation, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fivetran/go-fivetran/metadata"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+// connectorTypeTTL is how long the metadata of a connector type is reused across admissions
+const connectorTypeTTL = time.Hour
+
+// connectorTypes caches the metadata of connector types, so admissions do not each call Fivetran
+type connectorTypes struct {
+	service fivetran.MetadataService
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]connectorTypeEntry
+}
+
+// connectorTypeEntry is the cached metadata of a connector type, nil when Fivetran does not know it
+type connectorTypeEntry struct {
+	metadata *metadata.ConnectorMetadata
+	expires  time.Time
+}
+
+func newConnectorTypes(service fivetran.MetadataService) *connectorTypes {
+	return &connectorTypes{
+		service: service,
+		ttl:     connectorTypeTTL,
+		now:     time.Now,
+		entries: map[string]connectorTypeEntry{},
+	}
+}
+
+// get returns the metadata of a connector type, or nil when Fivetran does not know the service
+func (c *connectorTypes) get(ctx context.Context, service string) (*metadata.ConnectorMetadata, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[service]
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expires) {
+		return entry.metadata, nil
+	}
+
+	resp, err := c.service.GetConnectorType(ctx, service)
+	if err != nil {
+		if apiErr, ok := fivetran.AsAPIError(err); !ok || apiErr.StatusCode != http.StatusNotFound {
+			return nil, err
+		}
+	} else {
+		entry.metadata = &resp.Data.ConnectorMetadata
+	}
+
+	entry.expires = c.now().Add(c.ttl)
+	c.mu.Lock()
+	c.entries[service] = entry
+	c.mu.Unlock()
+	return entry.metadata, nil
+}
+
+// validateRequiredFields checks that the fields a connector type requires are set, with any value
+// including secret references and placeholders
+func validateRequiredFields(property metadata.Property, values map[string]any, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	var missing string
+	for _, required := range fivetran.RequiredFields(property) {
+		// The fields of a missing object are reported with the object
+		if missing != "" && strings.HasPrefix(required, missing+".") {
+			continue
+		}
+		if !hasField(values, required) {
+			missing = required
+			names := strings.Split(required, ".")
+			allErrs = append(allErrs, field.Required(path.Child(names[0], names[1:]...),
+				"required by the connector type"))
+		}
+	}
+	return allErrs
+}
+
+// hasField reports whether a dotted path of nested objects leads to a value other than null or an
+// empty string
+func hasField(values map[string]any, path string) bool {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		value, ok := values[name]
+		if !ok || value == nil || value == "" {
+			return false
+		}
+		if i == len(names)-1 {
+			return true
+		}
+		if values, ok = value.(map[string]any); !ok {
+			return false
+		}
+	}
+	return false
+}
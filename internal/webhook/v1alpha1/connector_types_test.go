@@ -0,0 +1,194 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fivetran/go-fivetran/metadata"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+// fakeMetadata serves the metadata of the postgres connector type
+type fakeMetadata struct {
+	calls int
+	err   error
+}
+
+func (*fakeMetadata) ListConnectorTypes(ctx context.Context) ([]metadata.ConnectorMetadata, error) {
+	return nil, nil
+}
+
+func (m *fakeMetadata) GetConnectorType(ctx context.Context, service string) (metadata.ConnectorMetadataResponse, error) {
+	m.calls++
+	var resp metadata.ConnectorMetadataResponse
+	if m.err != nil {
+		return resp, m.err
+	}
+	if service != "postgres" {
+		return resp, &fivetran.APIError{StatusCode: http.StatusNotFound, Code: "NotFound_Service"}
+	}
+	resp.Data.ID = service
+	resp.Data.Config = metadata.Property{
+		Required: []string{"host", "user", "password", "tunnel"},
+		Properties: map[string]*metadata.Property{
+			"tunnel": {Type: "object", Required: []string{"host", "port"}},
+		},
+	}
+	resp.Data.Auth = metadata.Property{Required: []string{"client_id"}}
+	return resp, nil
+}
+
+func TestValidateMetadata(t *testing.T) {
+	const validConfig = `{"schema_prefix":"users","host":"db","user":"fivetran","password":"vault:secret/data/db#password","tunnel":{"host":"bastion","port":22}}`
+
+	tests := []struct {
+		name      string
+		connector func() *operatorv1alpha1.FivetranConnector
+		errFields []string
+	}{
+		{
+			name: "valid with a secret reference",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				return newConnector("connectors", "users", "group_a", validConfig)
+			},
+		},
+		{
+			name: "unknown service",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				connector := newConnector("connectors", "users", "group_a", validConfig)
+				connector.Spec.Connector.Service = "postgress"
+				return connector
+			},
+			errFields: []string{"spec.connector.service"},
+		},
+		{
+			name: "missing required fields",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				return newConnector("connectors", "users", "group_a", `{"schema_prefix":"users","host":"","user":"fivetran","tunnel":{"host":"bastion"}}`)
+			},
+			errFields: []string{"spec.connector.config.host", "spec.connector.config.password", "spec.connector.config.tunnel.port"},
+		},
+		{
+			name: "missing required object",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				return newConnector("connectors", "users", "group_a", `{"schema_prefix":"users","host":"db","user":"fivetran","password":"secret"}`)
+			},
+			errFields: []string{"spec.connector.config.tunnel"},
+		},
+		{
+			name: "missing required auth field",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				connector := newConnector("connectors", "users", "group_a", validConfig)
+				connector.Spec.Connector.Auth = &runtime.RawExtension{Raw: []byte(`{"client_secret":"vault:secret/data/app#secret"}`)}
+				return connector
+			},
+			errFields: []string{"spec.connector.auth.client_id"},
+		},
+		{
+			name: "auth completed with a connect card",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				connector := newConnector("connectors", "users", "group_a", validConfig)
+				connector.Spec.Connector.Auth = &runtime.RawExtension{Raw: []byte(`{}`)}
+				connector.Spec.ConnectCard = &operatorv1alpha1.ConnectCard{}
+				return connector
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := newValidator(t)
+			validator.connectorTypes = newConnectorTypes(&fakeMetadata{})
+
+			_, err := validator.ValidateCreate(context.Background(), tt.connector())
+			if len(tt.errFields) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if !apierrors.IsInvalid(err) {
+				t.Fatalf("expected an Invalid error, got %v", err)
+			}
+			causes := err.(*apierrors.StatusError).ErrStatus.Details.Causes
+			if len(causes) != len(tt.errFields) {
+				t.Fatalf("expected %d causes, got %v", len(tt.errFields), causes)
+			}
+			for _, expected := range tt.errFields {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error for %s, got %v", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateMetadataUnavailable(t *testing.T) {
+	validator := newValidator(t)
+	validator.connectorTypes = newConnectorTypes(&fakeMetadata{err: errors.New("connection refused")})
+
+	warnings, err := validator.ValidateCreate(context.Background(), newConnector("connectors", "users", "group_a", `{}`))
+	if err != nil {
+		t.Fatalf("expected the connector to be admitted, got %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "connection refused") {
+		t.Errorf("expected a warning about the unavailable metadata, got %v", warnings)
+	}
+}
+
+func TestValidateMetadataOnUpdate(t *testing.T) {
+	metadataService := &fakeMetadata{}
+	validator := newValidator(t)
+	validator.connectorTypes = newConnectorTypes(metadataService)
+
+	// A connector created before the service required its fields can still be paused
+	existing := newConnector("connectors", "users", "group_a", `{"schema_prefix":"users"}`)
+	paused := existing.DeepCopy()
+	pausedTrue := true
+	paused.Spec.Connector.Paused = &pausedTrue
+	if _, err := validator.ValidateUpdate(context.Background(), existing, paused); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if metadataService.calls != 0 {
+		t.Errorf("expected no metadata lookup, got %d", metadataService.calls)
+	}
+
+	changed := existing.DeepCopy()
+	changed.Spec.Connector.Config = &runtime.RawExtension{Raw: []byte(`{"schema_prefix":"users","host":"db"}`)}
+	if _, err := validator.ValidateUpdate(context.Background(), existing, changed); !apierrors.IsInvalid(err) {
+		t.Errorf("expected an Invalid error for a changed config, got %v", err)
+	}
+}
+
+func TestConnectorTypesCache(t *testing.T) {
+	metadataService := &fakeMetadata{}
+	types := newConnectorTypes(metadataService)
+	now := time.Now()
+	types.now = func() time.Time { return now }
+
+	for _, service := range []string{"postgres", "postgres", "unknown", "unknown"} {
+		if _, err := types.get(context.Background(), service); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if metadataService.calls != 2 {
+		t.Errorf("expected known and unknown services to be cached, got %d calls", metadataService.calls)
+	}
+
+	now = now.Add(connectorTypeTTL)
+	if _, err := types.get(context.Background(), "postgres"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if metadataService.calls != 3 {
+		t.Errorf("expected an expired entry to be refreshed, got %d calls", metadataService.calls)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+const (
+	// annotationAllowDestructiveChanges set to "true" admits updates that stop syncing schemas,
+	// tables or columns, or move tables to a sync mode that keeps less data
+	annotationAllowDestructiveChanges = "operator.dataverse.redhat.com/allow-destructive-changes"
+
+	syncModeHistory    = "HISTORY"
+	syncModeSoftDelete = "SOFT_DELETE"
+	syncModeLive       = "LIVE"
+)
+
+// syncModeRetention ranks sync modes by the data they keep: LIVE drops deleted rows, SOFT_DELETE
+// keeps them marked as deleted, and HISTORY keeps every version of a row
+var syncModeRetention = map[string]int{syncModeLive: 0, syncModeSoftDelete: 1, syncModeHistory: 2}
+
+// validateSchemaChanges returns an error for each change of an update that disables a schema,
+// table or column enabled before, or moves a table to a sync mode that keeps less data. Schemas,
+// tables and columns removed from the spec are left as they are in Fivetran, so removing them is
+// not destructive.
+func validateSchemaChanges(oldSchemas, schemas *operatorv1alpha1.ConnectorSchemaConfig, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if oldSchemas == nil || schemas == nil {
+		return allErrs
+	}
+
+	for _, schemaName := range slices.Sorted(maps.Keys(schemas.Schemas)) {
+		schemaObj, oldSchema := schemas.Schemas[schemaName], oldSchemas.Schemas[schemaName]
+		if schemaObj == nil || oldSchema == nil {
+			continue
+		}
+		schemaPath := path.Child("schemas").Key(schemaName)
+		if oldSchema.Enabled && !schemaObj.Enabled {
+			allErrs = append(allErrs, destructiveChange(schemaPath.Child("enabled"), "disables a synced schema"))
+			continue
+		}
+
+		for _, tableName := range slices.Sorted(maps.Keys(schemaObj.Tables)) {
+			table, oldTable := schemaObj.Tables[tableName], oldSchema.Tables[tableName]
+			if table == nil || oldTable == nil {
+				continue
+			}
+			tablePath := schemaPath.Child("tables").Key(tableName)
+			if oldTable.Enabled && !table.Enabled {
+				allErrs = append(allErrs, destructiveChange(tablePath.Child("enabled"), "disables a synced table"))
+				continue
+			}
+			if table.SyncMode != "" && oldTable.SyncMode != "" &&
+				syncModeRetention[table.SyncMode] < syncModeRetention[oldTable.SyncMode] {
+				allErrs = append(allErrs, destructiveChange(tablePath.Child("sync_mode"),
+					fmt.Sprintf("changes the sync mode from %s to %s, which keeps less data", oldTable.SyncMode, table.SyncMode)))
+			}
+
+			for _, columnName := range slices.Sorted(maps.Keys(table.Columns)) {
+				column, oldColumn := table.Columns[columnName], oldTable.Columns[columnName]
+				if column == nil || oldColumn == nil {
+					continue
+				}
+				if oldColumn.Enabled && !column.Enabled {
+					allErrs = append(allErrs, destructiveChange(tablePath.Child("columns").Key(columnName).Child("enabled"),
+						"disables a synced column"))
+				}
+			}
+		}
+	}
+	return allErrs
+}
+
+// destructiveChange returns the error for a destructive change, naming the annotation that admits it
+func destructiveChange(path *field.Path, detail string) *field.Error {
+	return field.Forbidden(path, fmt.Sprintf("%s; set the %s annotation to \"true\" to allow it",
+		detail, annotationAllowDestructiveChanges))
+}
@@ -0,0 +1,110 @@
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+func TestValidateSchemaChanges(t *testing.T) {
+	existing := newConnector("connectors", "users", "group_a", `{"schema_prefix":"users"}`)
+	existing.Spec.ConnectorSchemas = &operatorv1alpha1.ConnectorSchemaConfig{
+		Schemas: map[string]*operatorv1alpha1.SchemaObject{
+			"public": {Enabled: true, Tables: map[string]*operatorv1alpha1.TableObject{
+				"users": {Enabled: true, SyncMode: syncModeHistory, Columns: map[string]*operatorv1alpha1.ColumnObject{
+					"email": {Enabled: true},
+					"phone": {Enabled: false},
+				}},
+				"orders": {Enabled: true, SyncMode: syncModeSoftDelete},
+				"events": {Enabled: true, SyncMode: syncModeLive},
+			}},
+			"archive": {Enabled: true},
+		},
+	}
+	validator := newValidator(t, existing)
+
+	tests := []struct {
+		name      string
+		change    func(schemas *operatorv1alpha1.ConnectorSchemaConfig)
+		errFields []string
+	}{
+		{
+			name: "enabling and removing",
+			change: func(schemas *operatorv1alpha1.ConnectorSchemaConfig) {
+				schemas.Schemas["public"].Tables["users"].Columns["phone"].Enabled = true
+				schemas.Schemas["public"].Tables["events"].SyncMode = syncModeHistory
+				delete(schemas.Schemas["public"].Tables, "orders")
+				delete(schemas.Schemas, "archive")
+			},
+		},
+		{
+			name: "disabling",
+			change: func(schemas *operatorv1alpha1.ConnectorSchemaConfig) {
+				schemas.Schemas["archive"].Enabled = false
+				schemas.Schemas["public"].Tables["orders"].Enabled = false
+				schemas.Schemas["public"].Tables["users"].Columns["email"].Enabled = false
+			},
+			errFields: []string{
+				"spec.connectorSchemas.schemas[archive].enabled",
+				"spec.connectorSchemas.schemas[public].tables[orders].enabled",
+				"spec.connectorSchemas.schemas[public].tables[users].columns[email].enabled",
+			},
+		},
+		{
+			name: "sync modes keeping less data",
+			change: func(schemas *operatorv1alpha1.ConnectorSchemaConfig) {
+				schemas.Schemas["public"].Tables["users"].SyncMode = syncModeSoftDelete
+				schemas.Schemas["public"].Tables["orders"].SyncMode = syncModeLive
+			},
+			errFields: []string{
+				"spec.connectorSchemas.schemas[public].tables[users].sync_mode",
+				"spec.connectorSchemas.schemas[public].tables[orders].sync_mode",
+			},
+		},
+		{
+			name: "disabling a schema with its tables",
+			change: func(schemas *operatorv1alpha1.ConnectorSchemaConfig) {
+				schemas.Schemas["public"].Enabled = false
+				schemas.Schemas["public"].Tables["users"].Enabled = false
+			},
+			errFields: []string{"spec.connectorSchemas.schemas[public].enabled"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := existing.DeepCopy()
+			tt.change(updated.Spec.ConnectorSchemas)
+			_, err := validator.ValidateUpdate(context.Background(), existing, updated)
+			if len(tt.errFields) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if !apierrors.IsInvalid(err) {
+				t.Fatalf("expected an Invalid error, got %v", err)
+			}
+			causes := err.(*apierrors.StatusError).ErrStatus.Details.Causes
+			if len(causes) != len(tt.errFields) {
+				t.Fatalf("expected %d causes, got %v", len(tt.errFields), causes)
+			}
+			for _, expected := range tt.errFields {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error for %s, got %v", expected, err)
+				}
+			}
+
+			// The annotation admits the change
+			updated.Annotations = map[string]string{annotationAllowDestructiveChanges: "true"}
+			if _, err := validator.ValidateUpdate(context.Background(), existing, updated); err != nil {
+				t.Errorf("expected the annotated change to be admitted, got %v", err)
+			}
+		})
+	}
+}
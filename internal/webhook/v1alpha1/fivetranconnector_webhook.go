@@ -0,0 +1,341 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+// fivetranconnectorlog is for logging in this package.
+var fivetranconnectorlog = logf.Log.WithName("fivetranconnector-resource")
+
+const (
+	// destinationIndexKey indexes FivetranConnectors by the group and schema they land data in
+	destinationIndexKey = "spec.connector.destination"
+
+	dataDelaySensitivityCustom = "CUSTOM"
+	maskingAlgorithmHashed     = "HASHED"
+)
+
+// SetupFivetranConnectorWebhookWithManager registers the webhook for FivetranConnector in the manager.
+// A nil metadataService skips the checks of services and their required fields. defaults are the
+// operator's defaults for fields left unset by both a connector and the defaults of its group.
+// vaultReads configures the reads of referenced Vault secrets, and plaintextSecrets the check for
+// secrets that are not referenced from a secret store.
+func SetupFivetranConnectorWebhookWithManager(mgr ctrl.Manager, metadataService fivetran.MetadataService, defaults operatorv1alpha1.ConnectorDefaults,
+	vaultReads VaultReads, plaintextSecrets PlaintextSecrets) error {
+	if err := validateDefaults(defaults); err != nil {
+		return err
+	}
+	if err := validatePlaintextSecretsPolicy(plaintextSecrets.Policy); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &operatorv1alpha1.FivetranConnector{},
+		destinationIndexKey, destinationIndex); err != nil {
+		return fmt.Errorf("failed to index FivetranConnectors by destination: %w", err)
+	}
+
+	validator := &FivetranConnectorCustomValidator{
+		Client:           mgr.GetClient(),
+		vaultReads:       vaultReads,
+		plaintextSecrets: plaintextSecrets,
+	}
+	if metadataService != nil {
+		validator.connectorTypes = newConnectorTypes(metadataService)
+	}
+	return ctrl.NewWebhookManagedBy(mgr).For(&operatorv1alpha1.FivetranConnector{}).
+		WithValidator(validator).
+		WithDefaulter(&FivetranConnectorCustomDefaulter{Client: mgr.GetClient(), Defaults: defaults}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-operator-dataverse-redhat-com-v1alpha1-fivetranconnector,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.dataverse.redhat.com,resources=fivetranconnectors,verbs=create;update,versions=v1alpha1,name=vfivetranconnector-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// FivetranConnectorCustomValidator validates the cross-field rules of FivetranConnectors that the
+// CRD schema cannot express, rejects moving connectors to another group or service without the
+// migrate annotation, rejects connectors landing in the destination schema of another,
+// checks services and their required config and auth fields against Fivetran's metadata, and
+// checks the vault references of the config and auth and that it holds no plaintext secrets.
+type FivetranConnectorCustomValidator struct {
+	// Client reads the FivetranConnectors indexed by destination
+	Client client.Reader
+
+	// connectorTypes looks up the metadata of services; nil skips the metadata checks
+	connectorTypes *connectorTypes
+	// vaultReads reads the referenced Vault secrets; a nil Client only checks the references' syntax
+	vaultReads VaultReads
+	// plaintextSecrets warns about or rejects secrets that are not referenced from a secret store
+	plaintextSecrets PlaintextSecrets
+}
+
+var _ webhook.CustomValidator = &FivetranConnectorCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type FivetranConnector.
+func (v *FivetranConnectorCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	connector, ok := obj.(*operatorv1alpha1.FivetranConnector)
+	if !ok {
+		return nil, fmt.Errorf("expected a FivetranConnector object but got %T", obj)
+	}
+	fivetranconnectorlog.Info("Validation for FivetranConnector upon creation", "name", connector.GetName())
+
+	return v.validate(ctx, nil, connector)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type FivetranConnector.
+func (v *FivetranConnectorCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldConnector, ok := oldObj.(*operatorv1alpha1.FivetranConnector)
+	if !ok {
+		return nil, fmt.Errorf("expected a FivetranConnector object for the oldObj but got %T", oldObj)
+	}
+	connector, ok := newObj.(*operatorv1alpha1.FivetranConnector)
+	if !ok {
+		return nil, fmt.Errorf("expected a FivetranConnector object for the newObj but got %T", newObj)
+	}
+	fivetranconnectorlog.Info("Validation for FivetranConnector upon update", "name", connector.GetName())
+
+	// Let a connector being deleted drop its finalizer whatever its spec
+	if !connector.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	return v.validate(ctx, oldConnector, connector)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type FivetranConnector.
+func (v *FivetranConnectorCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate returns an Invalid error listing every rule the connector breaks. oldConnector is nil on
+// creation. Its vault references and plaintext secrets, and its service and required fields against
+// Fivetran's metadata, are only checked when the config or auth change, so fields a connector type
+// starts requiring and secrets removed from Vault do not block unrelated changes, such as pausing.
+func (v *FivetranConnectorCustomValidator) validate(ctx context.Context, oldConnector, connector *operatorv1alpha1.FivetranConnector) (admission.Warnings, error) {
+	checkCredentials := oldConnector == nil || credentialsChanged(oldConnector, connector)
+
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+	allErrs = append(allErrs, validateConnector(&connector.Spec.Connector, field.NewPath("spec", "connector"))...)
+	allErrs = append(allErrs, validateSchemas(connector.Spec.ConnectorSchemas, field.NewPath("spec", "connectorSchemas"))...)
+	if oldConnector != nil && connector.Annotations[annotationMigrateConnector] != "true" &&
+		connector.Annotations[annotationReplaceConnector] != "true" {
+		allErrs = append(allErrs, validateImmutableFields(&oldConnector.Spec.Connector, &connector.Spec.Connector,
+			field.NewPath("spec", "connector"))...)
+	}
+	if oldConnector != nil && connector.Annotations[annotationAllowDestructiveChanges] != "true" {
+		allErrs = append(allErrs, validateSchemaChanges(oldConnector.Spec.ConnectorSchemas, connector.Spec.ConnectorSchemas,
+			field.NewPath("spec", "connectorSchemas"))...)
+	}
+	var referenceErrs field.ErrorList
+	if checkCredentials {
+		referenceErrs = validateVaultReferences(connector)
+		allErrs = append(allErrs, referenceErrs...)
+
+		plaintextErrs, plaintextWarnings := v.plaintextSecrets.checkPlaintextSecrets(connector)
+		allErrs = append(allErrs, plaintextErrs...)
+		warnings = append(warnings, plaintextWarnings...)
+	}
+
+	destinationErr, err := v.validateDestination(ctx, connector)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	if destinationErr != nil {
+		allErrs = append(allErrs, destinationErr)
+	}
+
+	if checkCredentials && v.connectorTypes != nil {
+		metadataErrs, err := v.validateMetadata(ctx, connector)
+		if err != nil {
+			// Connectors stay editable while Fivetran is unavailable; setup tests catch what is missed
+			warnings = append(warnings, fmt.Sprintf("config was not checked against Fivetran metadata: %v", err))
+		}
+		allErrs = append(allErrs, metadataErrs...)
+	}
+	if checkCredentials && len(referenceErrs) == 0 && v.vaultReads.Client != nil {
+		vaultErrs, err := v.checkVaultReferences(ctx, connector)
+		if err != nil {
+			// Connectors stay editable while Vault is unavailable; the reconcile reports what is missed
+			warnings = append(warnings, fmt.Sprintf("vault references were not read: %v", err))
+		}
+		allErrs = append(allErrs, vaultErrs...)
+	}
+
+	if len(allErrs) == 0 {
+		return warnings, nil
+	}
+	return warnings, apierrors.NewInvalid(schema.GroupKind{Group: operatorv1alpha1.GroupVersion.Group, Kind: "FivetranConnector"},
+		connector.Name, allErrs)
+}
+
+// validateMetadata checks that Fivetran knows the connector's service and that the config, and the
+// auth unless a Connect Card completes it, set the fields the service requires
+func (v *FivetranConnectorCustomValidator) validateMetadata(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) (field.ErrorList, error) {
+	path := field.NewPath("spec", "connector")
+	service := connector.Spec.Connector.Service
+	connectorType, err := v.connectorTypes.get(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	if connectorType == nil {
+		return field.ErrorList{field.Invalid(path.Child("service"), service, "is not a connector type known to Fivetran")}, nil
+	}
+
+	allErrs := validateRequiredFields(connectorType.Config, connectorConfig(connector), path.Child("config"))
+	if connector.Spec.Connector.Auth != nil && connector.Spec.ConnectCard == nil {
+		allErrs = append(allErrs, validateRequiredFields(connectorType.Auth, connectorAuth(connector), path.Child("auth"))...)
+	}
+	return allErrs, nil
+}
+
+// credentialsChanged reports whether an update changes the config or auth of a connector
+func credentialsChanged(oldConnector, connector *operatorv1alpha1.FivetranConnector) bool {
+	return !bytes.Equal(rawExtension(oldConnector.Spec.Connector.Config), rawExtension(connector.Spec.Connector.Config)) ||
+		!bytes.Equal(rawExtension(oldConnector.Spec.Connector.Auth), rawExtension(connector.Spec.Connector.Auth))
+}
+
+// validateConnector checks that a CUSTOM data delay sensitivity comes with its threshold
+func validateConnector(connector *operatorv1alpha1.Connector, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if connector.DataDelaySensitivity == dataDelaySensitivityCustom && connector.DataDelayThreshold <= 0 {
+		allErrs = append(allErrs, field.Required(path.Child("data_delay_threshold"),
+			"a positive threshold is required when data_delay_sensitivity is CUSTOM"))
+	}
+	return allErrs
+}
+
+// validateSchemas checks that declared table and column maps are not empty, and that the hashed
+// flag and masking algorithm of each column agree
+func validateSchemas(schemas *operatorv1alpha1.ConnectorSchemaConfig, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if schemas == nil {
+		return allErrs
+	}
+
+	for schemaName, schemaObj := range schemas.Schemas {
+		if schemaObj == nil {
+			continue
+		}
+		tablesPath := path.Child("schemas").Key(schemaName).Child("tables")
+		if schemaObj.Tables != nil && len(schemaObj.Tables) == 0 {
+			allErrs = append(allErrs, field.Invalid(tablesPath, schemaObj.Tables,
+				"must list at least one table when declared"))
+		}
+
+		for tableName, table := range schemaObj.Tables {
+			if table == nil {
+				continue
+			}
+			columnsPath := tablesPath.Key(tableName).Child("columns")
+			if table.Columns != nil && len(table.Columns) == 0 {
+				allErrs = append(allErrs, field.Invalid(columnsPath, table.Columns,
+					"must list at least one column when declared"))
+			}
+
+			for columnName, column := range table.Columns {
+				if column == nil || column.MaskingAlgorithm == "" {
+					continue
+				}
+				if column.Hashed != (column.MaskingAlgorithm == maskingAlgorithmHashed) {
+					allErrs = append(allErrs, field.Invalid(columnsPath.Key(columnName).Child("masking_algorithm"),
+						column.MaskingAlgorithm, fmt.Sprintf("conflicts with hashed: %t", column.Hashed)))
+				}
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateDestination returns an error when another FivetranConnector lands data in the same
+// group and destination schema
+func (v *FivetranConnectorCustomValidator) validateDestination(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) (*field.Error, error) {
+	keys := destinationIndex(connector)
+	if len(keys) == 0 || v.Client == nil {
+		return nil, nil
+	}
+
+	connectors := &operatorv1alpha1.FivetranConnectorList{}
+	if err := v.Client.List(ctx, connectors, client.MatchingFields{destinationIndexKey: keys[0]}); err != nil {
+		return nil, fmt.Errorf("failed to list FivetranConnectors by destination: %w", err)
+	}
+	for _, other := range connectors.Items {
+		if other.Namespace == connector.Namespace && other.Name == connector.Name {
+			continue
+		}
+		return field.Duplicate(field.NewPath("spec", "connector", "config"),
+			fmt.Sprintf("group %s, schema %s is used by FivetranConnector %s/%s",
+				connector.Spec.Connector.GroupID, fivetran.DestinationSchema(connectorConfig(connector)),
+				other.Namespace, other.Name)), nil
+	}
+	return nil, nil
+}
+
+// destinationIndex returns the group and destination schema key of a FivetranConnector, or none
+// when its config names no schema
+func destinationIndex(obj client.Object) []string {
+	connector, ok := obj.(*operatorv1alpha1.FivetranConnector)
+	if !ok {
+		return nil
+	}
+	destinationSchema := fivetran.DestinationSchema(connectorConfig(connector))
+	if destinationSchema == "" {
+		return nil
+	}
+	return []string{connector.Spec.Connector.GroupID + "/" + destinationSchema}
+}
+
+// connectorConfig decodes the config of a FivetranConnector, returning nil when it is not an object
+func connectorConfig(connector *operatorv1alpha1.FivetranConnector) map[string]any {
+	return decodeObject(connector.Spec.Connector.Config)
+}
+
+// connectorAuth decodes the auth of a FivetranConnector, returning nil when it is not an object
+func connectorAuth(connector *operatorv1alpha1.FivetranConnector) map[string]any {
+	return decodeObject(connector.Spec.Connector.Auth)
+}
+
+// decodeObject decodes a JSON object, returning nil when it is unset or not an object
+func decodeObject(raw *runtime.RawExtension) map[string]any {
+	var object map[string]any
+	if err := json.Unmarshal(rawExtension(raw), &object); err != nil {
+		return nil
+	}
+	return object
+}
+
+// rawExtension returns the JSON of an optional raw extension
+func rawExtension(raw *runtime.RawExtension) []byte {
+	if raw == nil {
+		return nil
+	}
+	return raw.Raw
+}
@@ -0,0 +1,178 @@
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+func newConnector(namespace, name, groupID, config string) *operatorv1alpha1.FivetranConnector {
+	return &operatorv1alpha1.FivetranConnector{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: operatorv1alpha1.FivetranConnectorSpec{
+			Connector: operatorv1alpha1.Connector{
+				GroupID: groupID,
+				Service: "postgres",
+				Config:  &runtime.RawExtension{Raw: []byte(config)},
+			},
+		},
+	}
+}
+
+func newValidator(t *testing.T, connectors ...*operatorv1alpha1.FivetranConnector) *FivetranConnectorCustomValidator {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := operatorv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	builder := fake.NewClientBuilder().WithScheme(scheme).
+		WithIndex(&operatorv1alpha1.FivetranConnector{}, destinationIndexKey, destinationIndex)
+	for _, connector := range connectors {
+		builder = builder.WithObjects(connector)
+	}
+	return &FivetranConnectorCustomValidator{Client: builder.Build()}
+}
+
+func TestValidateCreate(t *testing.T) {
+	existing := newConnector("connectors", "orders", "group_a", `{"schema_prefix":"orders"}`)
+	validator := newValidator(t, existing)
+
+	tests := []struct {
+		name      string
+		connector func() *operatorv1alpha1.FivetranConnector
+		errFields []string
+	}{
+		{
+			name: "valid",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				return newConnector("connectors", "users", "group_a", `{"schema_prefix":"users"}`)
+			},
+		},
+		{
+			name: "custom sensitivity without threshold",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				connector := newConnector("connectors", "users", "group_a", `{"schema_prefix":"users"}`)
+				connector.Spec.Connector.DataDelaySensitivity = dataDelaySensitivityCustom
+				return connector
+			},
+			errFields: []string{"spec.connector.data_delay_threshold"},
+		},
+		{
+			name: "custom sensitivity with threshold",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				connector := newConnector("connectors", "users", "group_a", `{"schema_prefix":"users"}`)
+				connector.Spec.Connector.DataDelaySensitivity = dataDelaySensitivityCustom
+				connector.Spec.Connector.DataDelayThreshold = 60
+				return connector
+			},
+		},
+		{
+			name: "empty table and column maps",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				connector := newConnector("connectors", "users", "group_a", `{"schema_prefix":"users"}`)
+				connector.Spec.ConnectorSchemas = &operatorv1alpha1.ConnectorSchemaConfig{
+					Schemas: map[string]*operatorv1alpha1.SchemaObject{
+						"public": {Enabled: true, Tables: map[string]*operatorv1alpha1.TableObject{
+							"users": {Enabled: true, Columns: map[string]*operatorv1alpha1.ColumnObject{}},
+						}},
+						"archive": {Enabled: true, Tables: map[string]*operatorv1alpha1.TableObject{}},
+					},
+				}
+				return connector
+			},
+			errFields: []string{
+				"spec.connectorSchemas.schemas[archive].tables",
+				"spec.connectorSchemas.schemas[public].tables[users].columns",
+			},
+		},
+		{
+			name: "masking conflicts with hashing",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				connector := newConnector("connectors", "users", "group_a", `{"schema_prefix":"users"}`)
+				connector.Spec.ConnectorSchemas = &operatorv1alpha1.ConnectorSchemaConfig{
+					Schemas: map[string]*operatorv1alpha1.SchemaObject{
+						"public": {Enabled: true, Tables: map[string]*operatorv1alpha1.TableObject{
+							"users": {Enabled: true, Columns: map[string]*operatorv1alpha1.ColumnObject{
+								"email": {Enabled: true, Hashed: true, MaskingAlgorithm: "ENCRYPTED"},
+								"phone": {Enabled: true, Hashed: false, MaskingAlgorithm: maskingAlgorithmHashed},
+								"ssn":   {Enabled: true, Hashed: true, MaskingAlgorithm: maskingAlgorithmHashed},
+								"name":  {Enabled: true, Hashed: true},
+							}},
+						}},
+					},
+				}
+				return connector
+			},
+			errFields: []string{
+				"spec.connectorSchemas.schemas[public].tables[users].columns[email].masking_algorithm",
+				"spec.connectorSchemas.schemas[public].tables[users].columns[phone].masking_algorithm",
+			},
+		},
+		{
+			name: "duplicate destination",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				return newConnector("other", "orders-copy", "group_a", `{"schema_prefix":"orders"}`)
+			},
+			errFields: []string{"spec.connector.config"},
+		},
+		{
+			name: "same schema in another group",
+			connector: func() *operatorv1alpha1.FivetranConnector {
+				return newConnector("connectors", "orders-b", "group_b", `{"schema_prefix":"orders"}`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validator.ValidateCreate(context.Background(), tt.connector())
+			if len(tt.errFields) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if !apierrors.IsInvalid(err) {
+				t.Fatalf("expected an Invalid error, got %v", err)
+			}
+			causes := err.(*apierrors.StatusError).ErrStatus.Details.Causes
+			if len(causes) != len(tt.errFields) {
+				t.Fatalf("expected %d causes, got %v", len(tt.errFields), causes)
+			}
+			for _, expected := range tt.errFields {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error for %s, got %v", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	existing := newConnector("connectors", "orders", "group_a", `{"schema_prefix":"orders"}`)
+	validator := newValidator(t, existing)
+
+	// A connector keeps its own destination
+	updated := existing.DeepCopy()
+	updated.Spec.Connector.SyncFrequency = 60
+	if _, err := validator.ValidateUpdate(context.Background(), existing, updated); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// A connector being deleted is not validated
+	deleting := updated.DeepCopy()
+	deleting.Spec.Connector.DataDelaySensitivity = dataDelaySensitivityCustom
+	now := metav1.Now()
+	deleting.DeletionTimestamp = &now
+	if _, err := validator.ValidateUpdate(context.Background(), existing, deleting); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+)
+
+const (
+	// PlaintextSecretsAllow admits connectors with plaintext secrets
+	PlaintextSecretsAllow = "allow"
+	// PlaintextSecretsWarn admits connectors with plaintext secrets with a warning for each
+	PlaintextSecretsWarn = "warn"
+	// PlaintextSecretsReject rejects connectors with plaintext secrets
+	PlaintextSecretsReject = "reject"
+)
+
+var plaintextSecretsPolicies = []string{PlaintextSecretsAllow, PlaintextSecretsWarn, PlaintextSecretsReject}
+
+// secretFieldNames are the parts of config and auth field names, lowercased without separators,
+// that mark a field holding a secret
+var secretFieldNames = []string{"password", "passwd", "passphrase", "secret", "token", "apikey", "privatekey", "accesskey"}
+
+// nonSecretFieldSuffixes end the names of fields that describe a secret rather than hold it, such
+// as token_url or secret_name
+var nonSecretFieldSuffixes = []string{"url", "uri", "type", "method", "name", "path"}
+
+// PlaintextSecrets configures the check for secrets written into the config and auth of
+// connectors instead of being referenced from a secret store
+type PlaintextSecrets struct {
+	// Policy is PlaintextSecretsAllow, PlaintextSecretsWarn or PlaintextSecretsReject
+	Policy string
+	// Registry recognizes the references of secret stores besides Vault; nil recognizes Vault only
+	Registry *vault.Registry
+}
+
+// validatePlaintextSecretsPolicy checks the policy, so an invalid one fails at startup rather than
+// every admission
+func validatePlaintextSecretsPolicy(policy string) error {
+	if !slices.Contains(plaintextSecretsPolicies, policy) {
+		return fmt.Errorf("invalid plaintext secrets policy %q, must be one of %v", policy, plaintextSecretsPolicies)
+	}
+	return nil
+}
+
+// checkPlaintextSecrets returns the errors or warnings, depending on the policy, for the secret
+// fields of a connector's config and auth that hold a value instead of a secret reference
+func (p PlaintextSecrets) checkPlaintextSecrets(connector *operatorv1alpha1.FivetranConnector) (field.ErrorList, admission.Warnings) {
+	if p.Policy == "" || p.Policy == PlaintextSecretsAllow {
+		return nil, nil
+	}
+
+	var paths []*field.Path
+	for _, credentials := range connectorCredentials(connector) {
+		paths = p.collectPlaintextSecrets(decodeObject(credentials.raw), credentials.path, false, paths)
+	}
+
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+	for _, path := range paths {
+		// The value is never echoed, so the secret does not end up in events and logs
+		if p.Policy == PlaintextSecretsReject {
+			allErrs = append(allErrs, field.Forbidden(path,
+				"looks like a plaintext secret; reference it from a secret store, such as vault:path#key"))
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("%s looks like a plaintext secret; reference it from a secret store, such as vault:path#key", path))
+	}
+	return allErrs, warnings
+}
+
+// collectPlaintextSecrets walks data in key order and appends the paths of the strings under
+// secret fields that are not secret references
+func (p PlaintextSecrets) collectPlaintextSecrets(data any, path *field.Path, secretField bool, paths []*field.Path) []*field.Path {
+	switch v := data.(type) {
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			if key == vault.SecretRefKey {
+				continue
+			}
+			paths = p.collectPlaintextSecrets(v[key], path.Child(key), secretField || isSecretFieldName(key), paths)
+		}
+	case []any:
+		for i, item := range v {
+			paths = p.collectPlaintextSecrets(item, path.Index(i), secretField, paths)
+		}
+	case string:
+		if secretField && v != "" && !p.Registry.IsSecretReference(v) {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// isSecretFieldName reports whether a config or auth field name marks a field holding a secret
+func isSecretFieldName(name string) bool {
+	name = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+	for _, suffix := range nonSecretFieldSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	for _, part := range secretFieldNames {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+)
+
+func TestPlaintextSecrets(t *testing.T) {
+	registry := vault.NewRegistry()
+	if err := registry.Register("secret", vault.SecretResolverFunc(func(context.Context, string) (any, error) {
+		return nil, nil
+	})); err != nil {
+		t.Fatalf("failed to register resolver: %v", err)
+	}
+
+	config := `{
+		"schema_prefix": "users",
+		"user": "app",
+		"password": "hunter2",
+		"api_key": "vault:db#api_key",
+		"client_secret": "secret:connectors/oauth#client_secret",
+		"token_url": "https://example.com/token",
+		"private-key": "${ENV:PRIVATE_KEY}",
+		"tunnel": {"passphrase": "{{vault \"ssh\" \"passphrase\"}}", "access_tokens": ["abc", ""]},
+		"secret_count": 3
+	}`
+	newPlaintextConnector := func() *operatorv1alpha1.FivetranConnector {
+		connector := newConnector("connectors", "users", "group_a", config)
+		connector.Spec.Connector.Auth = &runtime.RawExtension{Raw: []byte(`{"client_access":{"client_secret":"s3cr3t"}}`)}
+		return connector
+	}
+	expected := []string{
+		"spec.connector.config.password",
+		"spec.connector.config.tunnel.access_tokens[0]",
+		"spec.connector.auth.client_access.client_secret",
+	}
+
+	t.Run("reject", func(t *testing.T) {
+		validator := newValidator(t)
+		validator.plaintextSecrets = PlaintextSecrets{Policy: PlaintextSecretsReject, Registry: registry}
+		_, err := validator.ValidateCreate(context.Background(), newPlaintextConnector())
+		if !apierrors.IsInvalid(err) {
+			t.Fatalf("expected an Invalid error, got %v", err)
+		}
+		causes := err.(*apierrors.StatusError).ErrStatus.Details.Causes
+		if len(causes) != len(expected) {
+			t.Fatalf("expected %d causes, got %v", len(expected), causes)
+		}
+		for _, path := range expected {
+			if !strings.Contains(err.Error(), path) {
+				t.Errorf("expected error for %s, got %v", path, err)
+			}
+		}
+		for _, secret := range []string{"hunter2", "s3cr3t"} {
+			if strings.Contains(err.Error(), secret) {
+				t.Errorf("expected the error not to contain the secret, got %v", err)
+			}
+		}
+	})
+
+	t.Run("warn", func(t *testing.T) {
+		validator := newValidator(t)
+		validator.plaintextSecrets = PlaintextSecrets{Policy: PlaintextSecretsWarn, Registry: registry}
+		warnings, err := validator.ValidateCreate(context.Background(), newPlaintextConnector())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(warnings) != len(expected) {
+			t.Fatalf("expected %d warnings, got %v", len(expected), warnings)
+		}
+		for i, path := range expected {
+			if !strings.HasPrefix(warnings[i], path+" ") {
+				t.Errorf("expected a warning for %s, got %s", path, warnings[i])
+			}
+		}
+	})
+
+	t.Run("unchanged credentials", func(t *testing.T) {
+		validator := newValidator(t)
+		validator.plaintextSecrets = PlaintextSecrets{Policy: PlaintextSecretsReject, Registry: registry}
+		existing := newPlaintextConnector()
+		paused := existing.DeepCopy()
+		pausedTrue := true
+		paused.Spec.Connector.Paused = &pausedTrue
+		if _, err := validator.ValidateUpdate(context.Background(), existing, paused); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestIsSecretFieldName(t *testing.T) {
+	tests := map[string]bool{
+		"password":          true,
+		"Client_Secret":     true,
+		"api-key":           true,
+		"aws_access_key_id": true,
+		"refresh_token":     true,
+		"private_key":       true,
+		"public_key":        false,
+		"token_url":         false,
+		"auth_type":         false,
+		"secret_name":       false,
+		"user":              false,
+		"host":              false,
+	}
+	for name, expected := range tests {
+		if got := isSecretFieldName(name); got != expected {
+			t.Errorf("expected isSecretFieldName(%q) to be %t, got %t", name, expected, got)
+		}
+	}
+}
+
+func TestValidatePlaintextSecretsPolicy(t *testing.T) {
+	for _, policy := range []string{PlaintextSecretsAllow, PlaintextSecretsWarn, PlaintextSecretsReject} {
+		if err := validatePlaintextSecretsPolicy(policy); err != nil {
+			t.Errorf("unexpected error for %q: %v", policy, err)
+		}
+	}
+	if err := validatePlaintextSecretsPolicy("block"); err == nil {
+		t.Error("expected an error for an unknown policy")
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+// VaultReads configures the reads of the Vault secrets connectors reference, which check at
+// admission that the secrets and keys exist
+type VaultReads struct {
+	// Client returns the Vault client resolving a connector's secrets; nil skips the reads
+	Client func(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) (*vaultpkg.VaultClient, error)
+	// Timeout bounds the reads of one admission; zero leaves them to the admission deadline
+	Timeout time.Duration
+}
+
+// validateVaultReferences checks the syntax of the vault references in the config and auth of a
+// connector
+func validateVaultReferences(connector *operatorv1alpha1.FivetranConnector) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, credentials := range connectorCredentials(connector) {
+		// A config that is not a JSON object is left to the reconcile to report
+		errs, _ := vault.ValidateReferences(credentials.raw)
+		allErrs = append(allErrs, vaultReferenceErrors(errs, credentials.path)...)
+	}
+	return allErrs
+}
+
+// checkVaultReferences reads the Vault secrets the config and auth of a connector reference and
+// returns an error for each missing secret or key, or an error when Vault cannot be read
+func (v *FivetranConnectorCustomValidator) checkVaultReferences(ctx context.Context, connector *operatorv1alpha1.FivetranConnector) (field.ErrorList, error) {
+	if v.vaultReads.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, v.vaultReads.Timeout)
+		defer cancel()
+	}
+
+	vaultClient, err := v.vaultReads.Client(ctx, connector)
+	if err != nil {
+		return nil, err
+	}
+
+	var allErrs field.ErrorList
+	for _, credentials := range connectorCredentials(connector) {
+		errs, err := vault.CheckReferences(ctx, vaultClient, credentials.raw)
+		if err != nil {
+			return nil, err
+		}
+		allErrs = append(allErrs, vaultReferenceErrors(errs, credentials.path)...)
+	}
+	return allErrs, nil
+}
+
+// credentialsField is a config or auth field of a connector
+type credentialsField struct {
+	path *field.Path
+	raw  *runtime.RawExtension
+}
+
+// connectorCredentials returns the config and auth of a connector with their field paths
+func connectorCredentials(connector *operatorv1alpha1.FivetranConnector) []credentialsField {
+	path := field.NewPath("spec", "connector")
+	return []credentialsField{
+		{path: path.Child("config"), raw: connector.Spec.Connector.Config},
+		{path: path.Child("auth"), raw: connector.Spec.Connector.Auth},
+	}
+}
+
+// vaultReferenceErrors converts vault reference errors to field errors below path
+func vaultReferenceErrors(errs []*vault.VaultError, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, err := range errs {
+		allErrs = append(allErrs, field.Invalid(path.Child(err.KeyPath), err.VaultRef, err.Err.Error()))
+	}
+	return allErrs
+}
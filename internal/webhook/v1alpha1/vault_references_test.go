@@ -0,0 +1,76 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+func TestValidateVaultReferences(t *testing.T) {
+	validator := newValidator(t)
+
+	connector := newConnector("connectors", "users", "group_a",
+		`{"schema_prefix":"users","user":"vault:db#user","password":"vault:db","hosts":["vault:db#host|upper"]}`)
+	connector.Spec.Connector.Auth = &runtime.RawExtension{Raw: []byte(`{"client_access":{"vaultSecretRef":"vault:oauth#*|trim"}}`)}
+	_, err := validator.ValidateCreate(context.Background(), connector)
+	if !apierrors.IsInvalid(err) {
+		t.Fatalf("expected an Invalid error, got %v", err)
+	}
+	causes := err.(*apierrors.StatusError).ErrStatus.Details.Causes
+	if len(causes) != 3 {
+		t.Fatalf("expected 3 causes, got %v", causes)
+	}
+	for _, expected := range []string{
+		"spec.connector.config.password",
+		"spec.connector.config.hosts[0]",
+		"spec.connector.auth.client_access.vaultSecretRef",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error for %s, got %v", expected, err)
+		}
+	}
+
+	// A connector whose references are unchanged can still be paused
+	paused := connector.DeepCopy()
+	pausedTrue := true
+	paused.Spec.Connector.Paused = &pausedTrue
+	if _, err := validator.ValidateUpdate(context.Background(), connector, paused); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCheckVaultReferencesUnavailable(t *testing.T) {
+	validator := newValidator(t)
+	var calls int
+	validator.vaultReads = VaultReads{Client: func(context.Context, *operatorv1alpha1.FivetranConnector) (*vaultpkg.VaultClient, error) {
+		calls++
+		return nil, errors.New("connection refused")
+	}}
+
+	warnings, err := validator.ValidateCreate(context.Background(),
+		newConnector("connectors", "users", "group_a", `{"schema_prefix":"users","password":"vault:db#password"}`))
+	if err != nil {
+		t.Fatalf("expected the connector to be admitted, got %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "connection refused") {
+		t.Errorf("expected a warning about the unavailable Vault, got %v", warnings)
+	}
+
+	// Malformed references are rejected without reading Vault
+	calls = 0
+	_, err = validator.ValidateCreate(context.Background(),
+		newConnector("connectors", "users", "group_a", `{"schema_prefix":"users","password":"vault:db"}`))
+	if !apierrors.IsInvalid(err) {
+		t.Errorf("expected an Invalid error, got %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("expected no Vault reads, got %d", calls)
+	}
+}
@@ -0,0 +1,140 @@
+package fivetran
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	httputils "github.com/fivetran/go-fivetran/http_utils"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ErrCircuitOpen is returned without contacting Fivetran while the API is considered unavailable
+var ErrCircuitOpen = errors.New("fivetran api circuit breaker is open")
+
+// CircuitBreakerConfig configures the breaker that stops requests during Fivetran API outages
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive 5xx responses that opens the circuit; zero or
+	// less disables the breaker
+	FailureThreshold int
+	// OpenDuration is how long the circuit stays open before a single probe request is let through
+	OpenDuration time.Duration
+}
+
+// DefaultCircuitBreakerConfig returns the breaker settings used by NewClient
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		FailureThreshold: 5,
+		OpenDuration:     30 * time.Second,
+	}
+}
+
+// circuitState is the state of a circuit breaker
+type circuitState int
+
+const (
+	// circuitClosed lets every request through
+	circuitClosed circuitState = iota
+	// circuitOpen rejects every request until the open duration has passed
+	circuitOpen
+	// circuitHalfOpen has let one probe request through and rejects others until it completes
+	circuitHalfOpen
+)
+
+// circuitOpenError rejects a request while the circuit is open
+type circuitOpenError struct {
+	retryAfter time.Duration
+}
+
+func (e *circuitOpenError) Error() string {
+	return fmt.Sprintf("%s: retrying in %s", ErrCircuitOpen, e.retryAfter.Round(time.Second))
+}
+
+func (e *circuitOpenError) Unwrap() error {
+	return ErrCircuitOpen
+}
+
+// circuitBreakerClient fails fast after consecutive server errors, periodically letting a probe
+// request through to detect recovery
+type circuitBreakerClient struct {
+	cfg  CircuitBreakerConfig
+	next httputils.HttpClient
+	now  func() time.Time
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+}
+
+// newCircuitBreakerClient wraps next with a circuit breaker, or returns next when it is disabled
+func newCircuitBreakerClient(next httputils.HttpClient, cfg CircuitBreakerConfig) httputils.HttpClient {
+	if cfg.FailureThreshold <= 0 {
+		return next
+	}
+	return &circuitBreakerClient{cfg: cfg, next: next, now: time.Now}
+}
+
+// Do performs the request unless the circuit is open, and records its outcome
+func (c *circuitBreakerClient) Do(req *http.Request) (*http.Response, error) {
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.next.Do(req)
+	if c.record(resp, err) {
+		log.FromContext(req.Context()).Info("Fivetran API circuit breaker opened",
+			"consecutiveFailures", c.cfg.FailureThreshold, "openDuration", c.cfg.OpenDuration)
+	}
+	return resp, err
+}
+
+// allow reports whether a request may be sent, moving an open circuit to half-open once its open
+// duration has passed
+func (c *circuitBreakerClient) allow() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.state {
+	case circuitOpen:
+		elapsed := c.now().Sub(c.openedAt)
+		if elapsed < c.cfg.OpenDuration {
+			return &circuitOpenError{retryAfter: c.cfg.OpenDuration - elapsed}
+		}
+		c.state = circuitHalfOpen
+		return nil
+	case circuitHalfOpen:
+		return &circuitOpenError{retryAfter: max(c.cfg.OpenDuration, time.Second)}
+	default:
+		return nil
+	}
+}
+
+// record updates the circuit with the outcome of a request, returning true when it opened the
+// circuit after a closed period. Requests that failed without a response only count against a probe.
+func (c *circuitBreakerClient) record(resp *http.Response, err error) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	serverError := resp != nil && resp.StatusCode >= http.StatusInternalServerError
+	switch {
+	case c.state == circuitHalfOpen && (err != nil || serverError):
+		c.state = circuitOpen
+		c.openedAt = c.now()
+	case err != nil:
+	case serverError:
+		c.failures++
+		if c.failures >= c.cfg.FailureThreshold {
+			c.state = circuitOpen
+			c.openedAt = c.now()
+			c.failures = 0
+			return true
+		}
+	default:
+		c.state = circuitClosed
+		c.failures = 0
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package fivetran
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// statusClient responds with the given status codes in turn
+type statusClient struct {
+	statuses []int
+	requests int
+}
+
+func (c *statusClient) Do(*http.Request) (*http.Response, error) {
+	status := c.statuses[c.requests]
+	c.requests++
+	return &http.Response{StatusCode: status}, nil
+}
+
+func TestCircuitBreakerClient(t *testing.T) {
+	next := &statusClient{statuses: []int{500, 200, 502, 503, 504, 500, 200, 200}}
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	breaker := newCircuitBreakerClient(next, CircuitBreakerConfig{FailureThreshold: 3, OpenDuration: time.Minute}).(*circuitBreakerClient)
+	breaker.now = func() time.Time { return now }
+
+	do := func() error {
+		req, _ := http.NewRequest(http.MethodGet, "https://api.fivetran.com/v1/connections", nil)
+		_, err := breaker.Do(req)
+		return err
+	}
+
+	// A success resets the count of consecutive failures
+	for range 2 {
+		if err := do(); err != nil {
+			t.Fatalf("expected no error but got: %v", err)
+		}
+	}
+	for range 3 {
+		if err := do(); err != nil {
+			t.Fatalf("expected no error but got: %v", err)
+		}
+	}
+
+	// Three consecutive server errors open the circuit
+	err := do()
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected open circuit, got %v", err)
+	}
+	if next.requests != 5 {
+		t.Errorf("expected an open circuit not to send requests, got %d sent", next.requests)
+	}
+
+	// After the open duration a probe is sent; its failure opens the circuit again
+	now = now.Add(time.Minute)
+	if err := do(); err != nil {
+		t.Fatalf("expected the probe to be sent, got: %v", err)
+	}
+	if err := do(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected a failed probe to reopen the circuit, got %v", err)
+	}
+
+	// A successful probe closes the circuit
+	now = now.Add(time.Minute)
+	if err := do(); err != nil {
+		t.Fatalf("expected the probe to be sent, got: %v", err)
+	}
+	if err := do(); err != nil {
+		t.Fatalf("expected a closed circuit, got: %v", err)
+	}
+	if next.requests != len(next.statuses) {
+		t.Errorf("expected %d requests, got %d", len(next.statuses), next.requests)
+	}
+}
+
+func TestCircuitOpenAPIError(t *testing.T) {
+	err := WrapFivetranError(nil, &circuitOpenError{retryAfter: 20 * time.Second})
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("expected the API error to wrap ErrCircuitOpen, got %v", err)
+	}
+	if RetryAfter(err) != 20*time.Second {
+		t.Errorf("expected retry after 20s, got %v", RetryAfter(err))
+	}
+}
@@ -0,0 +1,78 @@
+package fivetran
+
+import (
+	"context"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/certificates"
+	"github.com/fivetran/go-fivetran/common"
+)
+
+type certificateServiceImpl struct {
+	client *fivetran.Client
+	retry  RetryConfig
+}
+
+func newCertificateService(client *fivetran.Client, retry RetryConfig) CertificatesService {
+	return &certificateServiceImpl{client: client, retry: retry}
+}
+
+// ListConnectionCertificates retrieves every TLS certificate approved for a connection
+func (s *certificateServiceImpl) ListConnectionCertificates(ctx context.Context, connectionID string) ([]certificates.CertificateDetails, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]certificates.CertificateDetails, string, error) {
+		service := s.client.NewConnectionCertificatesList().ConnectionID(connectionID).Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (certificates.CertificatesListResponse, error) {
+			return callAPI(ctx, operationListConnectionCertificates, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// ApproveConnectionCertificate trusts the TLS certificate with the given hash and base64 encoded
+// content for a connection
+func (s *certificateServiceImpl) ApproveConnectionCertificate(ctx context.Context, connectionID, hash, encodedCert string) (certificates.CertificateResponse, error) {
+	service := s.client.NewCertificateConnectionCertificateApprove().
+		ConnectionID(connectionID).
+		Hash(hash).
+		EncodedCert(encodedCert)
+	return callAPI(ctx, operationApproveConnectionCertificate, service.Do)
+}
+
+// RevokeConnectionCertificate stops trusting the TLS certificate with the given hash for a connection
+func (s *certificateServiceImpl) RevokeConnectionCertificate(ctx context.Context, connectionID, hash string) (common.CommonResponse, error) {
+	service := s.client.NewConnectionCertificateRevoke().ConnectionID(connectionID).Hash(hash)
+	return callAPI(ctx, operationRevokeConnectionCertificate, service.Do)
+}
+
+// ListDestinationCertificates retrieves every TLS certificate approved for a destination
+func (s *certificateServiceImpl) ListDestinationCertificates(ctx context.Context, destinationID string) ([]certificates.CertificateDetails, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]certificates.CertificateDetails, string, error) {
+		service := s.client.NewDestinationCertificatesList().DestinationID(destinationID).Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (certificates.CertificatesListResponse, error) {
+			return callAPI(ctx, operationListDestinationCertificates, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// ApproveDestinationCertificate trusts the TLS certificate with the given hash and base64 encoded
+// content for a destination
+func (s *certificateServiceImpl) ApproveDestinationCertificate(ctx context.Context, destinationID, hash, encodedCert string) (certificates.CertificateResponse, error) {
+	service := s.client.NewCertificateDestinationCertificateApprove().
+		DestinationID(destinationID).
+		Hash(hash).
+		EncodedCert(encodedCert)
+	return callAPI(ctx, operationApproveDestinationCertificate, service.Do)
+}
+
+// RevokeDestinationCertificate stops trusting the TLS certificate with the given hash for a destination
+func (s *certificateServiceImpl) RevokeDestinationCertificate(ctx context.Context, destinationID, hash string) (common.CommonResponse, error) {
+	service := s.client.NewDestinationCertificateRevoke().DestinationID(destinationID).Hash(hash)
+	return callAPI(ctx, operationRevokeDestinationCertificate, service.Do)
+}
@@ -1,30 +1,122 @@
 package fivetran
 
 import (
+	"context"
 	"errors"
 
 	fivetran "github.com/fivetran/go-fivetran"
+	httputils "github.com/fivetran/go-fivetran/http_utils"
 )
 
 // Client manages the Fivetran API client and services
 type Client struct {
-	sdk         *fivetran.Client
-	Connections ConnectorService
-	Schemas     SchemaService
+	sdk             *fivetran.Client
+	rest            *restClient
+	Connections     ConnectorService
+	Schemas         SchemaService
+	Groups          GroupsService
+	Destinations    DestinationsService
+	Users           UsersService
+	Teams           TeamsService
+	Certificates    CertificatesService
+	Fingerprints    FingerprintsService
+	Sync            SyncService
+	Logs            LogsService
+	Webhooks        WebhooksService
+	Metadata        MetadataService
+	Transformations TransformationsService
+}
+
+// ClientConfig holds the settings of the Fivetran API client
+type ClientConfig struct {
+	// HTTP configures timeouts and the transport of API requests
+	HTTP HTTPConfig
+	// Retry configures retries of idempotent calls on transient errors
+	Retry RetryConfig
+	// RateLimit limits the rate of requests sent by the client
+	RateLimit RateLimitConfig
+	// CircuitBreaker stops requests while the Fivetran API is failing
+	CircuitBreaker CircuitBreakerConfig
+	// DebugLogging logs every request and response at V(2), with credentials masked
+	DebugLogging bool
+	// Faults injects failures and latency into requests, for testing; nothing is injected by default
+	Faults FaultInjectionConfig
+	// BaseURL overrides the URL of the Fivetran API, such as with a fake server in tests; empty
+	// uses the public API
+	BaseURL string
+	// APIVersion pins the version of every API request; the default keeps the version the SDK
+	// requests for each endpoint
+	APIVersion APIVersion
+	// ConnectionsAPIVersion pins the version of connections requests, overriding APIVersion, so
+	// connection payloads can move to a new version on their own
+	ConnectionsAPIVersion APIVersion
+}
+
+// DefaultClientConfig returns the settings used by NewClient
+func DefaultClientConfig() ClientConfig {
+	return ClientConfig{
+		HTTP:           DefaultHTTPConfig(),
+		Retry:          DefaultRetryConfig(),
+		RateLimit:      DefaultRateLimitConfig(),
+		CircuitBreaker: DefaultCircuitBreakerConfig(),
+	}
 }
 
 // NewClient creates a new Fivetran client with all services
 func NewClient(apiKey, apiSecret string) (*Client, error) {
+	return NewClientWithConfig(apiKey, apiSecret, DefaultClientConfig())
+}
+
+// NewClientWithConfig creates a new Fivetran client with all services using the given settings
+func NewClientWithConfig(apiKey, apiSecret string, cfg ClientConfig) (*Client, error) {
 	if apiKey == "" || apiSecret == "" {
 		return nil, errors.New("FIVETRAN_API_KEY and FIVETRAN_API_SECRET are required")
 	}
+	if err := cfg.APIVersion.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.ConnectionsAPIVersion.Validate(); err != nil {
+		return nil, err
+	}
 
 	sdk := fivetran.New(apiKey, apiSecret)
-	client := &Client{sdk: sdk}
+	// The SDK waits out rate limits inside the call, blocking the reconcile; surface them as
+	// errors instead so the Retry-After delay can be honored by requeueing
+	sdk.SetHandleRateLimits(false)
+	if cfg.BaseURL != "" {
+		sdk.BaseURL(cfg.BaseURL)
+	}
+	// An open circuit rejects requests before they take a rate limit token
+	var httpClient httputils.HttpClient = newFaultInjectingClient(newHTTPClient(cfg.HTTP), cfg.Faults)
+	httpClient = newVersionPinningClient(httpClient, cfg.APIVersion, cfg.ConnectionsAPIVersion)
+	httpClient = newDebugLoggingClient(httpClient, cfg.DebugLogging)
+	httpClient = newRateLimitedClient(httpClient, cfg.RateLimit)
+	httpClient = newCircuitBreakerClient(httpClient, cfg.CircuitBreaker)
+	sdk.SetHttpClient(&responseRecordingClient{next: httpClient})
+	client := &Client{sdk: sdk, rest: newRESTClient(sdk)}
 
 	// Initialize services
-	client.Connections = newConnectionService(sdk)
-	client.Schemas = newSchemaService(sdk)
+	client.Connections = newConnectionService(sdk, client.rest, cfg.Retry)
+	client.Schemas = newSchemaService(sdk, cfg.Retry)
+	client.Groups = newGroupService(sdk, cfg.Retry)
+	client.Destinations = newDestinationService(sdk, cfg.Retry)
+	client.Users = newUserService(sdk, cfg.Retry)
+	client.Teams = newTeamService(sdk, cfg.Retry)
+	client.Certificates = newCertificateService(sdk, cfg.Retry)
+	client.Fingerprints = newFingerprintService(sdk, cfg.Retry)
+	client.Sync = newSyncService(client.rest)
+	client.Logs = newLogService(sdk, cfg.Retry)
+	client.Webhooks = newWebhookService(sdk, cfg.Retry)
+	client.Metadata = newMetadataService(sdk, cfg.Retry)
+	client.Transformations = newTransformationService(sdk, cfg.Retry)
 
 	return client, nil
 }
+
+// Ping checks that the Fivetran API is reachable and accepts the client's credentials, with the
+// cheapest authenticated call
+func (c *Client) Ping(ctx context.Context) error {
+	service := c.sdk.NewGroupsList().Limit(1)
+	_, err := callAPI(ctx, operationPing, service.Do)
+	return err
+}
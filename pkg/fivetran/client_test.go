@@ -0,0 +1,41 @@
+package fivetran
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPing(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups" || r.URL.Query().Get("limit") != "1" {
+			t.Errorf("expected a list of one group, got %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if status == http.StatusUnauthorized {
+			_, _ = w.Write([]byte(`{"code":"AuthFailed","message":"Invalid API key"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code":"Success","data":{"items":[]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Errorf("expected no error but got: %v", err)
+	}
+
+	status = http.StatusUnauthorized
+	apiErr, ok := AsAPIError(client.Ping(context.Background()))
+	if !ok || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected a 401 APIError, got %v", apiErr)
+	}
+}
@@ -0,0 +1,110 @@
+package fivetran
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/fivetran/go-fivetran/connections"
+)
+
+// MaskedValue is how Fivetran returns the values of secret config fields
+const MaskedValue = "******"
+
+// CompareConnector compares the connection Fivetran returned with the desired connector and returns
+// the fields an update would change, such as "paused" or "config.port". Fields the desired
+// connector leaves unset, and config keys Fivetran adds, are not compared.
+//
+// Fivetran never returns auth, and masks the values of secret config fields, so neither can be
+// compared. A non-empty auth is always reported. A masked config value is only taken to be applied
+// when secretsApplied reports that the secret values resolved for the desired connector are the
+// ones last sent to Fivetran and the value was resolved from a reference in specConfig, the config before
+// its secret references were resolved; a plaintext secret may have changed with the spec.
+func CompareConnector(remote connections.DetailsWithCustomConfigNoTestsResponse, desired *Connector, specConfig map[string]any, secretsApplied bool) []string {
+	data := remote.Data.DetailsResponseDataCommon
+	var changed []string
+
+	if desired.Paused != nil && (data.Paused == nil || *data.Paused != *desired.Paused) {
+		changed = append(changed, "paused")
+	}
+	if desired.PauseAfterTrial != nil && (data.PauseAfterTrial == nil || *data.PauseAfterTrial != *desired.PauseAfterTrial) {
+		changed = append(changed, "pause_after_trial")
+	}
+	if desired.SyncFrequency != 0 && (data.SyncFrequency == nil || *data.SyncFrequency != desired.SyncFrequency) {
+		changed = append(changed, "sync_frequency")
+	}
+	if desired.DataDelayThreshold != 0 && (data.DataDelayThreshold == nil || *data.DataDelayThreshold != desired.DataDelayThreshold) {
+		changed = append(changed, "data_delay_threshold")
+	}
+	for _, field := range []struct {
+		name             string
+		desired, current string
+	}{
+		{"daily_sync_time", desired.DailySyncTime, data.DailySyncTime},
+		{"schedule_type", desired.ScheduleType, data.ScheduleType},
+		{"data_delay_sensitivity", desired.DataDelaySensitivity, data.DataDelaySensitivity},
+		{"networking_method", desired.NetworkingMethod, data.NetworkingMethod},
+		{"proxy_agent_id", desired.ProxyAgentID, data.ProxyAgentId},
+		{"private_link_id", desired.PrivateLinkID, data.PrivateLinkId},
+		{"hybrid_deployment_agent_id", desired.HybridDeploymentAgentID, data.HybridDeploymentAgentId},
+	} {
+		if field.desired != "" && field.desired != field.current {
+			changed = append(changed, field.name)
+		}
+	}
+
+	if desired.Auth != nil && len(*desired.Auth) > 0 {
+		changed = append(changed, "auth")
+	}
+	if desired.Config != nil {
+		changed = compareConfig(changed, "config", *desired.Config, remote.Data.Config, specConfig, secretsApplied)
+	}
+
+	sort.Strings(changed)
+	return changed
+}
+
+// compareConfig appends the paths of the values of desired that differ from current to changed
+func compareConfig(changed []string, path string, desired, current, spec map[string]any, secretsApplied bool) []string {
+	for key, value := range desired {
+		if value == nil {
+			continue
+		}
+		keyPath := path + "." + key
+		currentValue, ok := current[key]
+		if !ok {
+			changed = append(changed, keyPath)
+			continue
+		}
+		specValue := spec[key]
+
+		switch v := value.(type) {
+		case map[string]any:
+			currentMap, ok := currentValue.(map[string]any)
+			if !ok {
+				changed = append(changed, keyPath)
+				continue
+			}
+			specMap, _ := specValue.(map[string]any)
+			changed = compareConfig(changed, keyPath, v, currentMap, specMap, secretsApplied)
+		case []any:
+			if !reflect.DeepEqual(v, currentValue) {
+				changed = append(changed, keyPath)
+			}
+		default:
+			if currentValue == MaskedValue {
+				// The value was resolved from a reference when it differs from the spec's
+				resolved := specValue != nil && fmt.Sprint(specValue) != fmt.Sprint(value)
+				if !secretsApplied || !resolved {
+					changed = append(changed, keyPath)
+				}
+				continue
+			}
+			// Fivetran returns some numbers and booleans as strings
+			if fmt.Sprint(value) != fmt.Sprint(currentValue) {
+				changed = append(changed, keyPath)
+			}
+		}
+	}
+	return changed
+}
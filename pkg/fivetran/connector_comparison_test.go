@@ -0,0 +1,114 @@
+package fivetran
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/connections"
+)
+
+// connectionResponse decodes a connection as Fivetran returns it
+func connectionResponse(t *testing.T, data string) connections.DetailsWithCustomConfigNoTestsResponse {
+	t.Helper()
+	var resp connections.DetailsWithCustomConfigNoTestsResponse
+	if err := json.Unmarshal([]byte(`{"code":"Success","data":`+data+`}`), &resp); err != nil {
+		t.Fatalf("failed to decode connection: %v", err)
+	}
+	return resp
+}
+
+func TestCompareConnector(t *testing.T) {
+	remote := `{
+		"id": "conn_1",
+		"paused": false,
+		"sync_frequency": 360,
+		"schedule_type": "auto",
+		"config": {
+			"host": "db.example.com",
+			"port": "5432",
+			"password": "******",
+			"tunnel": {"host": "bastion", "private_key": "******"},
+			"schema_prefix": "sales"
+		}
+	}`
+	paused := false
+	desired := func(config map[string]any) *Connector {
+		return &Connector{
+			Paused:        &paused,
+			SyncFrequency: 360,
+			ScheduleType:  "auto",
+			Config:        &config,
+		}
+	}
+	resolvedConfig := map[string]any{
+		"host":     "db.example.com",
+		"port":     float64(5432),
+		"password": "hunter22",
+		"tunnel":   map[string]any{"private_key": "-----BEGIN KEY-----"},
+	}
+	referenceConfig := map[string]any{
+		"host":     "db.example.com",
+		"port":     float64(5432),
+		"password": "vault:fivetran/sales#password",
+		"tunnel":   map[string]any{"private_key": "vault:fivetran/sales#private_key"},
+	}
+
+	tests := []struct {
+		name           string
+		desired        *Connector
+		specConfig     map[string]any
+		secretsApplied bool
+		expected       []string
+	}{
+		{
+			name:           "secrets resolved from applied references match",
+			desired:        desired(resolvedConfig),
+			specConfig:     referenceConfig,
+			secretsApplied: true,
+		},
+		{
+			name:       "secrets not yet applied differ",
+			desired:    desired(resolvedConfig),
+			specConfig: referenceConfig,
+			expected:   []string{"config.password", "config.tunnel.private_key"},
+		},
+		{
+			name:           "plaintext secrets differ",
+			desired:        desired(resolvedConfig),
+			specConfig:     resolvedConfig,
+			secretsApplied: true,
+			expected:       []string{"config.password", "config.tunnel.private_key"},
+		},
+		{
+			name: "changed fields differ",
+			desired: &Connector{
+				SyncFrequency: 60,
+				DailySyncTime: "03:00",
+				Config:        &map[string]any{"host": "replica.example.com", "database": "sales"},
+			},
+			secretsApplied: true,
+			expected:       []string{"config.database", "config.host", "daily_sync_time", "sync_frequency"},
+		},
+		{
+			name:           "auth always differs",
+			desired:        &Connector{Auth: &map[string]any{"client_access": map[string]any{"client_id": "abc"}}},
+			secretsApplied: true,
+			expected:       []string{"auth"},
+		},
+		{
+			name:     "unset fields are not compared",
+			desired:  &Connector{Config: &map[string]any{}, Auth: &map[string]any{}},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := CompareConnector(connectionResponse(t, remote), tt.desired, tt.specConfig, tt.secretsApplied)
+			if !reflect.DeepEqual(changed, tt.expected) {
+				t.Errorf("expected changed fields %v, got %v", tt.expected, changed)
+			}
+		})
+	}
+}
@@ -2,18 +2,22 @@ package fivetran
 
 import (
 	"context"
+	"net/http"
 
 	fivetran "github.com/fivetran/go-fivetran"
 	"github.com/fivetran/go-fivetran/common"
+	connectcard "github.com/fivetran/go-fivetran/connect_card"
 	"github.com/fivetran/go-fivetran/connections"
 )
 
 type connectionServiceImpl struct {
 	client *fivetran.Client
+	rest   *restClient
+	retry  RetryConfig
 }
 
-func newConnectionService(client *fivetran.Client) ConnectorService {
-	return &connectionServiceImpl{client: client}
+func newConnectionService(client *fivetran.Client, rest *restClient, retry RetryConfig) ConnectorService {
+	return &connectionServiceImpl{client: client, rest: rest, retry: retry}
 }
 
 // Connection represents a Fivetran Connection configuration
@@ -38,75 +42,77 @@ type Connector struct {
 	HybridDeploymentAgentID string          `json:"hybrid_deployment_agent_id,omitempty"`
 }
 
-// CreateConnection creates a new Fivetran Connection
-func (s *connectionServiceImpl) CreateConnection(ctx context.Context, Connection *Connector) (connections.DetailsWithCustomConfigResponse, error) {
-	ConnectionService := s.client.NewConnectionCreate()
+// ConnectionStateResponse holds the state of a connection, such as the cursors its syncs resume from
+type ConnectionStateResponse struct {
+	common.CommonResponse
+	Data struct {
+		State map[string]any `json:"state"`
+	} `json:"data"`
+}
 
-	service := ConnectionService.
-		Service(Connection.Service).
-		GroupID(Connection.GroupID).
-		RunSetupTests(false)
+// connectionCreateRequest is the body of a create connection request. It is sent with the REST
+// client, since the SDK cannot set schedule_type when creating a connection.
+type connectionCreateRequest struct {
+	Service                 string          `json:"service"`
+	GroupID                 string          `json:"group_id"`
+	RunSetupTests           bool            `json:"run_setup_tests"`
+	Paused                  *bool           `json:"paused,omitempty"`
+	SyncFrequency           *int            `json:"sync_frequency,omitempty"`
+	DailySyncTime           string          `json:"daily_sync_time,omitempty"`
+	ScheduleType            string          `json:"schedule_type,omitempty"`
+	PauseAfterTrial         *bool           `json:"pause_after_trial,omitempty"`
+	Config                  *map[string]any `json:"config,omitempty"`
+	Auth                    *map[string]any `json:"auth,omitempty"`
+	NetworkingMethod        string          `json:"networking_method,omitempty"`
+	ProxyAgentID            string          `json:"proxy_agent_id,omitempty"`
+	PrivateLinkID           string          `json:"private_link_id,omitempty"`
+	HybridDeploymentAgentID string          `json:"hybrid_deployment_agent_id,omitempty"`
+	DataDelaySensitivity    string          `json:"data_delay_sensitivity,omitempty"`
+	DataDelayThreshold      *int            `json:"data_delay_threshold,omitempty"`
+}
 
-	// Handle pointer fields with nil checks
-	if Connection.Paused != nil {
-		service = service.Paused(*Connection.Paused)
+// CreateConnection creates a new Fivetran Connection with its complete configuration, including
+// its schedule type
+func (s *connectionServiceImpl) CreateConnection(ctx context.Context, Connection *Connector) (connections.DetailsWithCustomConfigResponse, error) {
+	request := connectionCreateRequest{
+		Service:                 Connection.Service,
+		GroupID:                 Connection.GroupID,
+		RunSetupTests:           false,
+		Paused:                  Connection.Paused,
+		DailySyncTime:           Connection.DailySyncTime,
+		ScheduleType:            Connection.ScheduleType,
+		PauseAfterTrial:         Connection.PauseAfterTrial,
+		Config:                  Connection.Config,
+		Auth:                    Connection.Auth,
+		NetworkingMethod:        Connection.NetworkingMethod,
+		ProxyAgentID:            Connection.ProxyAgentID,
+		PrivateLinkID:           Connection.PrivateLinkID,
+		HybridDeploymentAgentID: Connection.HybridDeploymentAgentID,
+		DataDelaySensitivity:    Connection.DataDelaySensitivity,
 	}
-
 	if Connection.SyncFrequency != 0 {
-		service = service.SyncFrequency(&Connection.SyncFrequency)
+		request.SyncFrequency = &Connection.SyncFrequency
 	}
-
-	if Connection.DailySyncTime != "" {
-		service = service.DailySyncTime(Connection.DailySyncTime)
-	}
-
-	// Note: ScheduleType is not available in fivetran go sdk
-
-	if Connection.PauseAfterTrial != nil {
-		service = service.PauseAfterTrial(*Connection.PauseAfterTrial)
-	}
-
-	if Connection.Config != nil {
-		service = service.ConfigCustom(Connection.Config)
-	}
-
-	if Connection.Auth != nil {
-		service = service.AuthCustom(Connection.Auth)
-	}
-
-	if Connection.NetworkingMethod != "" {
-		service = service.NetworkingMethod(Connection.NetworkingMethod)
-	}
-
-	if Connection.ProxyAgentID != "" {
-		service = service.ProxyAgentId(Connection.ProxyAgentID)
-	}
-
-	if Connection.PrivateLinkID != "" {
-		service = service.PrivateLinkId(Connection.PrivateLinkID)
-	}
-
-	if Connection.HybridDeploymentAgentID != "" {
-		service = service.HybridDeploymentAgentId(Connection.HybridDeploymentAgentID)
-	}
-
-	if Connection.DataDelaySensitivity != "" {
-		service = service.DataDelaySensitivity(Connection.DataDelaySensitivity)
-	}
-
 	if Connection.DataDelayThreshold != 0 {
-		service = service.DataDelayThreshold(&Connection.DataDelayThreshold)
+		request.DataDelayThreshold = &Connection.DataDelayThreshold
 	}
 
-	resp, err := service.DoCustom(ctx)
-	return resp, WrapFivetranError(resp, err)
+	resp, err := restCall[connections.DetailsWithCustomConfigResponse](ctx, s.rest, restRequest{
+		operation:      operationCreateConnection,
+		method:         http.MethodPost,
+		path:           restPath("connections"),
+		body:           request,
+		expectedStatus: http.StatusCreated,
+	})
+	return resp, scrubConnectorError(err, Connection)
 }
 
 // GetConnection retrieves a Fivetran Connection by ID
 func (s *connectionServiceImpl) GetConnection(ctx context.Context, ConnectionID string) (connections.DetailsWithCustomConfigNoTestsResponse, error) {
 	ConnectionService := s.client.NewConnectionDetails()
-	resp, err := ConnectionService.ConnectionID(ConnectionID).DoCustom(ctx)
-	return resp, WrapFivetranError(resp, err)
+	return callWithRetry(ctx, s.retry, func() (connections.DetailsWithCustomConfigNoTestsResponse, error) {
+		return callAPI(ctx, operationGetConnection, ConnectionService.ConnectionID(ConnectionID).DoCustom)
+	})
 }
 
 // UpdateConnection updates an existing Fivetran Connection
@@ -167,15 +173,16 @@ func (s *connectionServiceImpl) UpdateConnection(ctx context.Context, Connection
 		service = service.DataDelayThreshold(&Connection.DataDelayThreshold)
 	}
 
-	resp, err := service.DoCustom(ctx)
-	return resp, WrapFivetranError(resp, err)
+	return callWithRetry(ctx, s.retry, func() (connections.DetailsWithCustomConfigResponse, error) {
+		resp, err := callAPI(ctx, operationUpdateConnection, service.DoCustom)
+		return resp, scrubConnectorError(err, Connection)
+	})
 }
 
 // DeleteConnection deletes a Fivetran Connection
 func (s *connectionServiceImpl) DeleteConnection(ctx context.Context, ConnectionID string) (common.CommonResponse, error) {
 	ConnectionService := s.client.NewConnectionDelete()
-	resp, err := ConnectionService.ConnectionID(ConnectionID).Do(ctx)
-	return resp, WrapFivetranError(resp, err)
+	return callAPI(ctx, operationDeleteConnection, ConnectionService.ConnectionID(ConnectionID).Do)
 }
 
 // RunSetupTests runs setup tests for a Connection
@@ -195,6 +202,41 @@ func (s *connectionServiceImpl) RunSetupTests(ctx context.Context, ConnectionID
 		service = service.TrustFingerprints(true) // Default to true
 	}
 
-	resp, err := service.Do(ctx)
-	return resp, WrapFivetranError(resp, err)
+	return callAPI(ctx, operationRunSetupTests, service.Do)
+}
+
+// GetConnectionState retrieves the state of a Connection, which its syncs resume from
+func (s *connectionServiceImpl) GetConnectionState(ctx context.Context, ConnectionID string) (ConnectionStateResponse, error) {
+	return callWithRetry(ctx, s.retry, func() (ConnectionStateResponse, error) {
+		return restCall[ConnectionStateResponse](ctx, s.rest, restRequest{
+			operation: operationGetConnectionState,
+			method:    http.MethodGet,
+			path:      restPath("connections", ConnectionID, "state"),
+		})
+	})
+}
+
+// UpdateConnectionState replaces the state of a Connection, for example to reset a stuck cursor.
+// Fivetran only accepts the update while the Connection is paused.
+func (s *connectionServiceImpl) UpdateConnectionState(ctx context.Context, ConnectionID string, state map[string]any) (ConnectionStateResponse, error) {
+	return callWithRetry(ctx, s.retry, func() (ConnectionStateResponse, error) {
+		return restCall[ConnectionStateResponse](ctx, s.rest, restRequest{
+			operation: operationUpdateConnectionState,
+			method:    http.MethodPatch,
+			path:      restPath("connections", ConnectionID, "state"),
+			body:      map[string]any{"state": state},
+		})
+	})
+}
+
+// CreateConnectCard generates a Connect Card for a Connection, through which a user completes its
+// setup, such as an OAuth authorization, in the browser. Each call issues a new token.
+func (s *connectionServiceImpl) CreateConnectCard(ctx context.Context, ConnectionID, redirectURI string, hideSetupGuide bool) (connectcard.ConnectCardResponse, error) {
+	config := fivetran.NewConnectCardConfig().HideSetupGuide(hideSetupGuide)
+	if redirectURI != "" {
+		config = config.RedirectUri(redirectURI)
+	}
+
+	service := s.client.NewConnectCard().ConnectorId(ConnectionID).Config(config)
+	return callAPI(ctx, operationCreateConnectCard, service.Do)
 }
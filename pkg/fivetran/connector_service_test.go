@@ -0,0 +1,124 @@
+package fivetran
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCreateConnection(t *testing.T) {
+	var gotMethod, gotPath string
+	var gotBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotMethod, gotPath = r.Method, r.URL.Path
+		_ = json.Unmarshal(body, &gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"code":"Success","data":{"id":"connection_id","schedule_type":"manual","paused":true}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	paused := true
+	resp, err := client.Connections.CreateConnection(context.Background(), &Connector{
+		Service:       "postgres",
+		GroupID:       "group_id",
+		Paused:        &paused,
+		SyncFrequency: 360,
+		ScheduleType:  "manual",
+		Config:        &map[string]any{"host": "db"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if gotMethod != http.MethodPost || gotPath != "/connections" {
+		t.Errorf("expected POST /connections, got %s %s", gotMethod, gotPath)
+	}
+	expected := map[string]any{
+		"service":         "postgres",
+		"group_id":        "group_id",
+		"run_setup_tests": false,
+		"paused":          true,
+		"sync_frequency":  float64(360),
+		"schedule_type":   "manual",
+		"config":          map[string]any{"host": "db"},
+	}
+	if !reflect.DeepEqual(gotBody, expected) {
+		t.Errorf("expected the complete configuration in the request body %v, got %v", expected, gotBody)
+	}
+	if resp.Data.ID != "connection_id" || resp.Data.ScheduleType != "manual" {
+		t.Errorf("expected the created connection in the response, got %+v", resp.Data)
+	}
+}
+
+func TestUpdateConnectionState(t *testing.T) {
+	var gotMethod, gotPath, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotMethod, gotPath, gotBody = r.Method, r.URL.Path, string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"Success","data":{"state":{"cursor":"2024-01-01T00:00:00Z"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	resp, err := client.Connections.UpdateConnectionState(context.Background(), "connection_id", map[string]any{"cursor": "2024-01-01T00:00:00Z"})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if gotMethod != http.MethodPatch || gotPath != "/connections/connection_id/state" {
+		t.Errorf("expected PATCH /connections/connection_id/state, got %s %s", gotMethod, gotPath)
+	}
+	if gotBody != `{"state":{"cursor":"2024-01-01T00:00:00Z"}}` {
+		t.Errorf("expected the state in the request body, got %s", gotBody)
+	}
+	if resp.Data.State["cursor"] != "2024-01-01T00:00:00Z" {
+		t.Errorf("expected the updated cursor in the response, got %v", resp.Data.State)
+	}
+}
+
+func TestCreateConnectCard(t *testing.T) {
+	var gotPath, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotPath, gotBody = r.URL.Path, string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"Success","data":{"connector_id":"connection_id","connect_card":{"token":"token","uri":"https://fivetran.com/connect-card/setup?auth=token"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	resp, err := client.Connections.CreateConnectCard(context.Background(), "connection_id", "https://example.com/done", false)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if gotPath != "/connectors/connection_id/connect-card" {
+		t.Errorf("expected POST /connectors/connection_id/connect-card, got %s", gotPath)
+	}
+	if gotBody != `{"connect_card_config":{"redirect_uri":"https://example.com/done","hide_setup_guide":false}}` {
+		t.Errorf("expected the connect card config in the request body, got %s", gotBody)
+	}
+	if resp.Data.ConnectCard.Uri != "https://fivetran.com/connect-card/setup?auth=token" {
+		t.Errorf("expected the connect card URI in the response, got %q", resp.Data.ConnectCard.Uri)
+	}
+}
@@ -0,0 +1,118 @@
+package fivetran
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	httputils "github.com/fivetran/go-fivetran/http_utils"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
+)
+
+// maxDebugBodyLength is the number of bytes of a request or response body included in debug logs
+const maxDebugBodyLength = 1024
+
+// debugLoggingClient logs every request and response at V(2), with credentials masked
+type debugLoggingClient struct {
+	next httputils.HttpClient
+}
+
+// newDebugLoggingClient wraps next with debug logging, or returns next when it is disabled
+func newDebugLoggingClient(next httputils.HttpClient, enabled bool) httputils.HttpClient {
+	if !enabled {
+		return next
+	}
+	return &debugLoggingClient{next: next}
+}
+
+// Do performs the request and logs its method, path, status, duration and sanitized bodies
+func (c *debugLoggingClient) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	logger := log.FromContext(ctx).V(2)
+	if !logger.Enabled() {
+		return c.next.Do(req)
+	}
+	redactor := redact.FromContext(ctx)
+
+	keysAndValues := []any{"method", req.Method, "path", req.URL.Path}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			_ = body.Close()
+			if len(data) > 0 {
+				keysAndValues = append(keysAndValues, "requestBody", sanitizeBody(data, redactor))
+			}
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.next.Do(req)
+	keysAndValues = append(keysAndValues, "duration", time.Since(start))
+	if err != nil {
+		logger.Info("Fivetran API request failed", append(keysAndValues, "error", err.Error())...)
+		return resp, err
+	}
+
+	keysAndValues = append(keysAndValues, "status", resp.StatusCode)
+	if id := requestID(resp.Header); id != "" {
+		keysAndValues = append(keysAndValues, "requestId", id)
+	}
+	// Read the body for the log and hand the SDK an identical copy
+	data, readErr := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if readErr != nil {
+		return resp, readErr
+	}
+	if len(data) > 0 {
+		keysAndValues = append(keysAndValues, "responseBody", sanitizeBody(data, redactor))
+	}
+
+	logger.Info("Fivetran API request", keysAndValues...)
+	return resp, nil
+}
+
+// sanitizeBody returns the start of a body with every auth value, the values of sensitive config
+// keys, and the resolved secrets known to redactor masked
+func sanitizeBody(body []byte, redactor *redact.Redactor) string {
+	var data any
+	if err := json.Unmarshal(body, &data); err == nil {
+		if masked, err := json.Marshal(maskSubmittedValues(data, false)); err == nil {
+			body = masked
+		}
+	}
+
+	snippet := redact.MaskSensitiveFields(redactor.Redact(string(body)))
+	if len(snippet) > maxDebugBodyLength {
+		snippet = strings.ToValidUTF8(snippet[:maxDebugBodyLength], "") + "..."
+	}
+	return snippet
+}
+
+// maskSubmittedValues masks the values under auth objects and sensitive keys in decoded JSON
+func maskSubmittedValues(data any, maskAll bool) any {
+	switch v := data.(type) {
+	case map[string]any:
+		for key, value := range v {
+			v[key] = maskSubmittedValues(value, maskAll || key == "auth" || redact.IsSensitiveKey(key))
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = maskSubmittedValues(item, maskAll)
+		}
+		return v
+	case nil:
+		return nil
+	default:
+		if maskAll {
+			return redact.Mask
+		}
+		return v
+	}
+}
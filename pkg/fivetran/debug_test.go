@@ -0,0 +1,100 @@
+package fivetran
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
+)
+
+func TestSanitizeBody(t *testing.T) {
+	redactor := redact.New()
+	redactor.Add("resolved-vault-secret")
+
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "auth and sensitive config are masked",
+			body:     `{"auth":{"client_access":{"client_id":"id","client_secret":"s"}},"config":{"host":"db","password":"p","port":5432}}`,
+			expected: `{"auth":{"client_access":{"client_id":"[REDACTED]","client_secret":"[REDACTED]"}},"config":{"host":"db","password":"[REDACTED]","port":5432}}`,
+		},
+		{
+			name:     "resolved secrets are masked anywhere",
+			body:     `{"config":{"user":"resolved-vault-secret"}}`,
+			expected: `{"config":{"user":"[REDACTED]"}}`,
+		},
+		{
+			name:     "non-json bodies mask sensitive fields",
+			body:     `error: password=hunter2`,
+			expected: `error: password=[REDACTED]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitizeBody([]byte(tt.body), redactor); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	long := sanitizeBody([]byte(strings.Repeat("a", 2*maxDebugBodyLength)), nil)
+	if len(long) != maxDebugBodyLength+len("...") {
+		t.Errorf("expected the body to be truncated to %d bytes, got %d", maxDebugBodyLength, len(long))
+	}
+}
+
+// responseClient responds with a fixed body
+type responseClient struct {
+	body string
+}
+
+func (c *responseClient) Do(*http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(c.body))}, nil
+}
+
+func TestDebugLoggingClient(t *testing.T) {
+	var output bytes.Buffer
+	logger := funcr.New(func(prefix, args string) {
+		output.WriteString(args + "\n")
+	}, funcr.Options{Verbosity: 2})
+	ctx := log.IntoContext(context.Background(), logger)
+
+	responseBody := `{"code":"Success","data":{"id":"connection_id"}}`
+	client := newDebugLoggingClient(&responseClient{body: responseBody}, true)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPatch, "https://api.fivetran.com/v1/connections/connection_id",
+		strings.NewReader(`{"auth":{"refresh_token":"plaintext-refresh-token"}}`))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != responseBody {
+		t.Errorf("expected the response body to be passed through, got %q", body)
+	}
+
+	logged := output.String()
+	for _, expected := range []string{`"method"="PATCH"`, `"path"="/v1/connections/connection_id"`, `"status"=200`, "connection_id"} {
+		if !strings.Contains(logged, expected) {
+			t.Errorf("expected log to contain %s, got %s", expected, logged)
+		}
+	}
+	if strings.Contains(logged, "plaintext-refresh-token") {
+		t.Errorf("expected auth values to be masked, got %s", logged)
+	}
+
+	if newDebugLoggingClient(client, false) != client {
+		t.Errorf("expected disabled debug logging to return the wrapped client")
+	}
+}
@@ -0,0 +1,23 @@
+package fivetran
+
+// DestinationSchema returns the destination schema named by a connector config: schema_prefix for
+// connectors syncing several schemas, otherwise schema, suffixed with the table group of table
+// group connectors or the table of single table connectors. It is empty when the config names no
+// schema.
+func DestinationSchema(config map[string]any) string {
+	if prefix, ok := config["schema_prefix"].(string); ok && prefix != "" {
+		return prefix
+	}
+
+	schema, ok := config["schema"].(string)
+	if !ok || schema == "" {
+		return ""
+	}
+	if tableGroup, ok := config["table_group_name"].(string); ok && tableGroup != "" {
+		return schema + "." + tableGroup
+	}
+	if table, ok := config["table"].(string); ok && table != "" {
+		return schema + "." + table
+	}
+	return schema
+}
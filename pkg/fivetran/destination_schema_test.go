@@ -0,0 +1,50 @@
+package fivetran
+
+import "testing"
+
+func TestDestinationSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]any
+		expected string
+	}{
+		{
+			name:     "schema prefix takes precedence",
+			config:   map[string]any{"schema_prefix": "postgres", "schema": "ignored"},
+			expected: "postgres",
+		},
+		{
+			name:     "schema",
+			config:   map[string]any{"schema": "fivetran_log"},
+			expected: "fivetran_log",
+		},
+		{
+			name:     "single table connector",
+			config:   map[string]any{"schema": "sheets", "table": "budget"},
+			expected: "sheets.budget",
+		},
+		{
+			name:     "table group connector",
+			config:   map[string]any{"schema": "s3", "table_group_name": "events"},
+			expected: "s3.events",
+		},
+		{
+			name:     "no schema",
+			config:   map[string]any{"table": "budget"},
+			expected: "",
+		},
+		{
+			name:     "non-string values are ignored",
+			config:   map[string]any{"schema": "s3", "table_group_name": 1},
+			expected: "s3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DestinationSchema(tt.config); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
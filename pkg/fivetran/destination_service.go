@@ -0,0 +1,150 @@
+package fivetran
+
+import (
+	"context"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/go-fivetran/destinations"
+)
+
+type destinationServiceImpl struct {
+	client *fivetran.Client
+	retry  RetryConfig
+}
+
+func newDestinationService(client *fivetran.Client, retry RetryConfig) DestinationsService {
+	return &destinationServiceImpl{client: client, retry: retry}
+}
+
+// Destination represents a Fivetran destination configuration
+type Destination struct {
+	GroupID                   string          `json:"group_id"`
+	Service                   string          `json:"service"`
+	Region                    string          `json:"region,omitempty"`
+	TimeZoneOffset            string          `json:"time_zone_offset,omitempty"`
+	Config                    *map[string]any `json:"config"`
+	TrustCertificates         *bool           `json:"trust_certificates"`
+	TrustFingerprints         *bool           `json:"trust_fingerprints"`
+	DaylightSavingTimeEnabled *bool           `json:"daylight_saving_time_enabled"`
+	NetworkingMethod          string          `json:"networking_method,omitempty"`
+	PrivateLinkID             string          `json:"private_link_id,omitempty"`
+	HybridDeploymentAgentID   string          `json:"hybrid_deployment_agent_id,omitempty"`
+}
+
+// CreateDestination creates a new Fivetran destination for a group
+func (s *destinationServiceImpl) CreateDestination(ctx context.Context, destination *Destination) (destinations.DestinationDetailsWithSetupTestsCustomResponse, error) {
+	service := s.client.NewDestinationCreate().
+		GroupID(destination.GroupID).
+		Service(destination.Service).
+		RunSetupTests(false)
+
+	if destination.Region != "" {
+		service = service.Region(destination.Region)
+	}
+
+	if destination.TimeZoneOffset != "" {
+		service = service.TimeZoneOffset(destination.TimeZoneOffset)
+	}
+
+	if destination.Config != nil {
+		service = service.ConfigCustom(destination.Config)
+	}
+
+	if destination.TrustCertificates != nil {
+		service = service.TrustCertificates(*destination.TrustCertificates)
+	}
+
+	if destination.TrustFingerprints != nil {
+		service = service.TrustFingerprints(*destination.TrustFingerprints)
+	}
+
+	if destination.DaylightSavingTimeEnabled != nil {
+		service = service.DaylightSavingTimeEnabled(*destination.DaylightSavingTimeEnabled)
+	}
+
+	if destination.NetworkingMethod != "" {
+		service = service.NetworkingMethod(destination.NetworkingMethod)
+	}
+
+	if destination.PrivateLinkID != "" {
+		service = service.PrivateLinkId(destination.PrivateLinkID)
+	}
+
+	if destination.HybridDeploymentAgentID != "" {
+		service = service.HybridDeploymentAgentId(destination.HybridDeploymentAgentID)
+	}
+
+	resp, err := callAPI(ctx, operationCreateDestination, service.DoCustom)
+	return resp, scrubDestinationError(err, destination)
+}
+
+// GetDestination retrieves a Fivetran destination by ID
+func (s *destinationServiceImpl) GetDestination(ctx context.Context, destinationID string) (destinations.DestinationDetailsCustomResponse, error) {
+	service := s.client.NewDestinationDetails().DestinationID(destinationID)
+	return callWithRetry(ctx, s.retry, func() (destinations.DestinationDetailsCustomResponse, error) {
+		return callAPI(ctx, operationGetDestination, service.DoCustom)
+	})
+}
+
+// UpdateDestination updates an existing Fivetran destination
+func (s *destinationServiceImpl) UpdateDestination(ctx context.Context, destinationID string, destination *Destination) (destinations.DestinationDetailsWithSetupTestsCustomResponse, error) {
+	service := s.client.NewDestinationUpdate().DestinationID(destinationID).RunSetupTests(false)
+
+	if destination.Region != "" {
+		service = service.Region(destination.Region)
+	}
+
+	if destination.TimeZoneOffset != "" {
+		service = service.TimeZoneOffset(destination.TimeZoneOffset)
+	}
+
+	if destination.Config != nil {
+		service = service.ConfigCustom(destination.Config)
+	}
+
+	if destination.TrustCertificates != nil {
+		service = service.TrustCertificates(*destination.TrustCertificates)
+	}
+
+	if destination.TrustFingerprints != nil {
+		service = service.TrustFingerprints(*destination.TrustFingerprints)
+	}
+
+	if destination.DaylightSavingTimeEnabled != nil {
+		service = service.DaylightSavingTimeEnabled(*destination.DaylightSavingTimeEnabled)
+	}
+
+	if destination.NetworkingMethod != "" {
+		service = service.NetworkingMethod(destination.NetworkingMethod)
+	}
+
+	if destination.PrivateLinkID != "" {
+		service = service.PrivateLinkId(destination.PrivateLinkID)
+	}
+
+	if destination.HybridDeploymentAgentID != "" {
+		service = service.HybridDeploymentAgentId(destination.HybridDeploymentAgentID)
+	}
+
+	return callWithRetry(ctx, s.retry, func() (destinations.DestinationDetailsWithSetupTestsCustomResponse, error) {
+		resp, err := callAPI(ctx, operationUpdateDestination, service.DoCustom)
+		return resp, scrubDestinationError(err, destination)
+	})
+}
+
+// DeleteDestination deletes a Fivetran destination
+func (s *destinationServiceImpl) DeleteDestination(ctx context.Context, destinationID string) (common.CommonResponse, error) {
+	service := s.client.NewDestinationDelete().DestinationID(destinationID)
+	return callAPI(ctx, operationDeleteDestination, service.Do)
+}
+
+// RunDestinationSetupTests runs setup tests for a destination. The trust settings are always sent,
+// so a destination's certificates and fingerprints are only trusted when the caller asks for it.
+func (s *destinationServiceImpl) RunDestinationSetupTests(ctx context.Context, destinationID string, trustCertificates, trustFingerprints bool) (destinations.DestinationDetailsWithSetupTestsResponse, error) {
+	service := s.client.NewDestinationSetupTests().
+		DestinationID(destinationID).
+		TrustCertificates(trustCertificates).
+		TrustFingerprints(trustFingerprints)
+	return callAPI(ctx, operationRunDestinationSetupTests, service.Do)
+}
@@ -0,0 +1,80 @@
+package fivetran
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCreateDestination(t *testing.T) {
+	var gotBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":"InvalidInput","message":"Cannot connect with password plaintext-password"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	config := map[string]any{"host": "warehouse.example.com", "password": "plaintext-password"}
+	_, err = client.Destinations.CreateDestination(context.Background(), &Destination{
+		GroupID: "group_id",
+		Service: "snowflake",
+		Region:  "GCP_US_EAST4",
+		Config:  &config,
+	})
+
+	if gotBody["group_id"] != "group_id" || gotBody["service"] != "snowflake" || gotBody["run_setup_tests"] != false {
+		t.Errorf("expected group, service and disabled setup tests in request, got %v", gotBody)
+	}
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != "InvalidInput" {
+		t.Errorf("expected 400 InvalidInput, got %d %s", apiErr.StatusCode, apiErr.Code)
+	}
+	if strings.Contains(apiErr.Error(), "plaintext-password") {
+		t.Errorf("expected the password to be masked, got %q", apiErr.Error())
+	}
+}
+
+func TestRunDestinationSetupTests(t *testing.T) {
+	var gotBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/destinations/destination_id/test" {
+			t.Errorf("expected POST /destinations/destination_id/test, got %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"Success","data":{"id":"destination_id","setup_tests":[{"title":"Host Connection","status":"FAILED","message":"Connection refused"}]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	resp, err := client.Destinations.RunDestinationSetupTests(context.Background(), "destination_id", true, false)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+
+	if gotBody["trust_certificates"] != true || gotBody["trust_fingerprints"] != false {
+		t.Errorf("expected certificates trusted and fingerprints not trusted, got %v", gotBody)
+	}
+	if len(resp.Data.SetupTests) != 1 || resp.Data.SetupTests[0].Status != "FAILED" {
+		t.Errorf("expected the failed setup test, got %+v", resp.Data.SetupTests)
+	}
+}
@@ -5,8 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/fivetran/go-fivetran/common"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
 )
 
 // APIError represents a Fivetran API error with status code and details
@@ -15,10 +20,50 @@ type APIError struct {
 	Code       string // From CommonResponse.Code
 	Message    string // From CommonResponse.Message
 	RawError   string // Original error string
+	// FieldErrors are the field-level validation errors listed in the response body
+	FieldErrors []FieldError
+	// RetryAfter is how long Fivetran asked clients to wait before retrying a rate-limited
+	// request, from the Retry-After header; zero when not given
+	RetryAfter time.Duration
+	// RequestID is the ID Fivetran assigned to the failed request, for reference in support
+	// tickets; empty when the response did not carry one
+	RequestID string
+
+	// err is the error returned by the SDK
+	err error
+}
+
+// FieldError is a validation error Fivetran reported for a single field of a request
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) String() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
 }
 
 func (e *APIError) Error() string {
-	return fmt.Sprintf("fivetran api error (status %d): %s - %s", e.StatusCode, e.Code, e.Message)
+	msg := fmt.Sprintf("fivetran api error (status %d): %s - %s", e.StatusCode, e.Code, e.Message)
+	if len(e.FieldErrors) > 0 {
+		details := make([]string, 0, len(e.FieldErrors))
+		for _, fieldErr := range e.FieldErrors {
+			details = append(details, fieldErr.String())
+		}
+		msg += " [" + strings.Join(details, "; ") + "]"
+	}
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request id: %s)", e.RequestID)
+	}
+	return msg
+}
+
+// Unwrap returns the error returned by the SDK, such as ErrCircuitOpen
+func (e *APIError) Unwrap() error {
+	return e.err
 }
 
 // IsRetryable determines if a Fivetran error should be retried
@@ -61,11 +106,22 @@ func AsAPIError(err error) (*APIError, bool) {
 // The Fivetran Go SDK only returns basic error information in the format "status code: %d; expected: %d"
 // To get the actual error message and code, we need to extract detailed error information from the response's CommonResponse
 func WrapFivetranError(response any, err error) error {
+	return wrapFivetranResponseError(response, recordedResponse{}, err)
+}
+
+// requestIDHeaders are the response headers that may carry the ID of a request, in order of preference
+var requestIDHeaders = []string{"X-Request-Id", "X-Fivetran-Request-Id", "X-Correlation-Id"}
+
+// wrapFivetranResponseError behaves like WrapFivetranError and additionally reads the recorded
+// response: its status code when the SDK did not report one, the request ID, the Retry-After header
+// of rate-limited responses, and the code, message and field errors of its body
+func wrapFivetranResponseError(response any, recorded recordedResponse, err error) error {
 	if err == nil {
 		return nil
 	}
 
 	apiErr := &APIError{
+		err:        err,
 		RawError:   err.Error(),
 		StatusCode: 0,
 		Code:       "",
@@ -76,6 +132,9 @@ func WrapFivetranError(response any, err error) error {
 	var code, expected int
 	if _, scanErr := fmt.Sscanf(err.Error(), "status code: %d; expected: %d", &code, &expected); scanErr == nil {
 		apiErr.StatusCode = code
+	} else if recorded.statusCode >= http.StatusBadRequest {
+		// The SDK reports decoding errors, such as for an HTML gateway error page, without a status
+		apiErr.StatusCode = recorded.statusCode
 	}
 
 	// Try to extract error details from the response if it contains CommonResponse
@@ -86,6 +145,184 @@ func WrapFivetranError(response any, err error) error {
 		}
 	}
 
+	// The body carries what the SDK response drops, or lacks when the SDK could not decode it
+	if body, ok := parseErrorBody(recorded.body); ok {
+		if apiErr.Code == "" {
+			apiErr.Code = body.code
+		}
+		if apiErr.Message == "" {
+			apiErr.Message = body.message
+		}
+		apiErr.FieldErrors = body.fieldErrors
+	}
+
+	apiErr.RequestID = requestID(recorded.header)
+	if apiErr.StatusCode == http.StatusTooManyRequests {
+		apiErr.RetryAfter = parseRetryAfter(recorded.header.Get("Retry-After"), time.Now())
+	}
+
+	var openErr *circuitOpenError
+	if errors.As(err, &openErr) {
+		apiErr.Message = openErr.Error()
+		apiErr.RetryAfter = openErr.retryAfter
+	}
+
+	return apiErr
+}
+
+// errorBody is the code, message and field errors of a Fivetran error response body
+type errorBody struct {
+	code        string
+	message     string
+	fieldErrors []FieldError
+}
+
+// parseErrorBody parses a Fivetran error response body. Field errors are read from an "errors" or
+// "details" list, whose entries are either plain messages or objects naming the field.
+func parseErrorBody(data []byte) (errorBody, bool) {
+	var raw struct {
+		Code    string            `json:"code"`
+		Message string            `json:"message"`
+		Errors  []json.RawMessage `json:"errors"`
+		Details []json.RawMessage `json:"details"`
+	}
+	if len(data) == 0 || json.Unmarshal(data, &raw) != nil {
+		return errorBody{}, false
+	}
+
+	body := errorBody{code: raw.Code, message: raw.Message}
+	for _, entry := range append(raw.Errors, raw.Details...) {
+		if fieldErr, ok := parseFieldError(entry); ok {
+			body.fieldErrors = append(body.fieldErrors, fieldErr)
+		}
+	}
+	return body, true
+}
+
+// parseFieldError parses an entry of an error body's field error list
+func parseFieldError(entry json.RawMessage) (FieldError, bool) {
+	var message string
+	if err := json.Unmarshal(entry, &message); err == nil {
+		return FieldError{Message: message}, message != ""
+	}
+
+	var raw struct {
+		Field   string `json:"field"`
+		Name    string `json:"name"`
+		Path    string `json:"path"`
+		Message string `json:"message"`
+		Reason  string `json:"reason"`
+	}
+	if err := json.Unmarshal(entry, &raw); err != nil {
+		return FieldError{}, false
+	}
+	fieldErr := FieldError{
+		Field:   firstNonEmpty(raw.Field, raw.Name, raw.Path),
+		Message: firstNonEmpty(raw.Message, raw.Reason),
+	}
+	return fieldErr, fieldErr.Message != ""
+}
+
+// firstNonEmpty returns the first of values that is not empty
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// requestID returns the request ID carried by the response headers, if any
+func requestID(header http.Header) string {
+	for _, name := range requestIDHeaders {
+		if id := header.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date, returning zero
+// when it is missing or invalid
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+// RetryAfter returns how long Fivetran asked clients to wait before retrying the request that
+// failed with err, or zero when it did not say
+func RetryAfter(err error) time.Duration {
+	if apiErr, ok := AsAPIError(err); ok {
+		return apiErr.RetryAfter
+	}
+	return 0
+}
+
+// scrubConnectorError masks submitted credentials in an APIError returned for a connector request.
+// Fivetran sometimes echoes submitted config in error messages, which would otherwise be written
+// into status conditions. Every auth value is masked, along with config values of sensitive keys
+// and any sensitive field echoed in the message.
+func scrubConnectorError(err error, connector *Connector) error {
+	if connector == nil {
+		return scrubSubmittedError(err, nil, nil)
+	}
+	return scrubSubmittedError(err, connector.Config, connector.Auth)
+}
+
+// scrubDestinationError masks submitted credentials in an APIError returned for a destination
+// request, like scrubConnectorError
+func scrubDestinationError(err error, destination *Destination) error {
+	if destination == nil {
+		return scrubSubmittedError(err, nil, nil)
+	}
+	return scrubSubmittedError(err, destination.Config, nil)
+}
+
+// scrubWebhookError masks the submitted signing secret in an APIError returned for a webhook request
+func scrubWebhookError(err error, webhook *Webhook) error {
+	if webhook == nil || webhook.Secret == "" {
+		return scrubSubmittedError(err, nil, nil)
+	}
+	secret := map[string]any{"secret": webhook.Secret}
+	return scrubSubmittedError(err, nil, &secret)
+}
+
+// scrubSubmittedError masks the submitted auth values, config values of sensitive keys and any
+// sensitive field echoed in an APIError
+func scrubSubmittedError(err error, config, auth *map[string]any) error {
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return err
+	}
+
+	redactor := redact.New()
+	if config != nil {
+		redactor.AddConfig(*config)
+	}
+	if auth != nil {
+		redactor.AddAll(*auth)
+	}
+	scrub := func(text string) string {
+		return redact.MaskSensitiveFields(redactor.Redact(text))
+	}
+
+	apiErr.Message = scrub(apiErr.Message)
+	apiErr.RawError = scrub(apiErr.RawError)
+	for i := range apiErr.FieldErrors {
+		apiErr.FieldErrors[i].Message = scrub(apiErr.FieldErrors[i].Message)
+	}
 	return apiErr
 }
 
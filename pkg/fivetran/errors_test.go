@@ -0,0 +1,220 @@
+package fivetran
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScrubConnectorError(t *testing.T) {
+	config := map[string]any{
+		"host":     "db.example.com",
+		"password": "plaintext-password",
+	}
+	auth := map[string]any{
+		"client_access": map[string]any{"client_id": "oauth-client-id"},
+	}
+	connector := &Connector{Config: &config, Auth: &auth}
+
+	err := scrubConnectorError(&APIError{
+		StatusCode: 400,
+		Code:       "InvalidInput",
+		Message:    `Invalid config {"host":"db.example.com","password":"plaintext-password","client_id":"oauth-client-id","token":"unknown-token"}`,
+		RawError:   "status code: 400; expected: 201",
+		FieldErrors: []FieldError{
+			{Field: "config.password", Message: "password plaintext-password is too short"},
+		},
+	}, connector)
+
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	for _, secret := range []string{"plaintext-password", "oauth-client-id", "unknown-token"} {
+		if strings.Contains(apiErr.Error(), secret) {
+			t.Errorf("expected %q to be masked, got %q", secret, apiErr.Error())
+		}
+	}
+	if !strings.Contains(apiErr.Message, "db.example.com") {
+		t.Errorf("expected non-sensitive values to be kept, got %q", apiErr.Message)
+	}
+	if apiErr.StatusCode != 400 || apiErr.Code != "InvalidInput" {
+		t.Errorf("expected status and code to be kept, got %d %s", apiErr.StatusCode, apiErr.Code)
+	}
+
+	plain := errors.New("connection refused")
+	if got := scrubConnectorError(plain, connector); got != plain {
+		t.Errorf("expected non-API errors to be returned unchanged, got %v", got)
+	}
+	if got := scrubConnectorError(nil, connector); got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{name: "missing", value: "", expected: 0},
+		{name: "seconds", value: "30", expected: 30 * time.Second},
+		{name: "negative seconds", value: "-5", expected: 0},
+		{name: "http date", value: now.Add(time.Minute).Format(http.TimeFormat), expected: time.Minute},
+		{name: "past http date", value: now.Add(-time.Minute).Format(http.TimeFormat), expected: 0},
+		{name: "invalid", value: "soon", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value, now); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRateLimitedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "42")
+		w.Header().Set("X-Request-Id", "req-123")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"code":"TooManyRequests","message":"Rate limit exceeded"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	_, err = client.Connections.GetConnection(context.Background(), "connection_id")
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusTooManyRequests || !apiErr.IsRetryable() {
+		t.Errorf("expected retryable 429 error, got status %d", apiErr.StatusCode)
+	}
+	if RetryAfter(err) != 42*time.Second {
+		t.Errorf("expected retry after 42s, got %v", RetryAfter(err))
+	}
+	if apiErr.RequestID != "req-123" || !strings.Contains(apiErr.Error(), "request id: req-123") {
+		t.Errorf("expected request id req-123 in %q", apiErr.Error())
+	}
+}
+
+func TestParseErrorBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		ok          bool
+		code        string
+		message     string
+		fieldErrors []FieldError
+	}{
+		{name: "empty", body: "", ok: false},
+		{name: "not json", body: "<html>Bad Gateway</html>", ok: false},
+		{
+			name: "code and message", body: `{"code":"NotFound_Connection","message":"Connection not found"}`,
+			ok: true, code: "NotFound_Connection", message: "Connection not found",
+		},
+		{
+			name: "field errors",
+			body: `{"code":"InvalidInput","message":"Invalid request","errors":[{"field":"config.host","message":"must not be empty"},{"name":"config.port","reason":"must be a number"}]}`,
+			ok:   true, code: "InvalidInput", message: "Invalid request",
+			fieldErrors: []FieldError{
+				{Field: "config.host", Message: "must not be empty"},
+				{Field: "config.port", Message: "must be a number"},
+			},
+		},
+		{
+			name: "plain details", body: `{"code":"InvalidInput","message":"Invalid request","details":["schema is required",""]}`,
+			ok: true, code: "InvalidInput", message: "Invalid request",
+			fieldErrors: []FieldError{{Message: "schema is required"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, ok := parseErrorBody([]byte(tt.body))
+			if ok != tt.ok {
+				t.Fatalf("expected ok %v, got %v", tt.ok, ok)
+			}
+			if body.code != tt.code || body.message != tt.message {
+				t.Errorf("expected %q - %q, got %q - %q", tt.code, tt.message, body.code, body.message)
+			}
+			if len(body.fieldErrors) != len(tt.fieldErrors) {
+				t.Fatalf("expected field errors %v, got %v", tt.fieldErrors, body.fieldErrors)
+			}
+			for i := range tt.fieldErrors {
+				if body.fieldErrors[i] != tt.fieldErrors[i] {
+					t.Errorf("expected field error %v, got %v", tt.fieldErrors[i], body.fieldErrors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestErrorResponseBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		code        string
+		contains    string
+		fieldErrors int
+	}{
+		{
+			name:   "validation errors",
+			status: http.StatusBadRequest,
+			body:   `{"code":"InvalidInput","message":"Invalid request","errors":[{"field":"config.host","message":"must not be empty"}]}`,
+			code:   "InvalidInput", contains: "[config.host: must not be empty]", fieldErrors: 1,
+		},
+		{
+			name:     "gateway error page",
+			status:   http.StatusBadGateway,
+			body:     "<html>Bad Gateway</html>",
+			contains: "status 502",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			client.sdk.BaseURL(server.URL)
+
+			_, err = client.Connections.GetConnection(context.Background(), "connection_id")
+			apiErr, ok := AsAPIError(err)
+			if !ok {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.StatusCode != tt.status || apiErr.Code != tt.code {
+				t.Errorf("expected status %d and code %q, got %d and %q", tt.status, tt.code, apiErr.StatusCode, apiErr.Code)
+			}
+			if len(apiErr.FieldErrors) != tt.fieldErrors {
+				t.Errorf("expected %d field errors, got %v", tt.fieldErrors, apiErr.FieldErrors)
+			}
+			if !strings.Contains(apiErr.Error(), tt.contains) {
+				t.Errorf("expected %q in %q", tt.contains, apiErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package fivetran
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	httputils "github.com/fivetran/go-fivetran/http_utils"
+)
+
+// FaultInjectionConfig makes the client fail or delay a share of its requests, to exercise retries,
+// rate limit handling and the circuit breaker against a real or fake API in tests. Faults are
+// injected below every other layer of the client, so they are handled like real responses.
+type FaultInjectionConfig struct {
+	// ServerErrorRate is the share of requests, from 0 to 1, failed with a 503 response without
+	// being sent
+	ServerErrorRate float64
+	// RateLimitRate is the share of requests, from 0 to 1, failed with a 429 response without
+	// being sent
+	RateLimitRate float64
+	// RetryAfter is the Retry-After of injected 429 responses; zero sends none
+	RetryAfter time.Duration
+	// PartialFailureRate is the share of requests, from 0 to 1, that are sent but whose response
+	// is replaced with a 502, as when a proxy fails after Fivetran applied the request
+	PartialFailureRate float64
+	// Latency is added to every request
+	Latency time.Duration
+	// PathPrefix limits faults to requests whose path starts with it, such as /v1/connections;
+	// empty injects them into every request
+	PathPrefix string
+}
+
+// enabled reports whether any fault is configured
+func (c FaultInjectionConfig) enabled() bool {
+	return c.ServerErrorRate > 0 || c.RateLimitRate > 0 || c.PartialFailureRate > 0 || c.Latency > 0
+}
+
+// faultInjectingClient injects the configured faults into requests
+type faultInjectingClient struct {
+	cfg  FaultInjectionConfig
+	next httputils.HttpClient
+	rand func() float64
+}
+
+// newFaultInjectingClient wraps next with fault injection, or returns next when no fault is
+// configured
+func newFaultInjectingClient(next httputils.HttpClient, cfg FaultInjectionConfig) httputils.HttpClient {
+	if !cfg.enabled() {
+		return next
+	}
+	return &faultInjectingClient{cfg: cfg, next: next, rand: rand.Float64}
+}
+
+// Do delays the request and fails it, fails its response or performs it, in the configured shares
+func (c *faultInjectingClient) Do(req *http.Request) (*http.Response, error) {
+	if !strings.HasPrefix(req.URL.Path, c.cfg.PathPrefix) {
+		return c.next.Do(req)
+	}
+
+	if c.cfg.Latency > 0 {
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(c.cfg.Latency):
+		}
+	}
+
+	roll := c.rand()
+	switch {
+	case roll < c.cfg.ServerErrorRate:
+		return injectedResponse(req, http.StatusServiceUnavailable, nil), nil
+	case roll < c.cfg.ServerErrorRate+c.cfg.RateLimitRate:
+		header := http.Header{}
+		if c.cfg.RetryAfter > 0 {
+			header.Set("Retry-After", strconv.Itoa(int(c.cfg.RetryAfter.Round(time.Second)/time.Second)))
+		}
+		return injectedResponse(req, http.StatusTooManyRequests, header), nil
+	case roll < c.cfg.ServerErrorRate+c.cfg.RateLimitRate+c.cfg.PartialFailureRate:
+		resp, err := c.next.Do(req)
+		if err != nil {
+			return resp, err
+		}
+		if resp.Body != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		return injectedResponse(req, http.StatusBadGateway, nil), nil
+	default:
+		return c.next.Do(req)
+	}
+}
+
+// injectedResponse returns a Fivetran error response with the given status
+func injectedResponse(req *http.Request, status int, header http.Header) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Content-Type", "application/json")
+	body := fmt.Sprintf(`{"code":"InjectedFault","message":"injected %d response"}`, status)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,55 @@
+package fivetran
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestFaultInjectingClient(t *testing.T) {
+	next := &statusClient{statuses: []int{200, 200, 200}}
+	client := newFaultInjectingClient(next, FaultInjectionConfig{
+		ServerErrorRate:    0.1,
+		RateLimitRate:      0.1,
+		RetryAfter:         30 * time.Second,
+		PartialFailureRate: 0.1,
+		PathPrefix:         "/v1/connections",
+	}).(*faultInjectingClient)
+	rolls := []float64{0.05, 0.15, 0.25, 0.35}
+	client.rand = func() float64 {
+		roll := rolls[0]
+		rolls = rolls[1:]
+		return roll
+	}
+
+	do := func(path string) *http.Response {
+		req, _ := http.NewRequest(http.MethodGet, "https://api.fivetran.com"+path, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("expected no error but got: %v", err)
+		}
+		return resp
+	}
+
+	if resp := do("/v1/connections/connection_id"); resp.StatusCode != http.StatusServiceUnavailable || next.requests != 0 {
+		t.Errorf("expected an injected 503 without a request, got %d after %d requests", resp.StatusCode, next.requests)
+	}
+	if resp := do("/v1/connections/connection_id"); resp.StatusCode != http.StatusTooManyRequests || resp.Header.Get("Retry-After") != "30" {
+		t.Errorf("expected an injected 429 with Retry-After 30, got %d %q", resp.StatusCode, resp.Header.Get("Retry-After"))
+	}
+	if resp := do("/v1/connections/connection_id"); resp.StatusCode != http.StatusBadGateway || next.requests != 1 {
+		t.Errorf("expected an injected 502 after the request was sent, got %d after %d requests", resp.StatusCode, next.requests)
+	}
+	if resp := do("/v1/connections/connection_id"); resp.StatusCode != http.StatusOK {
+		t.Errorf("expected the response, got %d", resp.StatusCode)
+	}
+
+	// Requests outside the prefix are never faulted
+	if resp := do("/v1/groups"); resp.StatusCode != http.StatusOK || len(rolls) != 0 {
+		t.Errorf("expected the groups request passed through, got %d", resp.StatusCode)
+	}
+
+	if newFaultInjectingClient(next, FaultInjectionConfig{}) != next {
+		t.Errorf("expected no fault injection without faults")
+	}
+}
@@ -0,0 +1,80 @@
+package fivetran
+
+import (
+	"context"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/go-fivetran/fingerprints"
+)
+
+type fingerprintServiceImpl struct {
+	client *fivetran.Client
+	retry  RetryConfig
+}
+
+func newFingerprintService(client *fivetran.Client, retry RetryConfig) FingerprintsService {
+	return &fingerprintServiceImpl{client: client, retry: retry}
+}
+
+// ListConnectionFingerprints retrieves every SSH host key fingerprint approved for a connection
+func (s *fingerprintServiceImpl) ListConnectionFingerprints(ctx context.Context, connectionID string) ([]fingerprints.FingerprintDetails, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]fingerprints.FingerprintDetails, string, error) {
+		service := s.client.NewConnectionFingerprintsList().ConnectionID(connectionID).Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (fingerprints.FingerprintsListResponse, error) {
+			return callAPI(ctx, operationListConnectionFingerprints, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// ApproveConnectionFingerprint trusts the SSH host key with the given fingerprint hash and public
+// key for a connection
+func (s *fingerprintServiceImpl) ApproveConnectionFingerprint(ctx context.Context, connectionID, hash, publicKey string) (fingerprints.FingerprintResponse, error) {
+	service := s.client.NewCertificateConnectionFingerprintApprove().
+		ConnectionID(connectionID).
+		Hash(hash).
+		PublicKey(publicKey)
+	return callAPI(ctx, operationApproveConnectionFingerprint, service.Do)
+}
+
+// RevokeConnectionFingerprint stops trusting the SSH host key with the given fingerprint hash for
+// a connection
+func (s *fingerprintServiceImpl) RevokeConnectionFingerprint(ctx context.Context, connectionID, hash string) (common.CommonResponse, error) {
+	service := s.client.NewConnectionFingerprintRevoke().ConnectionID(connectionID).Hash(hash)
+	return callAPI(ctx, operationRevokeConnectionFingerprint, service.Do)
+}
+
+// ListDestinationFingerprints retrieves every SSH host key fingerprint approved for a destination
+func (s *fingerprintServiceImpl) ListDestinationFingerprints(ctx context.Context, destinationID string) ([]fingerprints.FingerprintDetails, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]fingerprints.FingerprintDetails, string, error) {
+		service := s.client.NewDestinationFingerprintsList().DestinationID(destinationID).Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (fingerprints.FingerprintsListResponse, error) {
+			return callAPI(ctx, operationListDestinationFingerprints, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// ApproveDestinationFingerprint trusts the SSH host key with the given fingerprint hash and public
+// key for a destination
+func (s *fingerprintServiceImpl) ApproveDestinationFingerprint(ctx context.Context, destinationID, hash, publicKey string) (fingerprints.FingerprintResponse, error) {
+	service := s.client.NewCertificateDestinationFingerprintApprove().
+		DestinationID(destinationID).
+		Hash(hash).
+		PublicKey(publicKey)
+	return callAPI(ctx, operationApproveDestinationFingerprint, service.Do)
+}
+
+// RevokeDestinationFingerprint stops trusting the SSH host key with the given fingerprint hash for
+// a destination
+func (s *fingerprintServiceImpl) RevokeDestinationFingerprint(ctx context.Context, destinationID, hash string) (common.CommonResponse, error) {
+	service := s.client.NewDestinationFingerprintRevoke().DestinationID(destinationID).Hash(hash)
+	return callAPI(ctx, operationRevokeDestinationFingerprint, service.Do)
+}
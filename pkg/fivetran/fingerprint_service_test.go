@@ -0,0 +1,42 @@
+package fivetran
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestApproveConnectionFingerprint(t *testing.T) {
+	var gotMethod, gotPath string
+	var gotBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"code":"Success","data":{"hash":"fingerprint_hash","public_key":"ssh-rsa AAAA"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	resp, err := client.Fingerprints.ApproveConnectionFingerprint(context.Background(), "connection_id", "fingerprint_hash", "ssh-rsa AAAA")
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if gotMethod != http.MethodPost || gotPath != "/connections/connection_id/fingerprints" {
+		t.Errorf("expected POST /connections/connection_id/fingerprints, got %s %s", gotMethod, gotPath)
+	}
+	if gotBody["hash"] != "fingerprint_hash" || gotBody["public_key"] != "ssh-rsa AAAA" {
+		t.Errorf("expected hash and public key in request, got %v", gotBody)
+	}
+	if resp.Data.Hash != "fingerprint_hash" {
+		t.Errorf("expected approved fingerprint_hash, got %+v", resp.Data)
+	}
+}
@@ -0,0 +1,270 @@
+package fivetranfake
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+
+	"github.com/fivetran/go-fivetran/common"
+	connectcard "github.com/fivetran/go-fivetran/connect_card"
+	"github.com/fivetran/go-fivetran/connections"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+// Connections is an in-memory fivetran.ConnectorService
+type Connections struct {
+	recorder
+
+	// SetupTests are the results returned by RunSetupTests
+	SetupTests []common.SetupTestResponse
+
+	mu          sync.Mutex
+	nextID      int
+	connections map[string]*connection
+}
+
+var _ fivetran.ConnectorService = &Connections{}
+
+// connection is a stored connection and its state
+type connection struct {
+	connector fivetran.Connector
+	state     map[string]any
+}
+
+// NewConnections returns a fake without connections
+func NewConnections() *Connections {
+	return &Connections{connections: make(map[string]*connection)}
+}
+
+// Add stores a connection under id, such as one to be adopted
+func (c *Connections) Add(id string, connector fivetran.Connector) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connections[id] = &connection{connector: copyConnector(connector)}
+}
+
+// Get returns the stored connection with id
+func (c *Connections) Get(id string) (fivetran.Connector, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stored, ok := c.connections[id]
+	if !ok {
+		return fivetran.Connector{}, false
+	}
+	return copyConnector(stored.connector), true
+}
+
+// CreateConnection stores the connection under a new ID
+func (c *Connections) CreateConnection(ctx context.Context, connector *fivetran.Connector) (connections.DetailsWithCustomConfigResponse, error) {
+	var resp connections.DetailsWithCustomConfigResponse
+	if err := c.record("CreateConnection", copyConnector(*connector)); err != nil {
+		return resp, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextID++
+	id := fmt.Sprintf("connection_%d", c.nextID)
+	c.connections[id] = &connection{connector: copyConnector(*connector)}
+
+	resp.Code = "Success"
+	resp.Data.DetailsResponseDataCommon = details(id, *connector)
+	resp.Data.Config = configOf(*connector)
+	return resp, nil
+}
+
+// GetConnection returns the stored connection
+func (c *Connections) GetConnection(ctx context.Context, connectionID string) (connections.DetailsWithCustomConfigNoTestsResponse, error) {
+	var resp connections.DetailsWithCustomConfigNoTestsResponse
+	if err := c.record("GetConnection", connectionID); err != nil {
+		return resp, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stored, ok := c.connections[connectionID]
+	if !ok {
+		return resp, connectionNotFound(connectionID)
+	}
+
+	resp.Code = "Success"
+	resp.Data.DetailsResponseDataCommon = details(connectionID, stored.connector)
+	resp.Data.Config = configOf(stored.connector)
+	return resp, nil
+}
+
+// UpdateConnection replaces the stored connection, keeping its group and service
+func (c *Connections) UpdateConnection(ctx context.Context, connectionID string, connector *fivetran.Connector) (connections.DetailsWithCustomConfigResponse, error) {
+	var resp connections.DetailsWithCustomConfigResponse
+	if err := c.record("UpdateConnection", connectionID, copyConnector(*connector)); err != nil {
+		return resp, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stored, ok := c.connections[connectionID]
+	if !ok {
+		return resp, connectionNotFound(connectionID)
+	}
+	updated := copyConnector(*connector)
+	updated.GroupID, updated.Service = stored.connector.GroupID, stored.connector.Service
+	stored.connector = updated
+
+	resp.Code = "Success"
+	resp.Data.DetailsResponseDataCommon = details(connectionID, updated)
+	resp.Data.Config = configOf(updated)
+	return resp, nil
+}
+
+// DeleteConnection removes the stored connection
+func (c *Connections) DeleteConnection(ctx context.Context, connectionID string) (common.CommonResponse, error) {
+	if err := c.record("DeleteConnection", connectionID); err != nil {
+		return common.CommonResponse{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.connections[connectionID]; !ok {
+		return common.CommonResponse{}, connectionNotFound(connectionID)
+	}
+	delete(c.connections, connectionID)
+	return common.CommonResponse{Code: "Success"}, nil
+}
+
+// RunSetupTests returns SetupTests for a stored connection
+func (c *Connections) RunSetupTests(ctx context.Context, connectionID string, trustCertificates, trustFingerprints *bool) (connections.DetailsWithConfigResponse, error) {
+	var resp connections.DetailsWithConfigResponse
+	if err := c.record("RunSetupTests", connectionID, trustCertificates, trustFingerprints); err != nil {
+		return resp, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stored, ok := c.connections[connectionID]
+	if !ok {
+		return resp, connectionNotFound(connectionID)
+	}
+
+	resp.Code = "Success"
+	resp.Data.DetailsResponseDataCommon = details(connectionID, stored.connector)
+	resp.Data.SetupTests = append([]common.SetupTestResponse(nil), c.SetupTests...)
+	return resp, nil
+}
+
+// GetConnectionState returns the stored state of a connection
+func (c *Connections) GetConnectionState(ctx context.Context, connectionID string) (fivetran.ConnectionStateResponse, error) {
+	var resp fivetran.ConnectionStateResponse
+	if err := c.record("GetConnectionState", connectionID); err != nil {
+		return resp, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stored, ok := c.connections[connectionID]
+	if !ok {
+		return resp, connectionNotFound(connectionID)
+	}
+
+	resp.Code = "Success"
+	resp.Data.State = maps.Clone(stored.state)
+	return resp, nil
+}
+
+// UpdateConnectionState replaces the stored state of a connection
+func (c *Connections) UpdateConnectionState(ctx context.Context, connectionID string, state map[string]any) (fivetran.ConnectionStateResponse, error) {
+	var resp fivetran.ConnectionStateResponse
+	if err := c.record("UpdateConnectionState", connectionID, maps.Clone(state)); err != nil {
+		return resp, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stored, ok := c.connections[connectionID]
+	if !ok {
+		return resp, connectionNotFound(connectionID)
+	}
+	stored.state = maps.Clone(state)
+
+	resp.Code = "Success"
+	resp.Data.State = maps.Clone(state)
+	return resp, nil
+}
+
+// CreateConnectCard returns a Connect Card URI for a stored connection
+func (c *Connections) CreateConnectCard(ctx context.Context, connectionID, redirectURI string, hideSetupGuide bool) (connectcard.ConnectCardResponse, error) {
+	var resp connectcard.ConnectCardResponse
+	if err := c.record("CreateConnectCard", connectionID, redirectURI, hideSetupGuide); err != nil {
+		return resp, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.connections[connectionID]; !ok {
+		return resp, connectionNotFound(connectionID)
+	}
+
+	token := fmt.Sprintf("%s-token", connectionID)
+	resp.Code = "Success"
+	resp.Data.ConnectorId = connectionID
+	resp.Data.ConnectCard.Token = token
+	resp.Data.ConnectCard.Uri = "https://fivetran.com/connect-card/setup?auth=" + token
+	resp.Data.ConnectCardConfig.RedirectUri = redirectURI
+	resp.Data.ConnectCardConfig.HideSetupGuide = hideSetupGuide
+	return resp, nil
+}
+
+// connectionNotFound returns the error for a connection that does not exist
+func connectionNotFound(connectionID string) error {
+	return notFound("NotFound_Connection", fmt.Sprintf("Connection with id '%s' doesn't exist", connectionID))
+}
+
+// details returns the response details of a connection
+func details(id string, connector fivetran.Connector) connections.DetailsResponseDataCommon {
+	data := connections.DetailsResponseDataCommon{
+		ID:                      id,
+		GroupID:                 connector.GroupID,
+		Service:                 connector.Service,
+		ScheduleType:            connector.ScheduleType,
+		Paused:                  connector.Paused,
+		PauseAfterTrial:         connector.PauseAfterTrial,
+		DailySyncTime:           connector.DailySyncTime,
+		PrivateLinkId:           connector.PrivateLinkID,
+		HybridDeploymentAgentId: connector.HybridDeploymentAgentID,
+		ProxyAgentId:            connector.ProxyAgentID,
+		NetworkingMethod:        connector.NetworkingMethod,
+		DataDelaySensitivity:    connector.DataDelaySensitivity,
+	}
+	if connector.SyncFrequency != 0 {
+		data.SyncFrequency = &connector.SyncFrequency
+	}
+	if connector.DataDelayThreshold != 0 {
+		data.DataDelayThreshold = &connector.DataDelayThreshold
+	}
+	if schema, ok := configOf(connector)["schema"].(string); ok {
+		data.Schema = schema
+	}
+	return data
+}
+
+// configOf returns a copy of the config of a connection
+func configOf(connector fivetran.Connector) map[string]any {
+	if connector.Config == nil {
+		return nil
+	}
+	return maps.Clone(*connector.Config)
+}
+
+// copyConnector returns a copy of connector whose config and auth can be changed independently
+func copyConnector(connector fivetran.Connector) fivetran.Connector {
+	if connector.Config != nil {
+		config := maps.Clone(*connector.Config)
+		connector.Config = &config
+	}
+	if connector.Auth != nil {
+		auth := maps.Clone(*connector.Auth)
+		connector.Auth = &auth
+	}
+	return connector
+}
@@ -0,0 +1,91 @@
+// Package fivetranfake provides in-memory fakes of the Fivetran client services, for testing
+// reconcilers without stubbing the service interfaces in every test. The fakes keep the resources
+// they are given, record every call, and can be told to fail calls to any method.
+package fivetranfake
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+// NewClient returns a Fivetran client whose Connections and Schemas are the given fakes. Its other
+// services are nil.
+func NewClient(connections *Connections, schemas *Schemas) *fivetran.Client {
+	return &fivetran.Client{Connections: connections, Schemas: schemas}
+}
+
+// Call is a call made to a fake, with the arguments following its context
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// recorder records the calls made to a fake and the errors configured for its methods
+type recorder struct {
+	mu       sync.Mutex
+	calls    []Call
+	failures map[string]error
+}
+
+// record records a call to method and returns the error configured for it, if any
+func (r *recorder) record(method string, args ...any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+	return r.failures[method]
+}
+
+// Calls returns the calls made, in order
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made to method, in order
+func (r *recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// FailWith makes every further call to method return err without taking effect; a nil err makes
+// the calls succeed again
+func (r *recorder) FailWith(method string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures == nil {
+		r.failures = make(map[string]error)
+	}
+	if err == nil {
+		delete(r.failures, method)
+		return
+	}
+	r.failures[method] = err
+}
+
+// Reset forgets the recorded calls
+func (r *recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+// APIError returns the error the client returns for a Fivetran response with the given status,
+// code and message
+func APIError(statusCode int, code, message string) *fivetran.APIError {
+	return &fivetran.APIError{StatusCode: statusCode, Code: code, Message: message}
+}
+
+// notFound returns the error for a resource that does not exist
+func notFound(code, message string) *fivetran.APIError {
+	return APIError(http.StatusNotFound, code, message)
+}
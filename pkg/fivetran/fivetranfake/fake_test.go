@@ -0,0 +1,100 @@
+package fivetranfake
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+func TestConnections(t *testing.T) {
+	ctx := context.Background()
+	fake := NewConnections()
+	client := NewClient(fake, NewSchemas())
+
+	paused := true
+	created, err := client.Connections.CreateConnection(ctx, &fivetran.Connector{
+		Service: "postgres",
+		GroupID: "group_id",
+		Paused:  &paused,
+		Config:  &map[string]any{"schema": "sales"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	id := created.Data.ID
+
+	_, err = client.Connections.UpdateConnection(ctx, id, &fivetran.Connector{Config: &map[string]any{"schema": "sales", "host": "db"}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	got, err := client.Connections.GetConnection(ctx, id)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if got.Data.Service != "postgres" || got.Data.Schema != "sales" || got.Data.Config["host"] != "db" {
+		t.Errorf("expected the updated postgres connection, got %+v", got.Data)
+	}
+
+	fake.FailWith("DeleteConnection", APIError(500, "InternalError", "boom"))
+	if _, err := client.Connections.DeleteConnection(ctx, id); !fivetran.IsRetryableError(err) {
+		t.Errorf("expected the configured server error, got %v", err)
+	}
+	if _, ok := fake.Get(id); !ok {
+		t.Errorf("expected the failed delete to keep the connection")
+	}
+	fake.FailWith("DeleteConnection", nil)
+	if _, err := client.Connections.DeleteConnection(ctx, id); err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+
+	_, err = client.Connections.GetConnection(ctx, id)
+	if apiErr, ok := fivetran.AsAPIError(err); !ok || apiErr.StatusCode != 404 {
+		t.Errorf("expected not found for the deleted connection, got %v", err)
+	}
+	if calls := fake.CallsTo("DeleteConnection"); len(calls) != 2 || calls[1].Args[0] != id {
+		t.Errorf("expected 2 deletes of %s, got %+v", id, calls)
+	}
+}
+
+func TestSchemas(t *testing.T) {
+	ctx := context.Background()
+	fake := NewSchemas()
+
+	resp, err := fake.GetSchemaDetails(ctx, "connection_id")
+	if err == nil || resp.Code != "NotFound_SchemaConfig" {
+		t.Fatalf("expected NotFound_SchemaConfig before a reload, got %q: %v", resp.Code, err)
+	}
+	if _, err := fake.ReloadSchema(ctx, "connection_id", "PRESERVE"); err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+
+	builder := fivetran.NewSchemaBuilder().
+		WithSchemaChangeHandling("BLOCK_ALL").
+		AddSchema("public", true).
+		AddTable("public", "orders", true, "SOFT_DELETE")
+	if _, err := fake.UpdateSchema(ctx, "connection_id", builder); err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	builder = fivetran.NewSchemaBuilder().
+		AddSchema("public", true).
+		AddTable("public", "customers", false, "")
+	if _, err := fake.UpdateSchema(ctx, "connection_id", builder); err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+
+	resp, err = fake.GetSchemaDetails(ctx, "connection_id")
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	tables := resp.Data.Schemas["public"].Tables
+	if resp.Data.SchemaChangeHandling != "BLOCK_ALL" || len(tables) != 2 || *tables["orders"].SyncMode != "SOFT_DELETE" || *tables["customers"].Enabled {
+		t.Errorf("expected both updates merged, got %s %+v", resp.Data.SchemaChangeHandling, tables)
+	}
+
+	fake.FailWith("UpdateSchema", errors.New("boom"))
+	if _, err := fake.UpdateSchema(ctx, "connection_id", builder); err == nil {
+		t.Errorf("expected the configured error")
+	}
+}
@@ -0,0 +1,191 @@
+package fivetranfake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/fivetran/go-fivetran/connections"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+// schemaNotFoundCode is the code Fivetran returns for a connection without a schema config
+const schemaNotFoundCode = "NotFound_SchemaConfig"
+
+// Schemas is an in-memory fivetran.SchemaService. A connection has no schema config until it is
+// created or reloaded; updates are merged into it like Fivetran merges them.
+type Schemas struct {
+	recorder
+
+	mu      sync.Mutex
+	schemas map[string]*schemaConfig
+}
+
+var _ fivetran.SchemaService = &Schemas{}
+
+// schemaConfig is the stored schema config of a connection, in its JSON form so that updates can
+// be merged into it
+type schemaConfig struct {
+	schemaChangeHandling string
+	schemas              map[string]any
+}
+
+// NewSchemas returns a fake without schema configs
+func NewSchemas() *Schemas {
+	return &Schemas{schemas: make(map[string]*schemaConfig)}
+}
+
+// Set stores the schema config of a connection, such as the schemas a reload discovers
+func (s *Schemas) Set(connectionID, schemaChangeHandling string, schemas map[string]*connections.ConnectionSchemaConfigSchemaResponse) error {
+	config := &schemaConfig{schemaChangeHandling: schemaChangeHandling}
+	if err := convert(schemas, &config.schemas); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.schemas[connectionID] = config
+	return nil
+}
+
+// CreateSchema stores the schema config of a connection without one
+func (s *Schemas) CreateSchema(ctx context.Context, connectionID string, builder *fivetran.SchemaBuilder) (connections.ConnectionSchemaDetailsResponse, error) {
+	var resp connections.ConnectionSchemaDetailsResponse
+	if err := s.record("CreateSchema", connectionID, builder); err != nil {
+		return resp, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.schemas[connectionID]; ok {
+		return resp, APIError(409, "AlreadyExists", fmt.Sprintf("Schema config for connection with id '%s' already exists", connectionID))
+	}
+	config := &schemaConfig{schemas: map[string]any{}}
+	if err := config.apply(builder); err != nil {
+		return resp, err
+	}
+	s.schemas[connectionID] = config
+	return config.response()
+}
+
+// UpdateSchema merges the builder's schemas into the schema config of a connection
+func (s *Schemas) UpdateSchema(ctx context.Context, connectionID string, builder *fivetran.SchemaBuilder) (connections.ConnectionSchemaDetailsResponse, error) {
+	var resp connections.ConnectionSchemaDetailsResponse
+	if err := s.record("UpdateSchema", connectionID, builder); err != nil {
+		return resp, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	config, ok := s.schemas[connectionID]
+	if !ok {
+		return schemaNotFound(connectionID)
+	}
+	if err := config.apply(builder); err != nil {
+		return resp, err
+	}
+	return config.response()
+}
+
+// GetSchemaDetails returns the schema config of a connection, with the NotFound_SchemaConfig code
+// when it has none
+func (s *Schemas) GetSchemaDetails(ctx context.Context, connectionID string) (connections.ConnectionSchemaDetailsResponse, error) {
+	var resp connections.ConnectionSchemaDetailsResponse
+	if err := s.record("GetSchemaDetails", connectionID); err != nil {
+		return resp, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	config, ok := s.schemas[connectionID]
+	if !ok {
+		return schemaNotFound(connectionID)
+	}
+	return config.response()
+}
+
+// ReloadSchema creates an empty schema config allowing all changes for a connection without one,
+// and otherwise returns the stored one
+func (s *Schemas) ReloadSchema(ctx context.Context, connectionID string, excludeMode string) (connections.ConnectionSchemaDetailsResponse, error) {
+	var resp connections.ConnectionSchemaDetailsResponse
+	if err := s.record("ReloadSchema", connectionID, excludeMode); err != nil {
+		return resp, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	config, ok := s.schemas[connectionID]
+	if !ok {
+		config = &schemaConfig{schemaChangeHandling: "ALLOW_ALL", schemas: map[string]any{}}
+		s.schemas[connectionID] = config
+	}
+	return config.response()
+}
+
+// apply merges the schemas and schema change handling of builder into the config
+func (c *schemaConfig) apply(builder *fivetran.SchemaBuilder) error {
+	schemas, schemaChangeHandling, err := builder.Build()
+	if err != nil {
+		return fmt.Errorf("failed to build schema config: %w", err)
+	}
+
+	requests := make(map[string]*connections.ConnectionSchemaConfigSchemaRequest, len(schemas))
+	for name, schema := range schemas {
+		requests[name] = schema.Request()
+	}
+	var update map[string]any
+	if err := convert(requests, &update); err != nil {
+		return err
+	}
+
+	merge(c.schemas, update)
+	if schemaChangeHandling != "" {
+		c.schemaChangeHandling = schemaChangeHandling
+	}
+	return nil
+}
+
+// response returns the config as a Fivetran response
+func (c *schemaConfig) response() (connections.ConnectionSchemaDetailsResponse, error) {
+	var resp connections.ConnectionSchemaDetailsResponse
+	resp.Code = "Success"
+	resp.Data.SchemaChangeHandling = c.schemaChangeHandling
+	err := convert(c.schemas, &resp.Data.Schemas)
+	return resp, err
+}
+
+// schemaNotFound returns the response and error for a connection without a schema config
+func schemaNotFound(connectionID string) (connections.ConnectionSchemaDetailsResponse, error) {
+	var resp connections.ConnectionSchemaDetailsResponse
+	resp.Code = schemaNotFoundCode
+	resp.Message = fmt.Sprintf("Schema config for connection with id '%s' doesn't exist", connectionID)
+	return resp, notFound(resp.Code, resp.Message)
+}
+
+// merge recursively merges the objects of src into dst, replacing its other values. Like Fivetran,
+// it ignores null values.
+func merge(dst, src map[string]any) {
+	for key, value := range src {
+		if value == nil {
+			continue
+		}
+		srcObject, srcIsObject := value.(map[string]any)
+		dstObject, dstIsObject := dst[key].(map[string]any)
+		if srcIsObject && dstIsObject {
+			merge(dstObject, srcObject)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// convert converts in to out through its JSON form
+func convert(in, out any) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,382 @@
+package fivetranfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fivetran/go-fivetran/common"
+)
+
+// Server is an httptest server emulating the Fivetran API endpoints for connections, their schema
+// configs and setup tests, for testing the real client end to end. Faults script failures of
+// matching requests.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	nextID      int
+	connections map[string]map[string]any
+	schemas     map[string]map[string]any
+	setupTests  []common.SetupTestResponse
+	faults      []*Fault
+	requests    []Request
+}
+
+// Request is a request received by a Server
+type Request struct {
+	Method string
+	Path   string
+}
+
+// Fault makes the requests matching its method and path fail with a Fivetran error response
+type Fault struct {
+	// Method matches the request method; empty matches every method
+	Method string
+	// Path matches the request path, such as /connections/connection_1/schemas; a trailing *
+	// matches every path with the preceding prefix and empty matches every path
+	Path string
+	// StatusCode is the status of the error response
+	StatusCode int
+	// Code and Message are the Fivetran error code and message of the response
+	Code    string
+	Message string
+	// RetryAfter is sent in the Retry-After header when positive
+	RetryAfter time.Duration
+	// Delay is waited before responding, to emulate a slow API
+	Delay time.Duration
+	// Times is the number of matching requests that fail; zero fails every one
+	Times int
+}
+
+// matches reports whether the fault applies to r
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(f.Path, "*"); ok {
+		return strings.HasPrefix(r.URL.Path, prefix)
+	}
+	return f.Path == "" || f.Path == r.URL.Path
+}
+
+// NewServer starts a server without connections. Its setup tests all pass.
+func NewServer() *Server {
+	s := &Server{
+		connections: make(map[string]map[string]any),
+		schemas:     make(map[string]map[string]any),
+		setupTests:  []common.SetupTestResponse{{Title: "Connecting to host", Status: "PASSED"}},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /connections", s.createConnection)
+	mux.HandleFunc("GET /connections/{id}", s.getConnection)
+	mux.HandleFunc("PATCH /connections/{id}", s.updateConnection)
+	mux.HandleFunc("DELETE /connections/{id}", s.deleteConnection)
+	mux.HandleFunc("POST /connections/{id}/test", s.runSetupTests)
+	mux.HandleFunc("GET /connections/{id}/schemas", s.getSchema)
+	mux.HandleFunc("POST /connections/{id}/schemas", s.createSchema)
+	mux.HandleFunc("PATCH /connections/{id}/schemas", s.updateSchema)
+	mux.HandleFunc("POST /connections/{id}/schemas/reload", s.reloadSchema)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("%s %s is not emulated", r.Method, r.URL.Path))
+	})
+
+	s.Server = httptest.NewServer(s.injectFaults(mux))
+	return s
+}
+
+// Fail adds a fault, which takes precedence over the faults added before it
+func (s *Server) Fail(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append([]*Fault{&fault}, s.faults...)
+}
+
+// ClearFaults removes every fault
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// SetSetupTests sets the results of the setup tests of every connection
+func (s *Server) SetSetupTests(tests ...common.SetupTestResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setupTests = tests
+}
+
+// AddConnection stores a connection under id with the given details, such as one to be adopted
+func (s *Server) AddConnection(id string, details map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	connection := maps.Clone(details)
+	connection["id"] = id
+	s.connections[id] = connection
+}
+
+// Connection returns the details of a stored connection
+func (s *Server) Connection(id string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	connection, ok := s.connections[id]
+	return maps.Clone(connection), ok
+}
+
+// Requests returns the requests received, in order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// injectFaults records every request and fails those matching a fault
+func (s *Server) injectFaults(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path})
+		var fault *Fault
+		for i, f := range s.faults {
+			if !f.matches(r) {
+				continue
+			}
+			if f.Times > 0 {
+				f.Times--
+				if f.Times == 0 {
+					s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+				}
+			}
+			fault = f
+			break
+		}
+		s.mu.Unlock()
+
+		if fault == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if fault.Delay > 0 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(fault.Delay):
+			}
+		}
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(fault.RetryAfter.Round(time.Second)/time.Second)))
+		}
+		writeError(w, fault.StatusCode, fault.Code, fault.Message)
+	})
+}
+
+// transientConnectionFields are request fields that are not part of a connection's details
+var transientConnectionFields = []string{"auth", "run_setup_tests", "trust_certificates", "trust_fingerprints"}
+
+func (s *Server) createConnection(w http.ResponseWriter, r *http.Request) {
+	request, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	id := fmt.Sprintf("connection_%d", s.nextID)
+	connection := map[string]any{"id": id}
+	merge(connection, request)
+	for _, field := range transientConnectionFields {
+		delete(connection, field)
+	}
+	if schema, ok := nested(connection, "config")["schema"]; ok {
+		connection["schema"] = schema
+	}
+	s.connections[id] = connection
+
+	writeData(w, http.StatusCreated, s.withSetupTests(connection))
+}
+
+func (s *Server) getConnection(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	connection, ok := s.connection(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, connection)
+}
+
+func (s *Server) updateConnection(w http.ResponseWriter, r *http.Request) {
+	request, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	connection, ok := s.connection(w, r)
+	if !ok {
+		return
+	}
+	// The group and service of a connection cannot change
+	delete(request, "group_id")
+	delete(request, "service")
+	merge(connection, request)
+	for _, field := range transientConnectionFields {
+		delete(connection, field)
+	}
+
+	writeData(w, http.StatusOK, s.withSetupTests(connection))
+}
+
+func (s *Server) deleteConnection(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.connection(w, r); !ok {
+		return
+	}
+	delete(s.connections, r.PathValue("id"))
+	delete(s.schemas, r.PathValue("id"))
+	writeJSON(w, http.StatusOK, map[string]any{"code": "Success", "message": "Connection has been deleted"})
+}
+
+func (s *Server) runSetupTests(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	connection, ok := s.connection(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, s.withSetupTests(connection))
+}
+
+func (s *Server) getSchema(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.connection(w, r); !ok {
+		return
+	}
+	schema, ok := s.schemas[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, schemaNotFoundCode,
+			fmt.Sprintf("Schema config for connection with id '%s' doesn't exist", r.PathValue("id")))
+		return
+	}
+	writeData(w, http.StatusOK, schema)
+}
+
+func (s *Server) createSchema(w http.ResponseWriter, r *http.Request) {
+	request, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.connection(w, r); !ok {
+		return
+	}
+	if _, ok := s.schemas[r.PathValue("id")]; ok {
+		writeError(w, http.StatusConflict, "AlreadyExists",
+			fmt.Sprintf("Schema config for connection with id '%s' already exists", r.PathValue("id")))
+		return
+	}
+	schema := map[string]any{"schemas": map[string]any{}}
+	merge(schema, request)
+	s.schemas[r.PathValue("id")] = schema
+	writeData(w, http.StatusOK, schema)
+}
+
+func (s *Server) updateSchema(w http.ResponseWriter, r *http.Request) {
+	request, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.connection(w, r); !ok {
+		return
+	}
+	schema, ok := s.schemas[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, schemaNotFoundCode,
+			fmt.Sprintf("Schema config for connection with id '%s' doesn't exist", r.PathValue("id")))
+		return
+	}
+	merge(schema, request)
+	writeData(w, http.StatusOK, schema)
+}
+
+func (s *Server) reloadSchema(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.connection(w, r); !ok {
+		return
+	}
+	schema, ok := s.schemas[r.PathValue("id")]
+	if !ok {
+		schema = map[string]any{"schema_change_handling": "ALLOW_ALL", "schemas": map[string]any{}}
+		s.schemas[r.PathValue("id")] = schema
+	}
+	writeData(w, http.StatusOK, schema)
+}
+
+// connection returns the connection of the request path, writing a not found response when it
+// does not exist. The caller holds the lock.
+func (s *Server) connection(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	connection, ok := s.connections[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound_Connection",
+			fmt.Sprintf("Connection with id '%s' doesn't exist", r.PathValue("id")))
+	}
+	return connection, ok
+}
+
+// withSetupTests returns the details of a connection with the setup test results. The caller
+// holds the lock.
+func (s *Server) withSetupTests(connection map[string]any) map[string]any {
+	data := maps.Clone(connection)
+	data["setup_tests"] = s.setupTests
+	return data
+}
+
+// nested returns the object under key, or nil when there is none
+func nested(data map[string]any, key string) map[string]any {
+	object, _ := data[key].(map[string]any)
+	return object
+}
+
+// decodeBody decodes the JSON object of a request body, writing a bad request response when it
+// is invalid
+func decodeBody(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	body := map[string]any{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidInput", fmt.Sprintf("Invalid request body: %v", err))
+		return nil, false
+	}
+	return body, true
+}
+
+// writeData writes a successful Fivetran response with data
+func writeData(w http.ResponseWriter, status int, data any) {
+	writeJSON(w, status, map[string]any{"code": "Success", "data": data})
+}
+
+// writeError writes a Fivetran error response
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]any{"code": code, "message": message})
+}
+
+// writeJSON writes body as a JSON response
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
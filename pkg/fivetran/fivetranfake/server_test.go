@@ -0,0 +1,86 @@
+package fivetranfake
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/fivetran/go-fivetran/common"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+)
+
+func TestServer(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.SetSetupTests(common.SetupTestResponse{Title: "Validating certificate", Status: "WARNING", Message: "self-signed"})
+
+	client, err := fivetran.NewClientWithConfig("key", "secret", fivetran.ClientConfig{
+		Retry:   fivetran.RetryConfig{MaxAttempts: 2},
+		BaseURL: server.URL,
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	ctx := context.Background()
+
+	paused := true
+	created, err := client.Connections.CreateConnection(ctx, &fivetran.Connector{
+		Service: "postgres",
+		GroupID: "group_id",
+		Paused:  &paused,
+		Config:  &map[string]any{"schema": "sales", "host": "db"},
+		Auth:    &map[string]any{"password": "secret"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	id := created.Data.ID
+	if stored, _ := server.Connection(id); stored["auth"] != nil || stored["service"] != "postgres" {
+		t.Errorf("expected the postgres connection stored without its auth, got %v", stored)
+	}
+
+	tests, err := client.Connections.RunSetupTests(ctx, id, nil, nil)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if len(tests.Data.SetupTests) != 1 || tests.Data.SetupTests[0].Status != "WARNING" {
+		t.Errorf("expected the configured setup test warning, got %+v", tests.Data.SetupTests)
+	}
+
+	schema, err := client.Schemas.GetSchemaDetails(ctx, id)
+	if err == nil || schema.Code != "NotFound_SchemaConfig" {
+		t.Fatalf("expected NotFound_SchemaConfig before a reload, got %q: %v", schema.Code, err)
+	}
+	if _, err := client.Schemas.ReloadSchema(ctx, id, "PRESERVE"); err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	builder := fivetran.NewSchemaBuilder().AddSchema("public", true).AddTable("public", "orders", true, "HISTORY")
+	schema, err = client.Schemas.UpdateSchema(ctx, id, builder)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if table := schema.Data.Schemas["public"].Tables["orders"]; table == nil || *table.SyncMode != "HISTORY" {
+		t.Errorf("expected the orders table in history mode, got %+v", schema.Data.Schemas)
+	}
+
+	// A single server error is retried by the client
+	server.Fail(Fault{Method: http.MethodGet, Path: "/connections/*", StatusCode: http.StatusServiceUnavailable, Times: 1})
+	if _, err := client.Connections.GetConnection(ctx, id); err != nil {
+		t.Fatalf("expected the retry to succeed, got: %v", err)
+	}
+
+	server.Fail(Fault{Method: http.MethodDelete, StatusCode: http.StatusTooManyRequests, Code: "TooManyRequests", Message: "slow down", RetryAfter: 30 * time.Second})
+	_, err = client.Connections.DeleteConnection(ctx, id)
+	if apiErr, ok := fivetran.AsAPIError(err); !ok || apiErr.StatusCode != http.StatusTooManyRequests || apiErr.RetryAfter != 30*time.Second {
+		t.Errorf("expected the scripted rate limit, got %v", err)
+	}
+	server.ClearFaults()
+	if _, err := client.Connections.DeleteConnection(ctx, id); err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if _, ok := server.Connection(id); ok {
+		t.Errorf("expected the connection deleted")
+	}
+}
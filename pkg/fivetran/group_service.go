@@ -0,0 +1,88 @@
+package fivetran
+
+import (
+	"context"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/go-fivetran/connections"
+	"github.com/fivetran/go-fivetran/groups"
+)
+
+type groupServiceImpl struct {
+	client *fivetran.Client
+	retry  RetryConfig
+}
+
+func newGroupService(client *fivetran.Client, retry RetryConfig) GroupsService {
+	return &groupServiceImpl{client: client, retry: retry}
+}
+
+// ListGroups retrieves every group in the account
+func (s *groupServiceImpl) ListGroups(ctx context.Context) ([]groups.GroupItem, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]groups.GroupItem, string, error) {
+		service := s.client.NewGroupsList().Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (groups.GroupsListResponse, error) {
+			return callAPI(ctx, operationListGroups, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// GetGroup retrieves a group by ID
+func (s *groupServiceImpl) GetGroup(ctx context.Context, groupID string) (groups.GroupDetailsResponse, error) {
+	service := s.client.NewGroupDetails().GroupID(groupID)
+	return callWithRetry(ctx, s.retry, func() (groups.GroupDetailsResponse, error) {
+		return callAPI(ctx, operationGetGroup, service.Do)
+	})
+}
+
+// CreateGroup creates a group with the given name
+func (s *groupServiceImpl) CreateGroup(ctx context.Context, name string) (groups.GroupDetailsResponse, error) {
+	service := s.client.NewGroupCreate().Name(name)
+	return callAPI(ctx, operationCreateGroup, service.Do)
+}
+
+// UpdateGroup renames a group
+func (s *groupServiceImpl) UpdateGroup(ctx context.Context, groupID, name string) (groups.GroupDetailsResponse, error) {
+	service := s.client.NewGroupUpdate().GroupID(groupID).Name(name)
+	return callWithRetry(ctx, s.retry, func() (groups.GroupDetailsResponse, error) {
+		return callAPI(ctx, operationUpdateGroup, service.Do)
+	})
+}
+
+// DeleteGroup deletes a group
+func (s *groupServiceImpl) DeleteGroup(ctx context.Context, groupID string) (common.CommonResponse, error) {
+	service := s.client.NewGroupDelete().GroupID(groupID)
+	return callAPI(ctx, operationDeleteGroup, service.Do)
+}
+
+// ListGroupConnections retrieves every connection in a group, or only the one with the given
+// destination schema name when schema is not empty
+func (s *groupServiceImpl) ListGroupConnections(ctx context.Context, groupID, schema string) ([]connections.DetailsResponseDataCommon, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]connections.DetailsResponseDataCommon, string, error) {
+		service := s.client.NewGroupListConnections().GroupID(groupID).Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		if schema != "" {
+			service = service.Schema(schema)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (groups.GroupListConnectionsResponse, error) {
+			return callAPI(ctx, operationListGroupConnections, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// GetGroupPublicKey retrieves the public key Fivetran uses to connect to SSH tunnel hosts for the
+// connections of a group
+func (s *groupServiceImpl) GetGroupPublicKey(ctx context.Context, groupID string) (groups.GroupSshKeyResponse, error) {
+	service := s.client.NewGroupSshPublicKey().GroupID(groupID)
+	return callWithRetry(ctx, s.retry, func() (groups.GroupSshKeyResponse, error) {
+		return callAPI(ctx, operationGetGroupPublicKey, service.Do)
+	})
+}
@@ -0,0 +1,72 @@
+package fivetran
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListGroupsPagination(t *testing.T) {
+	pages := map[string]string{
+		"":      `{"code":"Success","data":{"items":[{"id":"group_1","name":"one"},{"id":"group_2","name":"two"}],"next_cursor":"page2"}}`,
+		"page2": `{"code":"Success","data":{"items":[{"id":"group_3","name":"three"}]}}`,
+	}
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("limit") != "100" {
+			t.Errorf("expected limit 100, got %q", r.URL.Query().Get("limit"))
+		}
+		page, ok := pages[r.URL.Query().Get("cursor")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	items, err := client.Groups.ListGroups(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+	if len(items) != 3 || items[0].ID != "group_1" || items[2].ID != "group_3" {
+		t.Errorf("expected groups group_1 to group_3, got %+v", items)
+	}
+}
+
+func TestGetGroupPublicKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/groups/group_1/public-key" {
+			t.Errorf("expected GET /groups/group_1/public-key, got %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"Success","data":{"public_key":"ssh-rsa AAAA fivetran user key"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	resp, err := client.Groups.GetGroupPublicKey(context.Background(), "group_1")
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if resp.Data.PublicKey != "ssh-rsa AAAA fivetran user key" {
+		t.Errorf("expected the group public key, got %q", resp.Data.PublicKey)
+	}
+}
@@ -0,0 +1,104 @@
+package fivetran
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	httputils "github.com/fivetran/go-fivetran/http_utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
+)
+
+// tracer creates the spans of Fivetran API calls
+var tracer = otel.Tracer("github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran")
+
+// maxRecordedBodyLength is the number of bytes of a response body kept for error details
+const maxRecordedBodyLength = 64 * 1024
+
+// responseRecorderKey is the context key of the recorder for a call's response
+type responseRecorderKey struct{}
+
+// recordedResponse is the status code, headers and start of the body of a response
+type recordedResponse struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// responseRecorder records the last response received for an SDK call, which the SDK does not
+// expose
+type responseRecorder struct {
+	mu       sync.Mutex
+	response recordedResponse
+}
+
+func (r *responseRecorder) set(response recordedResponse) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.response = response
+}
+
+func (r *responseRecorder) get() recordedResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.response
+}
+
+// responseRecordingClient records responses for calls made through callAPI
+type responseRecordingClient struct {
+	next httputils.HttpClient
+}
+
+// Do performs the request and records the response in the request's context
+func (c *responseRecordingClient) Do(req *http.Request) (*http.Response, error) {
+	resp, err := c.next.Do(req)
+	recorder, ok := req.Context().Value(responseRecorderKey{}).(*responseRecorder)
+	if resp == nil || !ok {
+		return resp, err
+	}
+
+	recorded := recordedResponse{statusCode: resp.StatusCode, header: resp.Header.Clone()}
+	// Keep the body of failed responses for their error details and hand the SDK an identical copy
+	if resp.StatusCode >= http.StatusBadRequest && resp.Body != nil {
+		data, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if readErr != nil {
+			return resp, readErr
+		}
+		recorded.body = data[:min(len(data), maxRecordedBodyLength)]
+	}
+	recorder.set(recorded)
+	return resp, err
+}
+
+// callAPI runs an SDK call in a span, records its metrics and wraps its error with the details of
+// the response, including the headers and error body the SDK drops
+func callAPI[T any](ctx context.Context, operation string, call func(ctx context.Context) (T, error)) (T, error) {
+	ctx, span := tracer.Start(ctx, "fivetran."+operation, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("fivetran.operation", operation)))
+	defer span.End()
+
+	recorder := &responseRecorder{}
+	start := time.Now()
+	resp, err := call(context.WithValue(ctx, responseRecorderKey{}, recorder))
+	recorded := recorder.get()
+	observeAPICall(operation, recorded.statusCode, time.Since(start))
+
+	err = wrapFivetranResponseError(resp, recorded, err)
+	if recorded.statusCode != 0 {
+		span.SetAttributes(attribute.Int("http.response.status_code", recorded.statusCode))
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, redact.FromContext(ctx).Redact(err.Error()))
+	}
+	return resp, err
+}
@@ -3,8 +3,19 @@ package fivetran
 import (
 	"context"
 
+	"github.com/fivetran/go-fivetran/certificates"
 	"github.com/fivetran/go-fivetran/common"
+	connectcard "github.com/fivetran/go-fivetran/connect_card"
 	"github.com/fivetran/go-fivetran/connections"
+	"github.com/fivetran/go-fivetran/destinations"
+	externallogging "github.com/fivetran/go-fivetran/external_logging"
+	"github.com/fivetran/go-fivetran/fingerprints"
+	"github.com/fivetran/go-fivetran/groups"
+	"github.com/fivetran/go-fivetran/metadata"
+	"github.com/fivetran/go-fivetran/teams"
+	"github.com/fivetran/go-fivetran/transformations"
+	"github.com/fivetran/go-fivetran/users"
+	"github.com/fivetran/go-fivetran/webhooks"
 )
 
 // ConnectionService defines the interface for Connection operations
@@ -14,6 +25,9 @@ type ConnectorService interface {
 	UpdateConnection(ctx context.Context, ConnectionID string, Connection *Connector) (connections.DetailsWithCustomConfigResponse, error)
 	DeleteConnection(ctx context.Context, ConnectionID string) (common.CommonResponse, error)
 	RunSetupTests(ctx context.Context, ConnectionID string, trustCertificates, trustFingerprints *bool) (connections.DetailsWithConfigResponse, error)
+	GetConnectionState(ctx context.Context, ConnectionID string) (ConnectionStateResponse, error)
+	UpdateConnectionState(ctx context.Context, ConnectionID string, state map[string]any) (ConnectionStateResponse, error)
+	CreateConnectCard(ctx context.Context, ConnectionID, redirectURI string, hideSetupGuide bool) (connectcard.ConnectCardResponse, error)
 }
 
 // SchemaService defines the interface for schema operations
@@ -23,3 +37,130 @@ type SchemaService interface {
 	GetSchemaDetails(ctx context.Context, ConnectionID string) (connections.ConnectionSchemaDetailsResponse, error)
 	ReloadSchema(ctx context.Context, ConnectionID string, excludeMode string) (connections.ConnectionSchemaDetailsResponse, error)
 }
+
+// GroupsService defines the interface for group operations
+type GroupsService interface {
+	ListGroups(ctx context.Context) ([]groups.GroupItem, error)
+	GetGroup(ctx context.Context, groupID string) (groups.GroupDetailsResponse, error)
+	CreateGroup(ctx context.Context, name string) (groups.GroupDetailsResponse, error)
+	UpdateGroup(ctx context.Context, groupID, name string) (groups.GroupDetailsResponse, error)
+	DeleteGroup(ctx context.Context, groupID string) (common.CommonResponse, error)
+	ListGroupConnections(ctx context.Context, groupID, schema string) ([]connections.DetailsResponseDataCommon, error)
+	GetGroupPublicKey(ctx context.Context, groupID string) (groups.GroupSshKeyResponse, error)
+}
+
+// DestinationsService defines the interface for destination operations
+type DestinationsService interface {
+	CreateDestination(ctx context.Context, destination *Destination) (destinations.DestinationDetailsWithSetupTestsCustomResponse, error)
+	GetDestination(ctx context.Context, destinationID string) (destinations.DestinationDetailsCustomResponse, error)
+	UpdateDestination(ctx context.Context, destinationID string, destination *Destination) (destinations.DestinationDetailsWithSetupTestsCustomResponse, error)
+	DeleteDestination(ctx context.Context, destinationID string) (common.CommonResponse, error)
+	RunDestinationSetupTests(ctx context.Context, destinationID string, trustCertificates, trustFingerprints bool) (destinations.DestinationDetailsWithSetupTestsResponse, error)
+}
+
+// UsersService defines the interface for user and user group membership operations
+type UsersService interface {
+	ListUsers(ctx context.Context) ([]users.UserDetailsData, error)
+	GetUser(ctx context.Context, userID string) (users.UserDetailsResponse, error)
+	InviteUser(ctx context.Context, user *User) (users.UserDetailsResponse, error)
+	UpdateUser(ctx context.Context, userID string, user *User) (users.UserDetailsResponse, error)
+	DeleteUser(ctx context.Context, userID string) (common.CommonResponse, error)
+	ListUserGroupMemberships(ctx context.Context, userID string) ([]users.UserGroupMembership, error)
+	AddUserToGroup(ctx context.Context, userID, groupID, role string) (users.UserGroupMembershipCreateResponse, error)
+	UpdateUserGroupMembership(ctx context.Context, userID, groupID, role string) (common.CommonResponse, error)
+	RemoveUserFromGroup(ctx context.Context, userID, groupID string) (common.CommonResponse, error)
+}
+
+// TeamsService defines the interface for team and team role assignment operations
+type TeamsService interface {
+	ListTeams(ctx context.Context) ([]teams.TeamData, error)
+	GetTeam(ctx context.Context, teamID string) (teams.TeamsDetailsResponse, error)
+	CreateTeam(ctx context.Context, team *Team) (teams.TeamsCreateResponse, error)
+	UpdateTeam(ctx context.Context, teamID string, team *Team) (teams.TeamsUpdateResponse, error)
+	DeleteTeam(ctx context.Context, teamID string) (common.CommonResponse, error)
+	ListTeamGroupMemberships(ctx context.Context, teamID string) ([]teams.TeamGroupMembership, error)
+	AddTeamToGroup(ctx context.Context, teamID, groupID, role string) (teams.TeamGroupMembershipCreateResponse, error)
+	UpdateTeamGroupMembership(ctx context.Context, teamID, groupID, role string) (common.CommonResponse, error)
+	RemoveTeamFromGroup(ctx context.Context, teamID, groupID string) (common.CommonResponse, error)
+	ListTeamConnectionMemberships(ctx context.Context, teamID string) ([]teams.TeamConnectionMembership, error)
+	AddTeamToConnection(ctx context.Context, teamID, connectionID, role string) (teams.TeamConnectionMembershipCreateResponse, error)
+	UpdateTeamConnectionMembership(ctx context.Context, teamID, connectionID, role string) (common.CommonResponse, error)
+	RemoveTeamFromConnection(ctx context.Context, teamID, connectionID string) (common.CommonResponse, error)
+}
+
+// CertificatesService defines the interface for managing the TLS certificates trusted by
+// connections and destinations
+type CertificatesService interface {
+	ListConnectionCertificates(ctx context.Context, connectionID string) ([]certificates.CertificateDetails, error)
+	ApproveConnectionCertificate(ctx context.Context, connectionID, hash, encodedCert string) (certificates.CertificateResponse, error)
+	RevokeConnectionCertificate(ctx context.Context, connectionID, hash string) (common.CommonResponse, error)
+	ListDestinationCertificates(ctx context.Context, destinationID string) ([]certificates.CertificateDetails, error)
+	ApproveDestinationCertificate(ctx context.Context, destinationID, hash, encodedCert string) (certificates.CertificateResponse, error)
+	RevokeDestinationCertificate(ctx context.Context, destinationID, hash string) (common.CommonResponse, error)
+}
+
+// FingerprintsService defines the interface for managing the SSH host key fingerprints trusted by
+// connections and destinations
+type FingerprintsService interface {
+	ListConnectionFingerprints(ctx context.Context, connectionID string) ([]fingerprints.FingerprintDetails, error)
+	ApproveConnectionFingerprint(ctx context.Context, connectionID, hash, publicKey string) (fingerprints.FingerprintResponse, error)
+	RevokeConnectionFingerprint(ctx context.Context, connectionID, hash string) (common.CommonResponse, error)
+	ListDestinationFingerprints(ctx context.Context, destinationID string) ([]fingerprints.FingerprintDetails, error)
+	ApproveDestinationFingerprint(ctx context.Context, destinationID, hash, publicKey string) (fingerprints.FingerprintResponse, error)
+	RevokeDestinationFingerprint(ctx context.Context, destinationID, hash string) (common.CommonResponse, error)
+}
+
+// SyncService defines the interface for starting syncs and historical resyncs of connections
+type SyncService interface {
+	Sync(ctx context.Context, connectionID string, force bool) (common.CommonResponse, error)
+	Resync(ctx context.Context, connectionID string) (common.CommonResponse, error)
+	ResyncTables(ctx context.Context, connectionID string, tables map[string][]string) (common.CommonResponse, error)
+}
+
+// LogsService defines the interface for external logging operations
+type LogsService interface {
+	ListLogServices(ctx context.Context) ([]externallogging.ExternalLoggingResponseBase, error)
+	CreateLogService(ctx context.Context, logService *LogService) (externallogging.ExternalLoggingCustomResponse, error)
+	GetLogService(ctx context.Context, logServiceID string) (externallogging.ExternalLoggingResponse, error)
+	UpdateLogService(ctx context.Context, logServiceID string, logService *LogService) (externallogging.ExternalLoggingCustomResponse, error)
+	DeleteLogService(ctx context.Context, logServiceID string) (common.CommonResponse, error)
+	TestLogService(ctx context.Context, logServiceID string) (externallogging.ExternalLoggingSetupTestsResponse, error)
+}
+
+// WebhooksService defines the interface for account and group webhook operations
+type WebhooksService interface {
+	ListWebhooks(ctx context.Context) ([]webhooks.WebhookCommonData, error)
+	CreateAccountWebhook(ctx context.Context, webhook *Webhook) (webhooks.WebhookResponse, error)
+	CreateGroupWebhook(ctx context.Context, groupID string, webhook *Webhook) (webhooks.WebhookResponse, error)
+	GetWebhook(ctx context.Context, webhookID string) (webhooks.WebhookResponse, error)
+	UpdateWebhook(ctx context.Context, webhookID string, webhook *Webhook) (webhooks.WebhookResponse, error)
+	DeleteWebhook(ctx context.Context, webhookID string) (common.CommonResponse, error)
+	TestWebhook(ctx context.Context, webhookID, event string) (webhooks.WebhookTestResponse, error)
+}
+
+// MetadataService defines the interface for connector type metadata operations
+type MetadataService interface {
+	ListConnectorTypes(ctx context.Context) ([]metadata.ConnectorMetadata, error)
+	GetConnectorType(ctx context.Context, service string) (metadata.ConnectorMetadataResponse, error)
+}
+
+// TransformationsService defines the interface for dbt project, transformation and Quickstart
+// package operations
+type TransformationsService interface {
+	ListTransformationProjects(ctx context.Context) ([]TransformationProjectSummary, error)
+	GetTransformationProject(ctx context.Context, projectID string) (transformations.TransformationProjectResponse, error)
+	CreateTransformationProject(ctx context.Context, project *TransformationProject) (transformations.TransformationProjectCustomResponse, error)
+	UpdateTransformationProject(ctx context.Context, projectID string, project *TransformationProject) (transformations.TransformationProjectCustomResponse, error)
+	DeleteTransformationProject(ctx context.Context, projectID string) (common.CommonResponse, error)
+	TestTransformationProject(ctx context.Context, projectID string) (transformations.TransformationProjectResponse, error)
+	ListTransformations(ctx context.Context) ([]TransformationSummary, error)
+	GetTransformation(ctx context.Context, transformationID string) (transformations.TransformationResponse, error)
+	CreateTransformation(ctx context.Context, transformation *Transformation) (transformations.TransformationCustomResponse, error)
+	UpdateTransformation(ctx context.Context, transformationID string, transformation *Transformation) (transformations.TransformationCustomResponse, error)
+	DeleteTransformation(ctx context.Context, transformationID string) (common.CommonResponse, error)
+	RunTransformation(ctx context.Context, transformationID string) (common.CommonResponse, error)
+	CancelTransformation(ctx context.Context, transformationID string) (common.CommonResponse, error)
+	UpgradeTransformationPackage(ctx context.Context, transformationID string) (common.CommonResponse, error)
+	ListQuickstartPackages(ctx context.Context) ([]QuickstartPackage, error)
+	GetQuickstartPackage(ctx context.Context, packageID string) (transformations.QuickstartPackageResponse, error)
+}
@@ -0,0 +1,97 @@
+package fivetran
+
+import (
+	"context"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+	externallogging "github.com/fivetran/go-fivetran/external_logging"
+)
+
+type logServiceImpl struct {
+	client *fivetran.Client
+	retry  RetryConfig
+}
+
+func newLogService(client *fivetran.Client, retry RetryConfig) LogsService {
+	return &logServiceImpl{client: client, retry: retry}
+}
+
+// LogService represents the external logging configuration of a Fivetran group. A group has at
+// most one log service, whose ID is the group ID.
+type LogService struct {
+	GroupID string          `json:"group_id"`
+	Service string          `json:"service"`
+	Enabled *bool           `json:"enabled"`
+	Config  *map[string]any `json:"config"`
+}
+
+// ListLogServices retrieves every log service in the account
+func (s *logServiceImpl) ListLogServices(ctx context.Context) ([]externallogging.ExternalLoggingResponseBase, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]externallogging.ExternalLoggingResponseBase, string, error) {
+		service := s.client.NewExternalLoggingList().Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (externallogging.ExternalLoggingListResponse, error) {
+			return callAPI(ctx, operationListLogServices, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// CreateLogService creates the log service of a group
+func (s *logServiceImpl) CreateLogService(ctx context.Context, logService *LogService) (externallogging.ExternalLoggingCustomResponse, error) {
+	service := s.client.NewExternalLoggingCreate().
+		GroupId(logService.GroupID).
+		Service(logService.Service)
+
+	if logService.Enabled != nil {
+		service = service.Enabled(*logService.Enabled)
+	}
+
+	if logService.Config != nil {
+		service = service.ConfigCustom(logService.Config)
+	}
+
+	resp, err := callAPI(ctx, operationCreateLogService, service.DoCustom)
+	return resp, scrubSubmittedError(err, logService.Config, nil)
+}
+
+// GetLogService retrieves a log service by ID
+func (s *logServiceImpl) GetLogService(ctx context.Context, logServiceID string) (externallogging.ExternalLoggingResponse, error) {
+	service := s.client.NewExternalLoggingDetails().ExternalLoggingId(logServiceID)
+	return callWithRetry(ctx, s.retry, func() (externallogging.ExternalLoggingResponse, error) {
+		return callAPI(ctx, operationGetLogService, service.Do)
+	})
+}
+
+// UpdateLogService updates an existing log service
+func (s *logServiceImpl) UpdateLogService(ctx context.Context, logServiceID string, logService *LogService) (externallogging.ExternalLoggingCustomResponse, error) {
+	service := s.client.NewExternalLoggingUpdate().ExternalLoggingId(logServiceID).RunSetupTests(false)
+
+	if logService.Enabled != nil {
+		service = service.Enabled(*logService.Enabled)
+	}
+
+	if logService.Config != nil {
+		service = service.ConfigCustom(logService.Config)
+	}
+
+	return callWithRetry(ctx, s.retry, func() (externallogging.ExternalLoggingCustomResponse, error) {
+		resp, err := callAPI(ctx, operationUpdateLogService, service.DoCustom)
+		return resp, scrubSubmittedError(err, logService.Config, nil)
+	})
+}
+
+// DeleteLogService deletes a log service
+func (s *logServiceImpl) DeleteLogService(ctx context.Context, logServiceID string) (common.CommonResponse, error) {
+	service := s.client.NewExternalLoggingDelete().ExternalLoggingId(logServiceID)
+	return callAPI(ctx, operationDeleteLogService, service.Do)
+}
+
+// TestLogService runs the setup tests of a log service
+func (s *logServiceImpl) TestLogService(ctx context.Context, logServiceID string) (externallogging.ExternalLoggingSetupTestsResponse, error) {
+	service := s.client.NewExternalLoggingSetupTests().ExternalLoggingId(logServiceID)
+	return callAPI(ctx, operationTestLogService, service.Do)
+}
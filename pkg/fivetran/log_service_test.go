@@ -0,0 +1,49 @@
+package fivetran
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCreateLogService(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":"InvalidInput","message":"Token splunk-token is invalid"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	enabled := true
+	config := map[string]any{"hostname": "splunk.example.com", "token": "splunk-token"}
+	_, err = client.Logs.CreateLogService(context.Background(), &LogService{
+		GroupID: "group_id",
+		Service: "splunk",
+		Enabled: &enabled,
+		Config:  &config,
+	})
+
+	if gotPath != "/external-logging" || gotBody["group_id"] != "group_id" || gotBody["service"] != "splunk" {
+		t.Errorf("expected group and service posted to /external-logging, got %s %v", gotPath, gotBody)
+	}
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if strings.Contains(apiErr.Error(), "splunk-token") {
+		t.Errorf("expected the token to be masked, got %q", apiErr.Error())
+	}
+}
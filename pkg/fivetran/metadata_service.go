@@ -0,0 +1,64 @@
+package fivetran
+
+import (
+	"context"
+	"sort"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/metadata"
+)
+
+type metadataServiceImpl struct {
+	client *fivetran.Client
+	retry  RetryConfig
+}
+
+func newMetadataService(client *fivetran.Client, retry RetryConfig) MetadataService {
+	return &metadataServiceImpl{client: client, retry: retry}
+}
+
+// ListConnectorTypes retrieves the metadata of every connector type (service) Fivetran supports
+func (s *metadataServiceImpl) ListConnectorTypes(ctx context.Context) ([]metadata.ConnectorMetadata, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]metadata.ConnectorMetadata, string, error) {
+		service := s.client.NewMetadataList().Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (metadata.ConnectorMetadataListResponse, error) {
+			return callAPI(ctx, operationListConnectorTypes, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// GetConnectorType retrieves the metadata of a connector type, including the schema of its config
+// and auth fields
+func (s *metadataServiceImpl) GetConnectorType(ctx context.Context, service string) (metadata.ConnectorMetadataResponse, error) {
+	details := s.client.NewMetadataDetails().Service(service)
+	return callWithRetry(ctx, s.retry, func() (metadata.ConnectorMetadataResponse, error) {
+		return callAPI(ctx, operationGetConnectorType, details.Do)
+	})
+}
+
+// RequiredFields returns the dotted paths of the fields a config or auth property schema marks as
+// required, including those of required nested objects, in sorted order
+func RequiredFields(property metadata.Property) []string {
+	var fields []string
+	collectRequiredFields(&property, "", &fields)
+	sort.Strings(fields)
+	return fields
+}
+
+func collectRequiredFields(property *metadata.Property, prefix string, fields *[]string) {
+	for _, name := range property.Required {
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		*fields = append(*fields, path)
+
+		if nested, ok := property.Properties[name]; ok && nested != nil {
+			collectRequiredFields(nested, path, fields)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package fivetran
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/metadata"
+)
+
+func TestRequiredFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		property metadata.Property
+		expected []string
+	}{
+		{
+			name:     "no required fields",
+			property: metadata.Property{Type: "object"},
+			expected: nil,
+		},
+		{
+			name: "flat",
+			property: metadata.Property{
+				Required: []string{"user", "host"},
+				Properties: map[string]*metadata.Property{
+					"host": {Type: "string"},
+					"user": {Type: "string"},
+					"port": {Type: "integer"},
+				},
+			},
+			expected: []string{"host", "user"},
+		},
+		{
+			name: "nested",
+			property: metadata.Property{
+				Required: []string{"client_access"},
+				Properties: map[string]*metadata.Property{
+					"client_access": {
+						Type:     "object",
+						Required: []string{"client_id", "client_secret"},
+					},
+					"refresh_token": {
+						Type: "object",
+						// Optional objects' required fields are only required when they are set
+						Required: []string{"token"},
+					},
+				},
+			},
+			expected: []string{"client_access", "client_access.client_id", "client_access.client_secret"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RequiredFields(tt.property); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package fivetran
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Operations recorded in API call metrics
+const (
+	operationPing = "ping"
+
+	operationCreateConnection      = "create_connection"
+	operationGetConnection         = "get_connection"
+	operationUpdateConnection      = "update_connection"
+	operationDeleteConnection      = "delete_connection"
+	operationRunSetupTests         = "run_setup_tests"
+	operationGetConnectionState    = "get_connection_state"
+	operationUpdateConnectionState = "update_connection_state"
+	operationCreateConnectCard     = "create_connect_card"
+	operationCreateSchema          = "create_schema"
+	operationGetSchema             = "get_schema"
+	operationUpdateSchema          = "update_schema"
+	operationReloadSchema          = "reload_schema"
+
+	operationListGroups           = "list_groups"
+	operationGetGroup             = "get_group"
+	operationCreateGroup          = "create_group"
+	operationUpdateGroup          = "update_group"
+	operationDeleteGroup          = "delete_group"
+	operationListGroupConnections = "list_group_connections"
+	operationGetGroupPublicKey    = "get_group_public_key"
+
+	operationCreateDestination        = "create_destination"
+	operationGetDestination           = "get_destination"
+	operationUpdateDestination        = "update_destination"
+	operationDeleteDestination        = "delete_destination"
+	operationRunDestinationSetupTests = "run_destination_setup_tests"
+
+	operationListUsers                 = "list_users"
+	operationGetUser                   = "get_user"
+	operationInviteUser                = "invite_user"
+	operationUpdateUser                = "update_user"
+	operationDeleteUser                = "delete_user"
+	operationListUserGroupMemberships  = "list_user_group_memberships"
+	operationAddUserToGroup            = "add_user_to_group"
+	operationUpdateUserGroupMembership = "update_user_group_membership"
+	operationRemoveUserFromGroup       = "remove_user_from_group"
+
+	operationListTeams                      = "list_teams"
+	operationGetTeam                        = "get_team"
+	operationCreateTeam                     = "create_team"
+	operationUpdateTeam                     = "update_team"
+	operationDeleteTeam                     = "delete_team"
+	operationListTeamGroupMemberships       = "list_team_group_memberships"
+	operationAddTeamToGroup                 = "add_team_to_group"
+	operationUpdateTeamGroupMembership      = "update_team_group_membership"
+	operationRemoveTeamFromGroup            = "remove_team_from_group"
+	operationListTeamConnectionMemberships  = "list_team_connection_memberships"
+	operationAddTeamToConnection            = "add_team_to_connection"
+	operationUpdateTeamConnectionMembership = "update_team_connection_membership"
+	operationRemoveTeamFromConnection       = "remove_team_from_connection"
+
+	operationListConnectionCertificates    = "list_connection_certificates"
+	operationApproveConnectionCertificate  = "approve_connection_certificate"
+	operationRevokeConnectionCertificate   = "revoke_connection_certificate"
+	operationListDestinationCertificates   = "list_destination_certificates"
+	operationApproveDestinationCertificate = "approve_destination_certificate"
+	operationRevokeDestinationCertificate  = "revoke_destination_certificate"
+	operationListConnectionFingerprints    = "list_connection_fingerprints"
+	operationApproveConnectionFingerprint  = "approve_connection_fingerprint"
+	operationRevokeConnectionFingerprint   = "revoke_connection_fingerprint"
+	operationListDestinationFingerprints   = "list_destination_fingerprints"
+	operationApproveDestinationFingerprint = "approve_destination_fingerprint"
+	operationRevokeDestinationFingerprint  = "revoke_destination_fingerprint"
+
+	operationSyncConnection   = "sync_connection"
+	operationResyncConnection = "resync_connection"
+	operationResyncTables     = "resync_tables"
+
+	operationListLogServices  = "list_log_services"
+	operationCreateLogService = "create_log_service"
+	operationGetLogService    = "get_log_service"
+	operationUpdateLogService = "update_log_service"
+	operationDeleteLogService = "delete_log_service"
+	operationTestLogService   = "test_log_service"
+
+	operationListWebhooks         = "list_webhooks"
+	operationCreateAccountWebhook = "create_account_webhook"
+	operationCreateGroupWebhook   = "create_group_webhook"
+	operationGetWebhook           = "get_webhook"
+	operationUpdateWebhook        = "update_webhook"
+	operationDeleteWebhook        = "delete_webhook"
+	operationTestWebhook          = "test_webhook"
+
+	operationListConnectorTypes = "list_connector_types"
+	operationGetConnectorType   = "get_connector_type"
+
+	operationListTransformationProjects   = "list_transformation_projects"
+	operationGetTransformationProject     = "get_transformation_project"
+	operationCreateTransformationProject  = "create_transformation_project"
+	operationUpdateTransformationProject  = "update_transformation_project"
+	operationDeleteTransformationProject  = "delete_transformation_project"
+	operationTestTransformationProject    = "test_transformation_project"
+	operationListTransformations          = "list_transformations"
+	operationGetTransformation            = "get_transformation"
+	operationCreateTransformation         = "create_transformation"
+	operationUpdateTransformation         = "update_transformation"
+	operationDeleteTransformation         = "delete_transformation"
+	operationRunTransformation            = "run_transformation"
+	operationCancelTransformation         = "cancel_transformation"
+	operationUpgradeTransformationPackage = "upgrade_transformation_package"
+	operationListQuickstartPackages       = "list_quickstart_packages"
+	operationGetQuickstartPackage         = "get_quickstart_package"
+)
+
+// codeError labels calls that failed without a response, such as timeouts or an open circuit
+const codeError = "error"
+
+var (
+	apiRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fivetran_operator_fivetran_api_requests_total",
+		Help: "Number of Fivetran API calls by operation and HTTP status code.",
+	}, []string{"operation", "code"})
+
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "fivetran_operator_fivetran_api_request_duration_seconds",
+		Help:    "Latency of Fivetran API calls by operation, including time spent waiting for the client-side rate limit.",
+		Buckets: prometheus.ExponentialBuckets(0.05, 2, 11),
+	}, []string{"operation"})
+
+	rateLimitRemaining = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "fivetran_operator_fivetran_api_rate_limit_remaining",
+		Help: "Requests that can be sent immediately under the client-side Fivetran API rate limit.",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(apiRequestsTotal, apiRequestDuration, rateLimitRemaining)
+}
+
+// observeAPICall records the outcome and latency of an API call; a zero status code means no
+// response was received
+func observeAPICall(operation string, statusCode int, duration time.Duration) {
+	code := codeError
+	if statusCode != 0 {
+		code = strconv.Itoa(statusCode)
+	}
+	apiRequestsTotal.WithLabelValues(operation, code).Inc()
+	apiRequestDuration.WithLabelValues(operation).Observe(duration.Seconds())
+}
@@ -0,0 +1,124 @@
+package fivetran
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// requestCount returns the number of API calls recorded for an operation and status code
+func requestCount(t *testing.T, operation, code string) float64 {
+	t.Helper()
+	var metric dto.Metric
+	if err := apiRequestsTotal.WithLabelValues(operation, code).Write(&metric); err != nil {
+		t.Fatalf("failed to read metric: %v", err)
+	}
+	return metric.GetCounter().GetValue()
+}
+
+func TestAPICallMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"NotFound_Connection","message":"Connection not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code":"Success","data":{"id":"connection_id"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	gets := requestCount(t, operationGetConnection, "200")
+	deletes := requestCount(t, operationDeleteConnection, "404")
+
+	if _, err := client.Connections.GetConnection(context.Background(), "connection_id"); err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if _, err := client.Connections.DeleteConnection(context.Background(), "connection_id"); err == nil {
+		t.Fatalf("expected an error for the missing connection")
+	}
+
+	if got := requestCount(t, operationGetConnection, "200") - gets; got != 1 {
+		t.Errorf("expected 1 successful get, got %v", got)
+	}
+	if got := requestCount(t, operationDeleteConnection, "404") - deletes; got != 1 {
+		t.Errorf("expected 1 failed delete, got %v", got)
+	}
+}
+
+// spanRecorder keeps the spans ended in a test
+type spanRecorder struct {
+	sync.Mutex
+	spans []sdktrace.ReadOnlySpan
+}
+
+func (r *spanRecorder) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+func (r *spanRecorder) OnEnd(span sdktrace.ReadOnlySpan) {
+	r.Lock()
+	defer r.Unlock()
+	r.spans = append(r.spans, span)
+}
+
+func (r *spanRecorder) Shutdown(context.Context) error { return nil }
+
+func (r *spanRecorder) ForceFlush(context.Context) error { return nil }
+
+func TestAPICallSpans(t *testing.T) {
+	recorder := &spanRecorder{}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"NotFound_Connection","message":"Connection not found"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	if _, err := client.Connections.GetConnection(context.Background(), "connection_id"); err == nil {
+		t.Fatalf("expected an error for the missing connection")
+	}
+
+	recorder.Lock()
+	defer recorder.Unlock()
+	if len(recorder.spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(recorder.spans))
+	}
+	span := recorder.spans[0]
+	if span.Name() != "fivetran."+operationGetConnection {
+		t.Errorf("expected span fivetran.%s, got %s", operationGetConnection, span.Name())
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("expected an error status, got %v", span.Status())
+	}
+	var statusCode int64
+	for _, attr := range span.Attributes() {
+		if attr.Key == "http.response.status_code" {
+			statusCode = attr.Value.AsInt64()
+		}
+	}
+	if statusCode != http.StatusNotFound {
+		t.Errorf("expected status code 404 on the span, got %d", statusCode)
+	}
+}
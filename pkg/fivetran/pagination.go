@@ -0,0 +1,59 @@
+package fivetran
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// listPageSize is the number of items requested per page of a list call
+const listPageSize = 100
+
+// pageFetcher returns the items of the page at cursor of a cursor-paginated list call, an empty
+// cursor being the first page, and the cursor of the next page, which is empty on the last one
+type pageFetcher[T any] func(ctx context.Context, cursor string) ([]T, string, error)
+
+// paginate iterates over the items of every page of a cursor-paginated list call, fetching each
+// page only once the items of the previous one have been consumed. Iteration ends after the first
+// error, which is yielded with the zero value of T.
+func paginate[T any](ctx context.Context, fetch pageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		seen := map[string]bool{}
+		cursor := ""
+		for {
+			page, next, err := fetch(ctx, cursor)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if next == "" {
+				return
+			}
+			// Guard against an API returning a cursor it already returned, which would never end
+			if seen[next] {
+				yield(zero, fmt.Errorf("fivetran api returned cursor %q twice", next))
+				return
+			}
+			seen[next] = true
+			cursor = next
+		}
+	}
+}
+
+// listAll collects the items of every page of a cursor-paginated list call
+func listAll[T any](ctx context.Context, fetch pageFetcher[T]) ([]T, error) {
+	var items []T
+	for item, err := range paginate(ctx, fetch) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,42 @@
+package fivetran
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	pages := map[string]struct {
+		items []string
+		next  string
+	}{
+		"":      {items: []string{"a", "b"}, next: "page2"},
+		"page2": {items: []string{"c"}, next: "page3"},
+		"page3": {items: []string{"d"}, next: "page2"},
+	}
+	var fetched []string
+	fetch := func(_ context.Context, cursor string) ([]string, string, error) {
+		fetched = append(fetched, cursor)
+		page := pages[cursor]
+		return page.items, page.next, nil
+	}
+
+	// Stopping early does not fetch further pages
+	for item, err := range paginate(context.Background(), fetch) {
+		if err != nil {
+			t.Fatalf("expected no error but got: %v", err)
+		}
+		if item == "b" {
+			break
+		}
+	}
+	if len(fetched) != 1 {
+		t.Errorf("expected only the first page fetched, got %v", fetched)
+	}
+
+	// A repeated cursor ends the iteration with an error instead of looping forever
+	items, err := listAll(context.Background(), fetch)
+	if err == nil {
+		t.Errorf("expected an error for the repeated cursor, got items %v", items)
+	}
+}
@@ -0,0 +1,51 @@
+package fivetran
+
+import (
+	"net/http"
+
+	httputils "github.com/fivetran/go-fivetran/http_utils"
+	"golang.org/x/time/rate"
+)
+
+// RateLimitConfig configures the client-side limit on Fivetran API requests, shared by all
+// reconciles using the client
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained request rate; zero or less disables the limit
+	RequestsPerSecond float64
+	// Burst is the number of requests that may be sent at once above the sustained rate
+	Burst int
+}
+
+// DefaultRateLimitConfig returns the rate limit used by NewClient
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		RequestsPerSecond: 10,
+		Burst:             20,
+	}
+}
+
+// rateLimitedClient delays requests to stay within a token bucket limit
+type rateLimitedClient struct {
+	limiter *rate.Limiter
+	next    httputils.HttpClient
+}
+
+// newRateLimitedClient wraps next with the configured limit, or returns next when it is disabled
+func newRateLimitedClient(next httputils.HttpClient, cfg RateLimitConfig) httputils.HttpClient {
+	if cfg.RequestsPerSecond <= 0 {
+		return next
+	}
+	return &rateLimitedClient{
+		limiter: rate.NewLimiter(rate.Limit(cfg.RequestsPerSecond), max(cfg.Burst, 1)),
+		next:    next,
+	}
+}
+
+// Do waits for the limiter, giving up when the request's context is done, and performs the request
+func (c *rateLimitedClient) Do(req *http.Request) (*http.Response, error) {
+	if err := c.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	rateLimitRemaining.Set(c.limiter.Tokens())
+	return c.next.Do(req)
+}
@@ -0,0 +1,44 @@
+package fivetran
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// countingClient counts the requests it receives
+type countingClient struct {
+	requests int
+}
+
+func (c *countingClient) Do(*http.Request) (*http.Response, error) {
+	c.requests++
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestRateLimitedClient(t *testing.T) {
+	next := &countingClient{}
+	if client := newRateLimitedClient(next, RateLimitConfig{}); client != next {
+		t.Errorf("expected a disabled limit to return the wrapped client")
+	}
+
+	client := newRateLimitedClient(next, RateLimitConfig{RequestsPerSecond: 1, Burst: 2})
+	for range 2 {
+		req, _ := http.NewRequest(http.MethodGet, "https://api.fivetran.com/v1/connections", nil)
+		if _, err := client.Do(req); err != nil {
+			t.Fatalf("expected burst requests to pass, got: %v", err)
+		}
+	}
+
+	// The bucket is empty, so the next request waits longer than its deadline allows
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.fivetran.com/v1/connections", nil)
+	if _, err := client.Do(req); err == nil {
+		t.Errorf("expected the request to be throttled")
+	}
+	if next.requests != 2 {
+		t.Errorf("expected 2 requests to be sent, got %d", next.requests)
+	}
+}
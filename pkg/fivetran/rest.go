@@ -0,0 +1,57 @@
+package fivetran
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	fivetran "github.com/fivetran/go-fivetran"
+)
+
+// restClient calls Fivetran API endpoints the SDK does not cover, such as fields or operations
+// added to the API before an SDK release. Requests use the SDK's credentials, base URL and HTTP
+// client, so they share its rate limit, circuit breaker and debug logging.
+type restClient struct {
+	sdk *fivetran.Client
+}
+
+func newRESTClient(sdk *fivetran.Client) *restClient {
+	return &restClient{sdk: sdk}
+}
+
+// restRequest is a request to a Fivetran API endpoint
+type restRequest struct {
+	// operation labels the call in API metrics
+	operation string
+	method    string
+	// path is relative to the API base URL, such as "/groups/{id}"; use restPath to escape IDs
+	path  string
+	query map[string]string
+	// body is encoded as JSON when not nil
+	body any
+	// expectedStatus is the status of a successful response, http.StatusOK when zero
+	expectedStatus int
+}
+
+// restCall sends req and decodes the response into a T, returning errors as APIErrors
+func restCall[T any](ctx context.Context, c *restClient, req restRequest) (T, error) {
+	expectedStatus := req.expectedStatus
+	if expectedStatus == 0 {
+		expectedStatus = http.StatusOK
+	}
+
+	return callAPI(ctx, req.operation, func(ctx context.Context) (T, error) {
+		var resp T
+		err := c.sdk.NewHttpService().Do(ctx, req.method, req.path, req.body, req.query, expectedStatus, &resp)
+		return resp, err
+	})
+}
+
+// restPath joins path segments, escaping each of them
+func restPath(segments ...string) string {
+	path := ""
+	for _, segment := range segments {
+		path += "/" + url.PathEscape(segment)
+	}
+	return path
+}
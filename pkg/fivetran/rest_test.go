@@ -0,0 +1,72 @@
+package fivetran
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/common"
+)
+
+func TestRESTCall(t *testing.T) {
+	var gotMethod, gotPath, gotQuery, gotAuth string
+	var gotBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath, gotQuery = r.Method, r.URL.EscapedPath(), r.URL.RawQuery
+		gotAuth = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"NotFound","message":"Not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code":"Success","message":"Done"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	resp, err := restCall[common.CommonResponse](context.Background(), client.rest, restRequest{
+		operation: "test",
+		method:    http.MethodPatch,
+		path:      restPath("connections", "id/with slash"),
+		query:     map[string]string{"limit": "10"},
+		body:      map[string]any{"schedule_type": "manual"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if resp.Code != "Success" {
+		t.Errorf("expected code Success, got %q", resp.Code)
+	}
+	if gotMethod != http.MethodPatch || gotPath != "/connections/id%2Fwith%20slash" || gotQuery != "limit=10" {
+		t.Errorf("expected PATCH /connections/id%%2Fwith%%20slash?limit=10, got %s %s?%s", gotMethod, gotPath, gotQuery)
+	}
+	if gotAuth == "" {
+		t.Errorf("expected an Authorization header")
+	}
+	if gotBody["schedule_type"] != "manual" {
+		t.Errorf("expected schedule_type manual in body, got %v", gotBody)
+	}
+
+	_, err = restCall[common.CommonResponse](context.Background(), client.rest, restRequest{
+		operation: "test",
+		method:    http.MethodGet,
+		path:      "/missing",
+	})
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Code != "NotFound" {
+		t.Errorf("expected 404 NotFound, got %d %s", apiErr.StatusCode, apiErr.Code)
+	}
+}
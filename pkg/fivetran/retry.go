@@ -0,0 +1,72 @@
+package fivetran
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryConfig configures how idempotent Fivetran API calls are retried on transient errors
+type RetryConfig struct {
+	// MaxAttempts is the number of times a call is attempted; 1 or less disables retries
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry; it doubles on each further attempt
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryConfig returns the retry settings used by NewClient
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts:    3,
+		InitialBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+	}
+}
+
+// callWithRetry runs call, retrying transient errors with exponential backoff and jitter until the
+// attempts are exhausted or ctx is done. Only idempotent calls may be retried, since a request that
+// failed with a server error may still have been applied. Reads qualify, and so do updates, which
+// send the full desired state or role rather than a change to it, so applying one twice leaves the
+// same result. Creates and deletes do not: a repeated create makes a second object, and a repeated
+// delete fails once the first one was applied.
+func callWithRetry[T any](ctx context.Context, cfg RetryConfig, call func() (T, error)) (T, error) {
+	backoff := cfg.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		resp, err := call()
+		if err == nil || attempt >= cfg.MaxAttempts || ctx.Err() != nil || !IsRetryableError(err) || errors.Is(err, ErrCircuitOpen) {
+			return resp, err
+		}
+
+		// Honor a rate limit's Retry-After, leaving waits longer than a backoff to a requeue
+		delay := withJitter(backoff)
+		if retryAfter := RetryAfter(err); retryAfter > 0 {
+			if cfg.MaxBackoff > 0 && retryAfter > cfg.MaxBackoff {
+				return resp, err
+			}
+			delay = max(delay, retryAfter)
+		}
+
+		select {
+		case <-ctx.Done():
+			return resp, err
+		case <-time.After(delay):
+		}
+		backoff *= 2
+		if cfg.MaxBackoff > 0 && backoff > cfg.MaxBackoff {
+			backoff = cfg.MaxBackoff
+		}
+	}
+}
+
+// withJitter returns a random delay between half of and the full backoff, so reconciles failing
+// together do not retry in lockstep
+func withJitter(backoff time.Duration) time.Duration {
+	if backoff <= 1 {
+		return backoff
+	}
+	half := backoff / 2
+	return half + rand.N(backoff-half)
+}
@@ -0,0 +1,91 @@
+package fivetran
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCallWithRetry(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	serverErr := &APIError{StatusCode: 503, Code: "ServiceUnavailable"}
+	badRequest := &APIError{StatusCode: 400, Code: "InvalidInput"}
+	rateLimited := &APIError{StatusCode: 429, RetryAfter: time.Minute}
+
+	tests := []struct {
+		name             string
+		errs             []error
+		cancel           bool
+		expectedErr      error
+		expectedAttempts int
+	}{
+		{
+			name:             "succeeds first time",
+			errs:             []error{nil},
+			expectedAttempts: 1,
+		},
+		{
+			name:             "retries transient errors",
+			errs:             []error{serverErr, serverErr, nil},
+			expectedAttempts: 3,
+		},
+		{
+			name:             "gives up after max attempts",
+			errs:             []error{serverErr, serverErr, serverErr, nil},
+			expectedErr:      serverErr,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "does not retry client errors",
+			errs:             []error{badRequest, nil},
+			expectedErr:      badRequest,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "leaves long rate limits to a requeue",
+			errs:             []error{rateLimited, nil},
+			expectedErr:      rateLimited,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "stops when the context is done",
+			errs:             []error{serverErr, nil},
+			cancel:           true,
+			expectedErr:      serverErr,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				cancel()
+			}
+
+			attempts := 0
+			resp, err := callWithRetry(ctx, cfg, func() (int, error) {
+				err := tt.errs[attempts]
+				attempts++
+				return attempts, err
+			})
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+			if attempts != tt.expectedAttempts || resp != tt.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestWithJitter(t *testing.T) {
+	backoff := 100 * time.Millisecond
+	for range 100 {
+		if delay := withJitter(backoff); delay < backoff/2 || delay > backoff {
+			t.Fatalf("expected delay between %v and %v, got %v", backoff/2, backoff, delay)
+		}
+	}
+}
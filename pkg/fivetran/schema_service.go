@@ -10,10 +10,11 @@ import (
 
 type schemaServiceImpl struct {
 	client *fivetran.Client
+	retry  RetryConfig
 }
 
-func newSchemaService(client *fivetran.Client) SchemaService {
-	return &schemaServiceImpl{client: client}
+func newSchemaService(client *fivetran.Client, retry RetryConfig) SchemaService {
+	return &schemaServiceImpl{client: client, retry: retry}
 }
 
 // CreateSchema configures the schema for a Connection
@@ -34,9 +35,7 @@ func (s *schemaServiceImpl) CreateSchema(ctx context.Context, ConnectionID strin
 		service = service.Schema(schemaName, schema)
 	}
 
-	resp, err := service.Do(ctx)
-
-	return resp, WrapFivetranError(resp, err)
+	return callAPI(ctx, operationCreateSchema, service.Do)
 }
 
 // UpdateSchema updates the schema configuration for a Connection
@@ -57,23 +56,24 @@ func (s *schemaServiceImpl) UpdateSchema(ctx context.Context, ConnectionID strin
 		service = service.Schema(schemaName, schema)
 	}
 
-	resp, err := service.Do(ctx)
-	return resp, WrapFivetranError(resp, err)
+	return callWithRetry(ctx, s.retry, func() (connections.ConnectionSchemaDetailsResponse, error) {
+		return callAPI(ctx, operationUpdateSchema, service.Do)
+	})
 }
 
 // GetSchemaDetails retrieves schema configuration details for a Connection
 func (s *schemaServiceImpl) GetSchemaDetails(ctx context.Context, ConnectionID string) (connections.ConnectionSchemaDetailsResponse, error) {
 	schemaService := s.client.NewConnectionSchemaDetails()
-	resp, err := schemaService.ConnectionID(ConnectionID).Do(ctx)
-	return resp, WrapFivetranError(resp, err)
+	return callWithRetry(ctx, s.retry, func() (connections.ConnectionSchemaDetailsResponse, error) {
+		return callAPI(ctx, operationGetSchema, schemaService.ConnectionID(ConnectionID).Do)
+	})
 }
 
 // ReloadSchema reloads the schema configuration for a Connection
 func (s *schemaServiceImpl) ReloadSchema(ctx context.Context, ConnectionID string, excludeMode string) (connections.ConnectionSchemaDetailsResponse, error) {
 	reloadService := s.client.NewConnectionSchemaReload()
-	resp, err := reloadService.
+	return callAPI(ctx, operationReloadSchema, reloadService.
 		ConnectionID(ConnectionID).
 		ExcludeMode(excludeMode).
-		Do(ctx)
-	return resp, WrapFivetranError(resp, err)
+		Do)
 }
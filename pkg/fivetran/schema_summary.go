@@ -0,0 +1,45 @@
+package fivetran
+
+import (
+	"github.com/fivetran/go-fivetran/connections"
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// SummarizeSchema counts the enabled and disabled schemas and tables, and the hashed columns, of a
+// connector's schema in Fivetran. Tables of disabled schemas are counted by their own state.
+func SummarizeSchema(schema connections.ConnectionSchemaDetailsResponse) operatorv1alpha1.SchemaSummary {
+	var summary operatorv1alpha1.SchemaSummary
+	for _, schemaObj := range schema.Data.Schemas {
+		if schemaObj == nil {
+			continue
+		}
+		if isTrue(schemaObj.Enabled) {
+			summary.EnabledSchemas++
+		} else {
+			summary.DisabledSchemas++
+		}
+
+		for _, table := range schemaObj.Tables {
+			if table == nil {
+				continue
+			}
+			if isTrue(table.Enabled) {
+				summary.EnabledTables++
+			} else {
+				summary.DisabledTables++
+			}
+
+			for _, column := range table.Columns {
+				if column != nil && isTrue(column.Hashed) {
+					summary.HashedColumns++
+				}
+			}
+		}
+	}
+	return summary
+}
+
+// isTrue reports whether an optional flag is set
+func isTrue(value *bool) bool {
+	return value != nil && *value
+}
@@ -0,0 +1,49 @@
+package fivetran
+
+import (
+	"testing"
+
+	"github.com/fivetran/go-fivetran/connections"
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+func TestSummarizeSchema(t *testing.T) {
+	schema := createSchemaResponse(map[string]*connections.ConnectionSchemaConfigSchemaResponse{
+		"public": {
+			Enabled: boolPtr(true),
+			Tables: map[string]*connections.ConnectionSchemaConfigTableResponse{
+				"users": {
+					Enabled: boolPtr(true),
+					Columns: map[string]*connections.ConnectionSchemaConfigColumnResponse{
+						"email": {Enabled: boolPtr(true), Hashed: boolPtr(true)},
+						"phone": {Enabled: boolPtr(true), Hashed: boolPtr(true)},
+						"id":    {Enabled: boolPtr(true), Hashed: boolPtr(false)},
+					},
+				},
+				"audit_log": {Enabled: boolPtr(false)},
+			},
+		},
+		"archive": {
+			Enabled: boolPtr(false),
+			Tables: map[string]*connections.ConnectionSchemaConfigTableResponse{
+				"orders": {Enabled: boolPtr(true)},
+			},
+		},
+		"staging": {},
+	})
+
+	expected := operatorv1alpha1.SchemaSummary{
+		EnabledSchemas:  1,
+		DisabledSchemas: 2,
+		EnabledTables:   2,
+		DisabledTables:  1,
+		HashedColumns:   2,
+	}
+	if got := SummarizeSchema(schema); got != expected {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+
+	if got := SummarizeSchema(connections.ConnectionSchemaDetailsResponse{}); got != (operatorv1alpha1.SchemaSummary{}) {
+		t.Errorf("expected empty summary, got %+v", got)
+	}
+}
@@ -0,0 +1,110 @@
+package fivetran
+
+import (
+	"encoding/json"
+
+	"github.com/fivetran/go-fivetran/connections"
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+// RedactConfig returns a copy of a connector config with the values of auth objects and sensitive
+// keys masked, so it can be stored where secrets must not be
+func RedactConfig(config map[string]any) (map[string]any, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	var redacted map[string]any
+	if err := json.Unmarshal(data, &redacted); err != nil {
+		return nil, err
+	}
+	if redacted == nil {
+		return nil, nil
+	}
+	return maskSubmittedValues(redacted, false).(map[string]any), nil
+}
+
+// SchemaConfigFromResponse returns the schema config of a connector in Fivetran in the form of
+// spec.connectorSchemas, so it can be applied again. Masking algorithms are not reported by
+// Fivetran and are left empty.
+func SchemaConfigFromResponse(schema connections.ConnectionSchemaDetailsResponse) *operatorv1alpha1.ConnectorSchemaConfig {
+	config := &operatorv1alpha1.ConnectorSchemaConfig{
+		SchemaChangeHandling: schema.Data.SchemaChangeHandling,
+	}
+	for schemaName, schemaObj := range schema.Data.Schemas {
+		if schemaObj == nil {
+			continue
+		}
+		if config.Schemas == nil {
+			config.Schemas = make(map[string]*operatorv1alpha1.SchemaObject)
+		}
+		schemaConfig := &operatorv1alpha1.SchemaObject{Enabled: isTrue(schemaObj.Enabled)}
+		for tableName, table := range schemaObj.Tables {
+			if table == nil {
+				continue
+			}
+			if schemaConfig.Tables == nil {
+				schemaConfig.Tables = make(map[string]*operatorv1alpha1.TableObject)
+			}
+			tableConfig := &operatorv1alpha1.TableObject{Enabled: isTrue(table.Enabled)}
+			if table.SyncMode != nil {
+				tableConfig.SyncMode = *table.SyncMode
+			}
+			for columnName, column := range table.Columns {
+				if column == nil {
+					continue
+				}
+				if tableConfig.Columns == nil {
+					tableConfig.Columns = make(map[string]*operatorv1alpha1.ColumnObject)
+				}
+				tableConfig.Columns[columnName] = &operatorv1alpha1.ColumnObject{
+					Enabled:      isTrue(column.Enabled),
+					Hashed:       isTrue(column.Hashed),
+					IsPrimaryKey: isTrue(column.IsPrimaryKey),
+				}
+			}
+			schemaConfig.Tables[tableName] = tableConfig
+		}
+		config.Schemas[schemaName] = schemaConfig
+	}
+	return config
+}
+
+// MergeSchemaConfig returns the schema config of a spec completed with the schemas and tables of a
+// snapshot it does not configure. Schemas and tables the spec configures are kept as they are, as
+// is its schema change handling when set.
+func MergeSchemaConfig(spec, snapshot *operatorv1alpha1.ConnectorSchemaConfig) *operatorv1alpha1.ConnectorSchemaConfig {
+	if snapshot == nil {
+		return spec
+	}
+	merged := snapshot.DeepCopy()
+	if spec == nil {
+		return merged
+	}
+	if spec.SchemaChangeHandling != "" {
+		merged.SchemaChangeHandling = spec.SchemaChangeHandling
+	}
+	for schemaName, schemaObj := range spec.Schemas {
+		if schemaObj == nil {
+			continue
+		}
+		if merged.Schemas == nil {
+			merged.Schemas = make(map[string]*operatorv1alpha1.SchemaObject)
+		}
+		snapshotSchema := merged.Schemas[schemaName]
+		mergedSchema := schemaObj.DeepCopy()
+		if snapshotSchema != nil {
+			for tableName, table := range snapshotSchema.Tables {
+				if _, ok := mergedSchema.Tables[tableName]; ok {
+					continue
+				}
+				if mergedSchema.Tables == nil {
+					mergedSchema.Tables = make(map[string]*operatorv1alpha1.TableObject)
+				}
+				mergedSchema.Tables[tableName] = table
+			}
+		}
+		merged.Schemas[schemaName] = mergedSchema
+	}
+	return merged
+}
@@ -0,0 +1,149 @@
+package fivetran
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/connections"
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/redact"
+)
+
+func TestRedactConfig(t *testing.T) {
+	config := map[string]any{
+		"host":     "db.example.com",
+		"password": "hunter22",
+		"auth":     map[string]any{"client_id": "abc123"},
+		"tunnel":   map[string]any{"private_key": "-----BEGIN KEY-----", "user": "tunnel"},
+	}
+
+	redacted, err := RedactConfig(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]any{
+		"host":     "db.example.com",
+		"password": redact.Mask,
+		"auth":     map[string]any{"client_id": redact.Mask},
+		"tunnel":   map[string]any{"private_key": redact.Mask, "user": "tunnel"},
+	}
+	if !reflect.DeepEqual(redacted, expected) {
+		t.Errorf("expected %v, got %v", expected, redacted)
+	}
+	if config["password"] != "hunter22" {
+		t.Errorf("expected the config to be left unchanged, got password %v", config["password"])
+	}
+
+	if redacted, err := RedactConfig(nil); err != nil || redacted != nil {
+		t.Errorf("expected nil config, got %v, %v", redacted, err)
+	}
+}
+
+func TestSchemaConfigFromResponse(t *testing.T) {
+	schema := createSchemaResponse(map[string]*connections.ConnectionSchemaConfigSchemaResponse{
+		"public": {
+			Enabled: boolPtr(true),
+			Tables: map[string]*connections.ConnectionSchemaConfigTableResponse{
+				"users": {
+					Enabled:  boolPtr(true),
+					SyncMode: stringPtr("HISTORY"),
+					Columns: map[string]*connections.ConnectionSchemaConfigColumnResponse{
+						"id":    {Enabled: boolPtr(true), IsPrimaryKey: boolPtr(true)},
+						"email": {Enabled: boolPtr(true), Hashed: boolPtr(true)},
+					},
+				},
+				"audit_log": {Enabled: boolPtr(false)},
+			},
+		},
+		"archive": {},
+	})
+	schema.Data.SchemaChangeHandling = "BLOCK_ALL"
+
+	expected := &operatorv1alpha1.ConnectorSchemaConfig{
+		SchemaChangeHandling: "BLOCK_ALL",
+		Schemas: map[string]*operatorv1alpha1.SchemaObject{
+			"public": {
+				Enabled: true,
+				Tables: map[string]*operatorv1alpha1.TableObject{
+					"users": {
+						Enabled:  true,
+						SyncMode: "HISTORY",
+						Columns: map[string]*operatorv1alpha1.ColumnObject{
+							"id":    {Enabled: true, IsPrimaryKey: true},
+							"email": {Enabled: true, Hashed: true},
+						},
+					},
+					"audit_log": {},
+				},
+			},
+			"archive": {},
+		},
+	}
+	if got := SchemaConfigFromResponse(schema); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+
+	if got := SchemaConfigFromResponse(connections.ConnectionSchemaDetailsResponse{}); !reflect.DeepEqual(got, &operatorv1alpha1.ConnectorSchemaConfig{}) {
+		t.Errorf("expected empty schema config, got %+v", got)
+	}
+}
+
+func TestMergeSchemaConfig(t *testing.T) {
+	snapshot := &operatorv1alpha1.ConnectorSchemaConfig{
+		SchemaChangeHandling: "BLOCK_ALL",
+		Schemas: map[string]*operatorv1alpha1.SchemaObject{
+			"public": {
+				Enabled: true,
+				Tables: map[string]*operatorv1alpha1.TableObject{
+					"users":  {Enabled: true, SyncMode: "HISTORY"},
+					"orders": {Enabled: true},
+				},
+			},
+			"archive": {Enabled: false},
+		},
+	}
+	spec := &operatorv1alpha1.ConnectorSchemaConfig{
+		Schemas: map[string]*operatorv1alpha1.SchemaObject{
+			"public": {
+				Enabled: true,
+				Tables: map[string]*operatorv1alpha1.TableObject{
+					"users": {Enabled: false},
+				},
+			},
+			"staging": {Enabled: true},
+		},
+	}
+
+	expected := &operatorv1alpha1.ConnectorSchemaConfig{
+		SchemaChangeHandling: "BLOCK_ALL",
+		Schemas: map[string]*operatorv1alpha1.SchemaObject{
+			"public": {
+				Enabled: true,
+				Tables: map[string]*operatorv1alpha1.TableObject{
+					"users":  {Enabled: false},
+					"orders": {Enabled: true},
+				},
+			},
+			"archive": {Enabled: false},
+			"staging": {Enabled: true},
+		},
+	}
+	if got := MergeSchemaConfig(spec, snapshot); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+	if len(snapshot.Schemas["public"].Tables) != 2 || snapshot.Schemas["public"].Tables["users"].SyncMode != "HISTORY" {
+		t.Errorf("expected the snapshot to be left unchanged, got %+v", snapshot.Schemas["public"])
+	}
+
+	spec.SchemaChangeHandling = "ALLOW_COLUMNS"
+	if got := MergeSchemaConfig(spec, snapshot); got.SchemaChangeHandling != "ALLOW_COLUMNS" {
+		t.Errorf("expected the spec's schema change handling, got %s", got.SchemaChangeHandling)
+	}
+	if got := MergeSchemaConfig(nil, snapshot); !reflect.DeepEqual(got, snapshot) {
+		t.Errorf("expected the snapshot, got %+v", got)
+	}
+	if got := MergeSchemaConfig(spec, nil); got != spec {
+		t.Errorf("expected the spec, got %+v", got)
+	}
+}
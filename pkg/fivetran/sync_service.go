@@ -0,0 +1,48 @@
+package fivetran
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/fivetran/go-fivetran/common"
+)
+
+// syncServiceImpl calls the sync endpoints through the REST client; the SDK only covers the
+// deprecated force endpoint and single table resyncs
+type syncServiceImpl struct {
+	rest *restClient
+}
+
+func newSyncService(rest *restClient) SyncService {
+	return &syncServiceImpl{rest: rest}
+}
+
+// Sync starts a sync of a connection. Unless force is set, a sync already in progress is left to
+// finish instead of being restarted.
+func (s *syncServiceImpl) Sync(ctx context.Context, connectionID string, force bool) (common.CommonResponse, error) {
+	return restCall[common.CommonResponse](ctx, s.rest, restRequest{
+		operation: operationSyncConnection,
+		method:    http.MethodPost,
+		path:      restPath("connections", connectionID, "sync"),
+		body:      map[string]any{"force": force},
+	})
+}
+
+// Resync starts a historical sync of all data of a connection
+func (s *syncServiceImpl) Resync(ctx context.Context, connectionID string) (common.CommonResponse, error) {
+	return restCall[common.CommonResponse](ctx, s.rest, restRequest{
+		operation: operationResyncConnection,
+		method:    http.MethodPost,
+		path:      restPath("connections", connectionID, "resync"),
+	})
+}
+
+// ResyncTables starts a historical sync of the given tables, keyed by schema name
+func (s *syncServiceImpl) ResyncTables(ctx context.Context, connectionID string, tables map[string][]string) (common.CommonResponse, error) {
+	return restCall[common.CommonResponse](ctx, s.rest, restRequest{
+		operation: operationResyncTables,
+		method:    http.MethodPost,
+		path:      restPath("connections", connectionID, "schemas", "tables", "resync"),
+		body:      tables,
+	})
+}
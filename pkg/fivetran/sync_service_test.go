@@ -0,0 +1,75 @@
+package fivetran
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSyncService(t *testing.T) {
+	tests := []struct {
+		name         string
+		call         func(client *Client) error
+		expectedPath string
+		expectedBody string
+	}{
+		{
+			name: "sync",
+			call: func(client *Client) error {
+				_, err := client.Sync.Sync(context.Background(), "connection_id", false)
+				return err
+			},
+			expectedPath: "/connections/connection_id/sync",
+			expectedBody: `{"force":false}`,
+		},
+		{
+			name: "resync",
+			call: func(client *Client) error {
+				_, err := client.Sync.Resync(context.Background(), "connection_id")
+				return err
+			},
+			expectedPath: "/connections/connection_id/resync",
+			expectedBody: "",
+		},
+		{
+			name: "resync tables",
+			call: func(client *Client) error {
+				_, err := client.Sync.ResyncTables(context.Background(), "connection_id", map[string][]string{"public": {"orders"}})
+				return err
+			},
+			expectedPath: "/connections/connection_id/schemas/tables/resync",
+			expectedBody: `{"public":["orders"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMethod, gotPath, gotBody string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				gotMethod, gotPath, gotBody = r.Method, r.URL.Path, string(body)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"code":"Success","message":"Sync has been successfully triggered"}`))
+			}))
+			defer server.Close()
+
+			client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			client.sdk.BaseURL(server.URL)
+
+			if err := tt.call(client); err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if gotMethod != http.MethodPost || gotPath != tt.expectedPath {
+				t.Errorf("expected POST %s, got %s %s", tt.expectedPath, gotMethod, gotPath)
+			}
+			if gotBody != tt.expectedBody {
+				t.Errorf("expected body %q, got %q", tt.expectedBody, gotBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package fivetran
+
+import (
+	"context"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/go-fivetran/teams"
+)
+
+type teamServiceImpl struct {
+	client *fivetran.Client
+	retry  RetryConfig
+}
+
+func newTeamService(client *fivetran.Client, retry RetryConfig) TeamsService {
+	return &teamServiceImpl{client: client, retry: retry}
+}
+
+// Team represents a Fivetran team and its account role
+type Team struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Role        string `json:"role"`
+}
+
+// ListTeams retrieves every team in the account
+func (s *teamServiceImpl) ListTeams(ctx context.Context) ([]teams.TeamData, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]teams.TeamData, string, error) {
+		service := s.client.NewTeamsList().Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (teams.TeamsListResponse, error) {
+			return callAPI(ctx, operationListTeams, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// GetTeam retrieves a team by ID
+func (s *teamServiceImpl) GetTeam(ctx context.Context, teamID string) (teams.TeamsDetailsResponse, error) {
+	service := s.client.NewTeamsDetails().TeamId(teamID)
+	return callWithRetry(ctx, s.retry, func() (teams.TeamsDetailsResponse, error) {
+		return callAPI(ctx, operationGetTeam, service.Do)
+	})
+}
+
+// CreateTeam creates a team with the given account role
+func (s *teamServiceImpl) CreateTeam(ctx context.Context, team *Team) (teams.TeamsCreateResponse, error) {
+	service := s.client.NewTeamsCreate().Name(team.Name).Role(team.Role)
+
+	if team.Description != "" {
+		service = service.Description(team.Description)
+	}
+
+	return callAPI(ctx, operationCreateTeam, service.Do)
+}
+
+// UpdateTeam updates a team's name, description and account role; empty fields are left unchanged
+func (s *teamServiceImpl) UpdateTeam(ctx context.Context, teamID string, team *Team) (teams.TeamsUpdateResponse, error) {
+	service := s.client.NewTeamsUpdate().TeamId(teamID)
+
+	if team.Name != "" {
+		service = service.Name(team.Name)
+	}
+
+	if team.Description != "" {
+		service = service.Description(team.Description)
+	}
+
+	if team.Role != "" {
+		service = service.Role(team.Role)
+	}
+
+	return callWithRetry(ctx, s.retry, func() (teams.TeamsUpdateResponse, error) {
+		return callAPI(ctx, operationUpdateTeam, service.Do)
+	})
+}
+
+// DeleteTeam deletes a team
+func (s *teamServiceImpl) DeleteTeam(ctx context.Context, teamID string) (common.CommonResponse, error) {
+	service := s.client.NewTeamsDelete().TeamId(teamID)
+	return callAPI(ctx, operationDeleteTeam, service.Do)
+}
+
+// ListTeamGroupMemberships retrieves the role of a team in every group it has access to
+func (s *teamServiceImpl) ListTeamGroupMemberships(ctx context.Context, teamID string) ([]teams.TeamGroupMembership, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]teams.TeamGroupMembership, string, error) {
+		service := s.client.NewTeamGroupMembershipsList().TeamId(teamID).Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (teams.TeamGroupMembershipsListResponse, error) {
+			return callAPI(ctx, operationListTeamGroupMemberships, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// AddTeamToGroup gives a team the given role in a group
+func (s *teamServiceImpl) AddTeamToGroup(ctx context.Context, teamID, groupID, role string) (teams.TeamGroupMembershipCreateResponse, error) {
+	service := s.client.NewTeamGroupMembershipCreate().TeamId(teamID).GroupId(groupID).Role(role)
+	return callAPI(ctx, operationAddTeamToGroup, service.Do)
+}
+
+// UpdateTeamGroupMembership changes a team's role in a group
+func (s *teamServiceImpl) UpdateTeamGroupMembership(ctx context.Context, teamID, groupID, role string) (common.CommonResponse, error) {
+	service := s.client.NewTeamGroupMembershipUpdate().TeamId(teamID).GroupId(groupID).Role(role)
+	return callWithRetry(ctx, s.retry, func() (common.CommonResponse, error) {
+		return callAPI(ctx, operationUpdateTeamGroupMembership, service.Do)
+	})
+}
+
+// RemoveTeamFromGroup removes a team's access to a group
+func (s *teamServiceImpl) RemoveTeamFromGroup(ctx context.Context, teamID, groupID string) (common.CommonResponse, error) {
+	service := s.client.NewTeamGroupMembershipDelete().TeamId(teamID).GroupId(groupID)
+	return callAPI(ctx, operationRemoveTeamFromGroup, service.Do)
+}
+
+// ListTeamConnectionMemberships retrieves the role of a team in every connection it has access to
+func (s *teamServiceImpl) ListTeamConnectionMemberships(ctx context.Context, teamID string) ([]teams.TeamConnectionMembership, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]teams.TeamConnectionMembership, string, error) {
+		service := s.client.NewTeamConnectionMembershipsList().TeamId(teamID).Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (teams.TeamConnectionMembershipsListResponse, error) {
+			return callAPI(ctx, operationListTeamConnectionMemberships, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// AddTeamToConnection gives a team the given role in a connection
+func (s *teamServiceImpl) AddTeamToConnection(ctx context.Context, teamID, connectionID, role string) (teams.TeamConnectionMembershipCreateResponse, error) {
+	service := s.client.NewTeamConnectionMembershipCreate().TeamId(teamID).ConnectionId(connectionID).Role(role)
+	return callAPI(ctx, operationAddTeamToConnection, service.Do)
+}
+
+// UpdateTeamConnectionMembership changes a team's role in a connection
+func (s *teamServiceImpl) UpdateTeamConnectionMembership(ctx context.Context, teamID, connectionID, role string) (common.CommonResponse, error) {
+	service := s.client.NewTeamConnectionMembershipUpdate().TeamId(teamID).ConnectionId(connectionID).Role(role)
+	return callWithRetry(ctx, s.retry, func() (common.CommonResponse, error) {
+		return callAPI(ctx, operationUpdateTeamConnectionMembership, service.Do)
+	})
+}
+
+// RemoveTeamFromConnection removes a team's access to a connection
+func (s *teamServiceImpl) RemoveTeamFromConnection(ctx context.Context, teamID, connectionID string) (common.CommonResponse, error) {
+	service := s.client.NewTeamConnectionMembershipDelete().TeamId(teamID).ConnectionId(connectionID)
+	return callAPI(ctx, operationRemoveTeamFromConnection, service.Do)
+}
@@ -0,0 +1,37 @@
+package fivetran
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdateTeamConnectionMembership(t *testing.T) {
+	var gotMethod, gotPath string
+	var gotBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"Success","message":"Connection membership has been updated"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	if _, err := client.Teams.UpdateTeamConnectionMembership(context.Background(), "team_id", "connection_id", "Connector Administrator"); err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if gotMethod != http.MethodPatch || gotPath != "/teams/team_id/connections/connection_id" {
+		t.Errorf("expected PATCH /teams/team_id/connections/connection_id, got %s %s", gotMethod, gotPath)
+	}
+	if gotBody["role"] != "Connector Administrator" {
+		t.Errorf("expected role in request, got %v", gotBody)
+	}
+}
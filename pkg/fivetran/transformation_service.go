@@ -0,0 +1,273 @@
+package fivetran
+
+import (
+	"context"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/go-fivetran/transformations"
+)
+
+type transformationServiceImpl struct {
+	client *fivetran.Client
+	retry  RetryConfig
+}
+
+func newTransformationService(client *fivetran.Client, retry RetryConfig) TransformationsService {
+	return &transformationServiceImpl{client: client, retry: retry}
+}
+
+// TransformationProject represents a Fivetran dbt project configuration
+type TransformationProject struct {
+	GroupID string `json:"group_id"`
+	// Type is the project type, such as DBT_GIT
+	Type   string          `json:"type"`
+	Config *map[string]any `json:"project_config"`
+}
+
+// TransformationProjectSummary is a dbt project as listed by Fivetran
+type TransformationProjectSummary struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	GroupID     string `json:"group_id"`
+	CreatedAt   string `json:"created_at"`
+	CreatedByID string `json:"created_by_id"`
+}
+
+// Transformation represents a Fivetran transformation configuration, either a dbt Core
+// transformation of a project or a Quickstart package transformation
+type Transformation struct {
+	// Type is the transformation type, DBT_CORE or QUICKSTART
+	Type     string          `json:"type"`
+	Paused   *bool           `json:"paused"`
+	Config   *map[string]any `json:"transformation_config"`
+	Schedule *map[string]any `json:"schedule"`
+}
+
+// TransformationSummary is a transformation as listed by Fivetran
+type TransformationSummary struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	Status      string `json:"status"`
+	Paused      bool   `json:"paused"`
+	ProjectID   string `json:"project_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	PackageName string `json:"package_name,omitempty"`
+}
+
+// QuickstartPackage is a Quickstart transformation package available for connector types
+type QuickstartPackage struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Version          string   `json:"version"`
+	ConnectorTypes   []string `json:"connector_types"`
+	OutputModelNames []string `json:"output_model_names"`
+}
+
+// ListTransformationProjects retrieves every dbt project in the account
+func (s *transformationServiceImpl) ListTransformationProjects(ctx context.Context) ([]TransformationProjectSummary, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]TransformationProjectSummary, string, error) {
+		service := s.client.NewTransformationProjectsList().Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (transformations.TransformationProjectsListResponse, error) {
+			return callAPI(ctx, operationListTransformationProjects, service.Do)
+		})
+
+		projects := make([]TransformationProjectSummary, 0, len(resp.Data.Items))
+		for _, item := range resp.Data.Items {
+			projects = append(projects, TransformationProjectSummary{
+				ID:          item.Id,
+				Type:        item.ProjectType,
+				GroupID:     item.GroupId,
+				CreatedAt:   item.CreatedAt,
+				CreatedByID: item.CreatedById,
+			})
+		}
+		return projects, resp.Data.NextCursor, err
+	})
+}
+
+// GetTransformationProject retrieves a dbt project by ID
+func (s *transformationServiceImpl) GetTransformationProject(ctx context.Context, projectID string) (transformations.TransformationProjectResponse, error) {
+	service := s.client.NewTransformationProjectDetails().ProjectId(projectID)
+	return callWithRetry(ctx, s.retry, func() (transformations.TransformationProjectResponse, error) {
+		return callAPI(ctx, operationGetTransformationProject, service.Do)
+	})
+}
+
+// CreateTransformationProject creates a dbt project for a group
+func (s *transformationServiceImpl) CreateTransformationProject(ctx context.Context, project *TransformationProject) (transformations.TransformationProjectCustomResponse, error) {
+	service := s.client.NewTransformationProjectCreate().
+		GroupId(project.GroupID).
+		ProjectType(project.Type).
+		RunTests(false)
+
+	if project.Config != nil {
+		service = service.ProjectConfigCustom(project.Config)
+	}
+
+	resp, err := callAPI(ctx, operationCreateTransformationProject, service.DoCustom)
+	return resp, scrubSubmittedError(err, project.Config, nil)
+}
+
+// UpdateTransformationProject updates an existing dbt project
+func (s *transformationServiceImpl) UpdateTransformationProject(ctx context.Context, projectID string, project *TransformationProject) (transformations.TransformationProjectCustomResponse, error) {
+	service := s.client.NewTransformationProjectUpdate().ProjectId(projectID).RunTests(false)
+
+	if project.Config != nil {
+		service = service.ProjectConfigCustom(project.Config)
+	}
+
+	return callWithRetry(ctx, s.retry, func() (transformations.TransformationProjectCustomResponse, error) {
+		resp, err := callAPI(ctx, operationUpdateTransformationProject, service.DoCustom)
+		return resp, scrubSubmittedError(err, project.Config, nil)
+	})
+}
+
+// DeleteTransformationProject deletes a dbt project
+func (s *transformationServiceImpl) DeleteTransformationProject(ctx context.Context, projectID string) (common.CommonResponse, error) {
+	service := s.client.NewTransformationProjectDelete().ProjectId(projectID)
+	return callAPI(ctx, operationDeleteTransformationProject, service.Do)
+}
+
+// TestTransformationProject runs the setup tests of a dbt project
+func (s *transformationServiceImpl) TestTransformationProject(ctx context.Context, projectID string) (transformations.TransformationProjectResponse, error) {
+	// The SDK has no constructor for this service, and names its project ID setter after external logging
+	service := (&transformations.TransformationProjectTestsService{HttpService: s.client.NewHttpService()}).
+		ExternalLoggingId(projectID)
+	return callAPI(ctx, operationTestTransformationProject, service.Do)
+}
+
+// ListTransformations retrieves every transformation in the account
+func (s *transformationServiceImpl) ListTransformations(ctx context.Context) ([]TransformationSummary, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]TransformationSummary, string, error) {
+		service := s.client.NewTransformationsList().Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (transformations.TransformationsListResponse, error) {
+			return callAPI(ctx, operationListTransformations, service.Do)
+		})
+
+		items := make([]TransformationSummary, 0, len(resp.Data.Items))
+		for _, item := range resp.Data.Items {
+			items = append(items, TransformationSummary{
+				ID:          item.Id,
+				Type:        item.ProjectType,
+				Status:      item.Status,
+				Paused:      item.Paused,
+				ProjectID:   item.TransformationConfig.ProjectId,
+				Name:        item.TransformationConfig.Name,
+				PackageName: item.TransformationConfig.PackageName,
+			})
+		}
+		return items, resp.Data.NextCursor, err
+	})
+}
+
+// GetTransformation retrieves a transformation by ID
+func (s *transformationServiceImpl) GetTransformation(ctx context.Context, transformationID string) (transformations.TransformationResponse, error) {
+	service := s.client.NewTransformationDetails().TransformationId(transformationID)
+	return callWithRetry(ctx, s.retry, func() (transformations.TransformationResponse, error) {
+		return callAPI(ctx, operationGetTransformation, service.Do)
+	})
+}
+
+// CreateTransformation creates a dbt Core or Quickstart transformation
+func (s *transformationServiceImpl) CreateTransformation(ctx context.Context, transformation *Transformation) (transformations.TransformationCustomResponse, error) {
+	service := s.client.NewTransformationCreate().ProjectType(transformation.Type)
+
+	if transformation.Paused != nil {
+		service = service.Paused(*transformation.Paused)
+	}
+
+	if transformation.Config != nil {
+		service = service.TransformationConfigCustom(transformation.Config)
+	}
+
+	if transformation.Schedule != nil {
+		service = service.TransformationScheduleCustom(transformation.Schedule)
+	}
+
+	return callAPI(ctx, operationCreateTransformation, service.DoCustom)
+}
+
+// UpdateTransformation updates an existing transformation
+func (s *transformationServiceImpl) UpdateTransformation(ctx context.Context, transformationID string, transformation *Transformation) (transformations.TransformationCustomResponse, error) {
+	service := s.client.NewTransformationUpdate().TransformationId(transformationID)
+
+	if transformation.Paused != nil {
+		service = service.Paused(*transformation.Paused)
+	}
+
+	if transformation.Config != nil {
+		service = service.TransformationConfigCustom(transformation.Config)
+	}
+
+	if transformation.Schedule != nil {
+		service = service.TransformationScheduleCustom(transformation.Schedule)
+	}
+
+	return callWithRetry(ctx, s.retry, func() (transformations.TransformationCustomResponse, error) {
+		return callAPI(ctx, operationUpdateTransformation, service.DoCustom)
+	})
+}
+
+// DeleteTransformation deletes a transformation
+func (s *transformationServiceImpl) DeleteTransformation(ctx context.Context, transformationID string) (common.CommonResponse, error) {
+	service := s.client.NewTransformationDelete().TransformationId(transformationID)
+	return callAPI(ctx, operationDeleteTransformation, service.Do)
+}
+
+// RunTransformation starts a run of a transformation
+func (s *transformationServiceImpl) RunTransformation(ctx context.Context, transformationID string) (common.CommonResponse, error) {
+	service := s.client.NewTransformationRun().TransformationId(transformationID)
+	return callAPI(ctx, operationRunTransformation, service.Do)
+}
+
+// CancelTransformation cancels the running run of a transformation
+func (s *transformationServiceImpl) CancelTransformation(ctx context.Context, transformationID string) (common.CommonResponse, error) {
+	service := s.client.NewTransformationCancel().TransformationId(transformationID)
+	return callAPI(ctx, operationCancelTransformation, service.Do)
+}
+
+// UpgradeTransformationPackage upgrades a Quickstart transformation to the latest package version
+func (s *transformationServiceImpl) UpgradeTransformationPackage(ctx context.Context, transformationID string) (common.CommonResponse, error) {
+	service := s.client.NewTransformationUpgradePackage().TransformationId(transformationID)
+	return callAPI(ctx, operationUpgradeTransformationPackage, service.Do)
+}
+
+// ListQuickstartPackages retrieves every available Quickstart transformation package
+func (s *transformationServiceImpl) ListQuickstartPackages(ctx context.Context) ([]QuickstartPackage, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]QuickstartPackage, string, error) {
+		service := s.client.NewQuickstartPackagesList().Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (transformations.QuickstartPackagesListResponse, error) {
+			return callAPI(ctx, operationListQuickstartPackages, service.Do)
+		})
+
+		packages := make([]QuickstartPackage, 0, len(resp.Data.Items))
+		for _, item := range resp.Data.Items {
+			packages = append(packages, QuickstartPackage{
+				ID:               item.Id,
+				Name:             item.Name,
+				Version:          item.Version,
+				ConnectorTypes:   item.ConnectorTypes,
+				OutputModelNames: item.OutputModelNames,
+			})
+		}
+		return packages, resp.Data.NextCursor, err
+	})
+}
+
+// GetQuickstartPackage retrieves a Quickstart transformation package by ID
+func (s *transformationServiceImpl) GetQuickstartPackage(ctx context.Context, packageID string) (transformations.QuickstartPackageResponse, error) {
+	service := s.client.NewQuickstartPackageDetails().PackageDefinitionId(packageID)
+	return callWithRetry(ctx, s.retry, func() (transformations.QuickstartPackageResponse, error) {
+		return callAPI(ctx, operationGetQuickstartPackage, service.Do)
+	})
+}
@@ -0,0 +1,49 @@
+package fivetran
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCreateTransformationProject(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":"InvalidInput","message":"Cannot clone with token ghp-project-token"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	_, err = client.Transformations.CreateTransformationProject(context.Background(), &TransformationProject{
+		GroupID: "group_id",
+		Type:    "DBT_GIT",
+		Config: &map[string]any{
+			"git_remote_url": "https://github.com/example/dbt.git",
+			"token":          "ghp-project-token",
+		},
+	})
+
+	if gotPath != "/transformation-projects" || gotBody["group_id"] != "group_id" || gotBody["run_tests"] != false {
+		t.Errorf("expected the project posted to /transformation-projects without tests, got %s %v", gotPath, gotBody)
+	}
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if strings.Contains(apiErr.Error(), "ghp-project-token") {
+		t.Errorf("expected the token to be masked, got %q", apiErr.Error())
+	}
+}
@@ -0,0 +1,55 @@
+package fivetran
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+// HTTPConfig configures the HTTP client used for Fivetran API requests
+type HTTPConfig struct {
+	// Timeout bounds each request, including reading the response body; zero means no timeout
+	Timeout time.Duration
+	// KeepAlive is the interval between TCP keep-alive probes; negative disables keep-alives
+	KeepAlive time.Duration
+	// IdleConnTimeout is how long an idle connection is kept open for reuse
+	IdleConnTimeout time.Duration
+	// MaxIdleConnsPerHost is the number of idle connections kept open for reuse
+	MaxIdleConnsPerHost int
+	// WrapTransport, when set, wraps the transport, e.g. to inject metrics or logging middleware
+	WrapTransport func(http.RoundTripper) http.RoundTripper
+}
+
+// DefaultHTTPConfig returns the HTTP settings used by NewClient
+func DefaultHTTPConfig() HTTPConfig {
+	return HTTPConfig{
+		Timeout:             30 * time.Second,
+		KeepAlive:           30 * time.Second,
+		IdleConnTimeout:     90 * time.Second,
+		MaxIdleConnsPerHost: 10,
+	}
+}
+
+// newHTTPClient builds the HTTP client for Fivetran API requests
+func newHTTPClient(cfg HTTPConfig) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: cfg.KeepAlive,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.DisableKeepAlives = cfg.KeepAlive < 0
+	transport.IdleConnTimeout = cfg.IdleConnTimeout
+	transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+
+	var roundTripper http.RoundTripper = transport
+	if cfg.WrapTransport != nil {
+		roundTripper = cfg.WrapTransport(roundTripper)
+	}
+
+	return &http.Client{
+		Timeout:   cfg.Timeout,
+		Transport: roundTripper,
+	}
+}
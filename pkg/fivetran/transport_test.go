@@ -0,0 +1,53 @@
+package fivetran
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestHTTPConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/connections/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"Success","data":{"id":"connection_id"}}`))
+	}))
+	defer server.Close()
+
+	requests := 0
+	cfg := DefaultClientConfig()
+	cfg.Retry = RetryConfig{MaxAttempts: 1}
+	cfg.HTTP.Timeout = 50 * time.Millisecond
+	cfg.HTTP.WrapTransport = func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			return next.RoundTrip(req)
+		})
+	}
+	client, err := NewClientWithConfig("key", "secret", cfg)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	if _, err := client.Connections.GetConnection(context.Background(), "fast"); err != nil {
+		t.Errorf("expected no error but got: %v", err)
+	}
+	if _, err := client.Connections.GetConnection(context.Background(), "slow"); err == nil {
+		t.Errorf("expected the slow request to time out")
+	}
+	if requests != 2 {
+		t.Errorf("expected the wrapped transport to see 2 requests, got %d", requests)
+	}
+}
@@ -0,0 +1,142 @@
+package fivetran
+
+import (
+	"context"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/go-fivetran/users"
+)
+
+type userServiceImpl struct {
+	client *fivetran.Client
+	retry  RetryConfig
+}
+
+func newUserService(client *fivetran.Client, retry RetryConfig) UsersService {
+	return &userServiceImpl{client: client, retry: retry}
+}
+
+// User represents a Fivetran user's profile and account role
+type User struct {
+	// Email is only set when inviting a user; it cannot be changed afterwards
+	Email      string `json:"email"`
+	GivenName  string `json:"given_name"`
+	FamilyName string `json:"family_name"`
+	Phone      string `json:"phone,omitempty"`
+	Picture    string `json:"picture,omitempty"`
+	Role       string `json:"role,omitempty"`
+}
+
+// ListUsers retrieves every user in the account
+func (s *userServiceImpl) ListUsers(ctx context.Context) ([]users.UserDetailsData, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]users.UserDetailsData, string, error) {
+		service := s.client.NewUsersList().Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (users.UsersListResponse, error) {
+			return callAPI(ctx, operationListUsers, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// GetUser retrieves a user by ID
+func (s *userServiceImpl) GetUser(ctx context.Context, userID string) (users.UserDetailsResponse, error) {
+	service := s.client.NewUserDetails().UserID(userID)
+	return callWithRetry(ctx, s.retry, func() (users.UserDetailsResponse, error) {
+		return callAPI(ctx, operationGetUser, service.Do)
+	})
+}
+
+// InviteUser invites a new user to the account
+func (s *userServiceImpl) InviteUser(ctx context.Context, user *User) (users.UserDetailsResponse, error) {
+	service := s.client.NewUserInvite().
+		Email(user.Email).
+		GivenName(user.GivenName).
+		FamilyName(user.FamilyName)
+
+	if user.Phone != "" {
+		service = service.Phone(user.Phone)
+	}
+
+	if user.Picture != "" {
+		service = service.Picture(user.Picture)
+	}
+
+	if user.Role != "" {
+		service = service.Role(user.Role)
+	}
+
+	return callAPI(ctx, operationInviteUser, service.Do)
+}
+
+// UpdateUser updates a user's profile and account role; empty fields are left unchanged
+func (s *userServiceImpl) UpdateUser(ctx context.Context, userID string, user *User) (users.UserDetailsResponse, error) {
+	service := s.client.NewUserUpdate().UserID(userID)
+
+	if user.GivenName != "" {
+		service = service.GivenName(user.GivenName)
+	}
+
+	if user.FamilyName != "" {
+		service = service.FamilyName(user.FamilyName)
+	}
+
+	if user.Phone != "" {
+		service = service.Phone(user.Phone)
+	}
+
+	if user.Picture != "" {
+		service = service.Picture(user.Picture)
+	}
+
+	if user.Role != "" {
+		service = service.Role(user.Role)
+	}
+
+	return callWithRetry(ctx, s.retry, func() (users.UserDetailsResponse, error) {
+		return callAPI(ctx, operationUpdateUser, service.Do)
+	})
+}
+
+// DeleteUser removes a user from the account
+func (s *userServiceImpl) DeleteUser(ctx context.Context, userID string) (common.CommonResponse, error) {
+	service := s.client.NewUserDelete().UserID(userID)
+	return callAPI(ctx, operationDeleteUser, service.Do)
+}
+
+// ListUserGroupMemberships retrieves every group membership of a user
+func (s *userServiceImpl) ListUserGroupMemberships(ctx context.Context, userID string) ([]users.UserGroupMembership, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]users.UserGroupMembership, string, error) {
+		service := s.client.NewUserGroupMembershipsList().UserId(userID).Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (users.UserGroupMembershipsListResponse, error) {
+			return callAPI(ctx, operationListUserGroupMemberships, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// AddUserToGroup adds a user to a group with the given role
+func (s *userServiceImpl) AddUserToGroup(ctx context.Context, userID, groupID, role string) (users.UserGroupMembershipCreateResponse, error) {
+	service := s.client.NewUserGroupMembershipCreate().UserId(userID).GroupId(groupID).Role(role)
+	return callAPI(ctx, operationAddUserToGroup, service.Do)
+}
+
+// UpdateUserGroupMembership changes a user's role in a group
+func (s *userServiceImpl) UpdateUserGroupMembership(ctx context.Context, userID, groupID, role string) (common.CommonResponse, error) {
+	service := s.client.NewUserGroupMembershipUpdate().UserId(userID).GroupId(groupID).Role(role)
+	return callWithRetry(ctx, s.retry, func() (common.CommonResponse, error) {
+		return callAPI(ctx, operationUpdateUserGroupMembership, service.Do)
+	})
+}
+
+// RemoveUserFromGroup removes a user from a group
+func (s *userServiceImpl) RemoveUserFromGroup(ctx context.Context, userID, groupID string) (common.CommonResponse, error) {
+	service := s.client.NewUserGroupMembershipDelete().UserId(userID).GroupId(groupID)
+	return callAPI(ctx, operationRemoveUserFromGroup, service.Do)
+}
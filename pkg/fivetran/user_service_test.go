@@ -0,0 +1,42 @@
+package fivetran
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAddUserToGroup(t *testing.T) {
+	var gotMethod, gotPath string
+	var gotBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"code":"Success","data":{"id":"group_id","role":"Destination Reviewer"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	resp, err := client.Users.AddUserToGroup(context.Background(), "user_id", "group_id", "Destination Reviewer")
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if gotMethod != http.MethodPost || gotPath != "/users/user_id/groups" {
+		t.Errorf("expected POST /users/user_id/groups, got %s %s", gotMethod, gotPath)
+	}
+	if gotBody["id"] != "group_id" || gotBody["role"] != "Destination Reviewer" {
+		t.Errorf("expected group and role in request, got %v", gotBody)
+	}
+	if resp.Data.GroupId != "group_id" || resp.Data.Role != "Destination Reviewer" {
+		t.Errorf("expected membership of group_id, got %+v", resp.Data)
+	}
+}
@@ -0,0 +1,215 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// newDynamicCredsServer emulates a database secrets engine that issues new credentials on every read,
+// counting the credentials issued and the leases revoked
+func newDynamicCredsServer(t *testing.T) (*httptest.Server, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+
+	var issued, revoked atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/sys/leases/revoke" {
+			revoked.Add(1)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.URL.Path != "/v1/database/creds/readonly" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		n := issued.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"lease_id":       "database/creds/readonly/lease-" + strconv.Itoa(int(n)),
+			"lease_duration": 3600,
+			"renewable":      true,
+			"data": map[string]any{
+				"username": "v-user-" + strconv.Itoa(int(n)),
+				"password": "generated-password",
+			},
+		})
+	}))
+	return server, &issued, &revoked
+}
+
+// newDynamicCredsClient returns a client for the emulated secrets engine
+func newDynamicCredsClient(t *testing.T, server *httptest.Server) *vaultpkg.VaultClient {
+	t.Helper()
+
+	config := vaultapi.DefaultConfig()
+	config.Address = server.URL
+	client, err := vaultapi.NewClient(config)
+	if err != nil {
+		t.Fatalf("failed to create vault client: %v", err)
+	}
+	return &vaultpkg.VaultClient{
+		Client: client,
+		Config: &vaultpkg.ClientConfig{MountPath: "apps", KVVersion: vaultpkg.KVVersion2},
+		Cache:  vaultpkg.NewSecretCache(time.Hour, 10),
+	}
+}
+
+func TestResolveSecretsDynamic(t *testing.T) {
+	server, issued, _ := newDynamicCredsServer(t)
+	defer server.Close()
+	vaultClient := newDynamicCredsClient(t, server)
+
+	tests := []struct {
+		name        string
+		input       string
+		expected    map[string]any
+		expectError bool
+	}{
+		{
+			name:  "username and password from one lease",
+			input: `{"user":"vaultDynamic:database/creds/readonly#username","password":"vaultDynamic:database/creds/readonly#password"}`,
+			expected: map[string]any{
+				"user":     "v-user-1",
+				"password": "generated-password",
+			},
+		},
+		{
+			name:     "template",
+			input:    `{"url":"postgres://{{vaultDynamic \"database/creds/readonly\" \"username\"}}@db:5432/app"}`,
+			expected: map[string]any{"url": "postgres://v-user-2@db:5432/app"},
+		},
+		{
+			name:        "missing path",
+			input:       `{"user":"vaultDynamic:database/creds/missing#username"}`,
+			expectError: true,
+		},
+		{
+			name:        "missing key separator",
+			input:       `{"user":"vaultDynamic:database/creds/readonly"}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawExt := &runtime.RawExtension{Raw: []byte(tt.input)}
+
+			err := ResolveSecrets(context.Background(), vaultClient, rawExt)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			var result map[string]any
+			if err := json.Unmarshal(rawExt.Raw, &result); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("result mismatch:\nexpected: %+v\ngot:      %+v", tt.expected, result)
+			}
+		})
+	}
+
+	if got := issued.Load(); got != 2 {
+		t.Errorf("expected credentials to be issued once per resolution, got %d reads", got)
+	}
+	if vaultClient.Cache.Len() != 0 {
+		t.Errorf("expected dynamic credentials not to be cached, got %d entries", vaultClient.Cache.Len())
+	}
+}
+
+func TestResolveDynamicLeases(t *testing.T) {
+	server, _, revoked := newDynamicCredsServer(t)
+	defer server.Close()
+	vaultClient := newDynamicCredsClient(t, server)
+
+	rawExt := &runtime.RawExtension{Raw: []byte(`{"user":"vaultDynamic:database/creds/readonly#username","password":"vaultDynamic:database/creds/readonly#password"}`)}
+	result, err := Resolve(context.Background(), vaultClient, rawExt)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+
+	expected := []SecretLease{{
+		Path:          "database/creds/readonly",
+		LeaseID:       "database/creds/readonly/lease-1",
+		LeaseDuration: 3600,
+		Renewable:     true,
+	}}
+	if !reflect.DeepEqual(result.Leases, expected) {
+		t.Errorf("expected leases %+v, got %+v", expected, result.Leases)
+	}
+	if got := revoked.Load(); got != 0 {
+		t.Errorf("expected no leases to be revoked, got %d", got)
+	}
+
+	// A failed resolution revokes the credentials it issued
+	rawExt = &runtime.RawExtension{Raw: []byte(`{"user":"vaultDynamic:database/creds/readonly#username","missing":"vaultDynamic:database/creds/readonly#missing"}`)}
+	if _, err := Resolve(context.Background(), vaultClient, rawExt); err == nil {
+		t.Fatalf("expected error for missing key but got none")
+	}
+	if got := revoked.Load(); got != 1 {
+		t.Errorf("expected the issued lease to be revoked, got %d revocations", got)
+	}
+}
+
+func TestRegistryResolveAllDynamic(t *testing.T) {
+	server, issued, revoked := newDynamicCredsServer(t)
+	defer server.Close()
+	vaultClient := newDynamicCredsClient(t, server)
+	registry := NewRegistry()
+
+	config := &runtime.RawExtension{Raw: []byte(`{"user":"vaultDynamic:database/creds/readonly#username"}`)}
+	auth := &runtime.RawExtension{Raw: []byte(`{"password":"vaultDynamic:database/creds/readonly#password"}`)}
+	results, err := registry.ResolveAll(context.Background(), StaticClient(vaultClient), config, nil, auth)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+
+	if got := issued.Load(); got != 1 {
+		t.Errorf("expected config and auth to share one set of credentials, got %d reads", got)
+	}
+	if string(config.Raw) != `{"user":"v-user-1"}` {
+		t.Errorf("unexpected resolved config: %s", config.Raw)
+	}
+	if string(auth.Raw) != `{"password":"generated-password"}` {
+		t.Errorf("unexpected resolved auth: %s", auth.Raw)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected a result per configuration, got %d", len(results))
+	}
+	if len(results[0].Leases) != 1 || len(results[1].Leases) != 0 || len(results[2].Leases) != 0 {
+		t.Errorf("expected the lease to be reported once, with config, got %+v", results)
+	}
+	if !reflect.DeepEqual(results[2].References, []ResolvedReference{{KeyPath: "password", Reference: "vaultDynamic:database/creds/readonly#password"}}) {
+		t.Errorf("expected auth references to be reported with auth, got %+v", results[2].References)
+	}
+
+	// A failure in auth revokes the credentials issued for config and leaves both unresolved
+	config = &runtime.RawExtension{Raw: []byte(`{"user":"vaultDynamic:database/creds/readonly#username"}`)}
+	auth = &runtime.RawExtension{Raw: []byte(`{"password":"vaultDynamic:database/creds/readonly#missing"}`)}
+	if _, err := registry.ResolveAll(context.Background(), StaticClient(vaultClient), config, auth); err == nil {
+		t.Fatalf("expected error for missing key but got none")
+	}
+	if got := revoked.Load(); got != 1 {
+		t.Errorf("expected the issued lease to be revoked, got %d revocations", got)
+	}
+	if string(config.Raw) != `{"user":"vaultDynamic:database/creds/readonly#username"}` {
+		t.Errorf("expected config to be left unresolved, got %s", config.Raw)
+	}
+}
@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrEnvNotSet     = errors.New("environment variable is not set")
+	ErrEnvNotAllowed = errors.New("environment variable is not allowed in placeholders")
+)
+
+// DefaultEnvPrefix is the prefix the operator environment variables read by ${ENV:NAME}
+// placeholders must have, so connector authors cannot read the operator's own credentials
+const DefaultEnvPrefix = "FIVETRAN_CONFIG_"
+
+// envPlaceholderPattern matches ${ENV:NAME} placeholders
+var envPlaceholderPattern = regexp.MustCompile(`\$\{ENV:([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// EnvSource looks up the values of ${ENV:NAME} placeholders
+type EnvSource interface {
+	LookupEnv(ctx context.Context, name string) (string, bool, error)
+}
+
+// SetEnvSource sets a source of ${ENV:NAME} placeholders that takes precedence over the operator's
+// environment, such as a designated ConfigMap. Its values are not treated as secrets, and its names
+// are not restricted to the environment prefix. It is not safe to call concurrently with Resolve.
+func (r *Registry) SetEnvSource(source EnvSource) {
+	r.env = source
+}
+
+// SetEnvPrefix sets the prefix of the operator environment variables ${ENV:NAME} placeholders may
+// read, DefaultEnvPrefix by default. An empty prefix allows every variable, including the
+// operator's credentials. It is not safe to call concurrently with Resolve.
+func (r *Registry) SetEnvPrefix(prefix string) {
+	r.envPrefix = prefix
+}
+
+// lookupEnv returns the value of a placeholder from the env source, or else from the operator's
+// environment when name has the environment prefix. Values read from the environment are
+// sensitive, since the environment also holds credentials.
+func (r *Registry) lookupEnv(ctx context.Context, name string) (value string, ok, sensitive bool, err error) {
+	prefix := DefaultEnvPrefix
+	if r != nil {
+		prefix = r.envPrefix
+		if r.env != nil {
+			value, ok, err := r.env.LookupEnv(ctx, name)
+			if err != nil || ok {
+				return value, ok, false, err
+			}
+		}
+	}
+
+	if !strings.HasPrefix(name, prefix) {
+		return "", false, false, fmt.Errorf("%w: %s does not start with %s", ErrEnvNotAllowed, name, prefix)
+	}
+	value, ok = os.LookupEnv(name)
+	return value, ok, true, nil
+}
+
+// substituteEnv replaces ${ENV:NAME} placeholders in a string value, recording values read from
+// the operator's environment as sensitive. Placeholders are substituted before references are
+// resolved, so they can also parameterize reference paths.
+func substituteEnv(ctx context.Context, res *resolution, value, keyPath string) (string, error) {
+	if !envPlaceholderPattern.MatchString(value) {
+		return value, nil
+	}
+
+	var substituteErr error
+	result := envPlaceholderPattern.ReplaceAllStringFunc(value, func(placeholder string) string {
+		if substituteErr != nil {
+			return placeholder
+		}
+		name := envPlaceholderPattern.FindStringSubmatch(placeholder)[1]
+		envValue, ok, sensitive, err := res.registry.lookupEnv(ctx, name)
+		switch {
+		case errors.Is(err, ErrEnvNotAllowed):
+			substituteErr = &VaultError{Err: err, Retryable: false, KeyPath: keyPath, VaultRef: placeholder}
+		case err != nil:
+			substituteErr = NewResolverError(keyPath, placeholder, err)
+		case !ok:
+			substituteErr = &VaultError{
+				Err:       fmt.Errorf("%w: %s", ErrEnvNotSet, name),
+				Retryable: false,
+				KeyPath:   keyPath,
+				VaultRef:  placeholder,
+			}
+		case sensitive:
+			res.recordSensitive(envValue)
+		}
+		return envValue
+	})
+	if substituteErr != nil {
+		return "", substituteErr
+	}
+	return result, nil
+}
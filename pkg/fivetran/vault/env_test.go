@@ -0,0 +1,135 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+// mapEnvSource serves placeholder values from a map
+type mapEnvSource map[string]string
+
+func (m mapEnvSource) LookupEnv(_ context.Context, name string) (string, bool, error) {
+	value, ok := m[name]
+	return value, ok, nil
+}
+
+func TestResolveEnvPlaceholders(t *testing.T) {
+	t.Setenv("FIVETRAN_CONFIG_REGION", "us-east-1")
+	t.Setenv("FIVETRAN_API_SECRET", "operator-api-secret")
+
+	registry := NewRegistry()
+	if err := registry.Register("fake", fakeResolver(map[string]map[string]any{
+		"db-prod": {"host": "prod.example.com"},
+	})); err != nil {
+		t.Fatalf("failed to register resolver: %v", err)
+	}
+	vaultClient := &vaultpkg.VaultClient{Config: &vaultpkg.ClientConfig{MountPath: "apps"}}
+
+	tests := []struct {
+		name            string
+		env             EnvSource
+		prefix          string
+		input           string
+		expected        map[string]any
+		expectSensitive []string
+		expectError     error
+	}{
+		{
+			name:            "operator environment",
+			input:           `{"schema_prefix":"raw_${ENV:FIVETRAN_CONFIG_REGION}"}`,
+			expected:        map[string]any{"schema_prefix": "raw_us-east-1"},
+			expectSensitive: []string{"us-east-1"},
+		},
+		{
+			name:        "variable without the prefix",
+			input:       `{"password":"${ENV:FIVETRAN_API_SECRET}"}`,
+			expectError: ErrEnvNotAllowed,
+		},
+		{
+			name:        "variable without the prefix missing from the env source",
+			env:         mapEnvSource{"REGION": "eu-west-1"},
+			input:       `{"password":"${ENV:FIVETRAN_API_SECRET}"}`,
+			expectError: ErrEnvNotAllowed,
+		},
+		{
+			name:        "variable without a custom prefix",
+			prefix:      "CLUSTER_",
+			input:       `{"schema_prefix":"raw_${ENV:FIVETRAN_CONFIG_REGION}"}`,
+			expectError: ErrEnvNotAllowed,
+		},
+		{
+			name:            "env source falls back to prefixed variables",
+			env:             mapEnvSource{"ENVIRONMENT": "prod"},
+			input:           `{"schema_prefix":"${ENV:ENVIRONMENT}_${ENV:FIVETRAN_CONFIG_REGION}"}`,
+			expected:        map[string]any{"schema_prefix": "prod_us-east-1"},
+			expectSensitive: []string{"us-east-1"},
+		},
+		{
+			name:     "multiple placeholders",
+			env:      mapEnvSource{"REGION": "eu-west-1", "ENVIRONMENT": "prod"},
+			input:    `{"schema_prefix":"${ENV:ENVIRONMENT}_${ENV:REGION}","port":5432}`,
+			expected: map[string]any{"schema_prefix": "prod_eu-west-1", "port": float64(5432)},
+		},
+		{
+			name:            "placeholder in reference",
+			env:             mapEnvSource{"ENVIRONMENT": "prod"},
+			input:           `{"host":"fake:db-${ENV:ENVIRONMENT}#host"}`,
+			expected:        map[string]any{"host": "prod.example.com"},
+			expectSensitive: []string{"prod.example.com"},
+		},
+		{
+			name:     "other dollar values are untouched",
+			input:    `{"query":"SELECT '${value}', $1"}`,
+			expected: map[string]any{"query": "SELECT '${value}', $1"},
+		},
+		{
+			name:        "unset variable",
+			env:         mapEnvSource{},
+			input:       `{"schema_prefix":"raw_${ENV:FIVETRAN_CONFIG_UNSET}"}`,
+			expectError: ErrEnvNotSet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry.SetEnvSource(tt.env)
+			registry.SetEnvPrefix(DefaultEnvPrefix)
+			if tt.prefix != "" {
+				registry.SetEnvPrefix(tt.prefix)
+			}
+			rawExt := &runtime.RawExtension{Raw: []byte(tt.input)}
+
+			result, err := registry.Resolve(context.Background(), vaultClient, rawExt)
+			if tt.expectError != nil {
+				if !errors.Is(err, tt.expectError) {
+					t.Fatalf("expected error %v, got: %v", tt.expectError, err)
+				}
+				if IsRetryableError(err) {
+					t.Errorf("expected error to not be retryable: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			var config map[string]any
+			if err := json.Unmarshal(rawExt.Raw, &config); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if !reflect.DeepEqual(config, tt.expected) {
+				t.Errorf("result mismatch:\nexpected: %+v\ngot:      %+v", tt.expected, config)
+			}
+			if !reflect.DeepEqual(result.SensitiveValues, tt.expectSensitive) {
+				t.Errorf("expected sensitive values %v, got %v", tt.expectSensitive, result.SensitiveValues)
+			}
+		})
+	}
+}
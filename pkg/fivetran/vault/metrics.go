@@ -0,0 +1,47 @@
+package vault
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// tracer creates the spans of Vault reads
+var tracer = otel.Tracer("github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault")
+
+// Secrets engines labelling secretReadDuration
+const (
+	engineKV      = "kv"
+	engineDynamic = "dynamic"
+)
+
+var secretReadDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "fivetran_operator_vault_secret_read_duration_seconds",
+	Help:    "Latency of reading a secret from Vault by secrets engine, including retries, for reads not served from the cache.",
+	Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+}, []string{"engine"})
+
+func init() {
+	metrics.Registry.MustRegister(secretReadDuration)
+}
+
+// startSecretRead starts the span of a Vault read of path. The returned function ends it with the
+// read's error and records the read's latency.
+func startSecretRead(ctx context.Context, engine, path string) (context.Context, func(err error)) {
+	ctx, span := tracer.Start(ctx, "vault.read", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("vault.engine", engine), attribute.String("vault.path", path)))
+	start := time.Now()
+	return ctx, func(err error) {
+		secretReadDuration.WithLabelValues(engine).Observe(time.Since(start).Seconds())
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+// maxParallelLookups bounds the number of concurrent Vault reads per resolution
+const maxParallelLookups = 8
+
+// pendingLookup is a distinct secret to read, with the first place it is referenced for errors
+type pendingLookup struct {
+	ref      vaultReference
+	keyPath  string
+	vaultRef string
+}
+
+// prefetch reads every distinct secret referenced in data concurrently and fills the per-call
+// cache, so the walk that follows resolves references without waiting on Vault. Secrets already in
+// the per-call cache, read for a configuration resolved earlier, are not read again. The first
+// failure cancels the remaining reads. Invalid references and templates are left to the walk,
+// which reports them with their key path.
+func prefetch(ctx context.Context, vaultClient *vaultpkg.VaultClient, res *resolution, data any) error {
+	lookups := make(map[string]pendingLookup)
+	collectLookups(vaultClient, data, "", lookups)
+	if len(lookups) < 2 {
+		return nil
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxParallelLookups)
+
+	var mu sync.Mutex
+	for cacheKey, lookup := range lookups {
+		if _, ok := res.cache[cacheKey]; ok {
+			continue
+		}
+		if cached, ok := getSharedCache(vaultClient, lookup.ref); ok {
+			mu.Lock()
+			res.store(cacheKey, lookup.ref, cached)
+			mu.Unlock()
+			continue
+		}
+
+		g.Go(func() error {
+			cached, err := fetchPathData(gctx, vaultClient, lookup.ref, lookup.keyPath, lookup.vaultRef)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			res.store(cacheKey, lookup.ref, cached)
+			return nil
+		})
+	}
+
+	return g.Wait()
+}
+
+// collectLookups walks data and records the distinct secrets its vault references read
+func collectLookups(vaultClient *vaultpkg.VaultClient, data any, keyPath string, lookups map[string]pendingLookup) {
+	switch v := data.(type) {
+	case map[string]any:
+		for key, value := range v {
+			currentPath := buildKeyPath(keyPath, key)
+			if key == SecretRefKey {
+				if ref, ok := value.(string); ok && !strings.Contains(ref, "#") {
+					value = ref + "#" + wholeSecretKey
+				}
+			}
+			collectLookups(vaultClient, value, currentPath, lookups)
+		}
+	case []any:
+		for i, item := range v {
+			collectLookups(vaultClient, item, fmt.Sprintf("%s[%d]", keyPath, i), lookups)
+		}
+	case string:
+		// References parameterized by ${ENV:NAME} placeholders are read once substituted
+		if isTemplate(v) || !isReference(v) || envPlaceholderPattern.MatchString(v) {
+			return
+		}
+		refValue, _, err := splitTransforms(v)
+		if err != nil {
+			return
+		}
+		ref, err := parseReference(refValue)
+		if err != nil {
+			return
+		}
+		if ref.Mount == "" && !ref.Dynamic {
+			ref.Mount = vaultClient.Config.MountPath
+		}
+		if _, ok := lookups[ref.cacheKey()]; !ok {
+			lookups[ref.cacheKey()] = pendingLookup{ref: ref, keyPath: keyPath, vaultRef: v}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCollectLookups(t *testing.T) {
+	vaultClient := &vaultpkg.VaultClient{Config: &vaultpkg.ClientConfig{MountPath: "apps"}}
+
+	var data any
+	input := `{
+		"a": "vault:db#user",
+		"b": "vault:db#password|trim",
+		"c": ["vault://legacy/db#user", "plain"],
+		"d": {"vaultSecretRef": "vault:oauth"},
+		"e": "vault:db#user@2",
+		"f": "{{vault \"templated\" \"key\"}}",
+		"g": "vault:invalid"
+	}`
+	if err := json.Unmarshal([]byte(input), &data); err != nil {
+		t.Fatalf("failed to unmarshal input: %v", err)
+	}
+
+	lookups := make(map[string]pendingLookup)
+	collectLookups(vaultClient, data, "", lookups)
+
+	keys := make([]string, 0, len(lookups))
+	for key := range lookups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	expected := []string{"apps/db", "apps/db@2", "apps/oauth", "legacy/db"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected lookups %v, got %v", expected, keys)
+	}
+}
+
+func TestResolveSecretsParallel(t *testing.T) {
+	client, cleanup := setupTestVault(t)
+	defer cleanup()
+
+	config := make(map[string]any)
+	expected := make(map[string]any)
+	for i := range 20 {
+		path := fmt.Sprintf("parallel/secret-%d", i)
+		if _, err := client.KVv2("apps").Put(context.Background(), path, map[string]any{"value": path}); err != nil {
+			t.Fatalf("failed to write secret: %v", err)
+		}
+		config[fmt.Sprintf("key%d", i)] = "vault:" + path + "#value"
+		expected[fmt.Sprintf("key%d", i)] = path
+	}
+
+	vaultClient := &vaultpkg.VaultClient{
+		Client: client,
+		Config: &vaultpkg.ClientConfig{MountPath: "apps"},
+	}
+
+	raw, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal config: %v", err)
+	}
+	rawExt := &runtime.RawExtension{Raw: raw}
+
+	versions, err := ResolveSecretsWithVersions(context.Background(), vaultClient, rawExt)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if len(versions) != 20 {
+		t.Errorf("expected 20 secret versions, got %d", len(versions))
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal(rawExt.Raw, &result); err != nil {
+		t.Fatalf("failed to unmarshal result: %v", err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("result mismatch:\nexpected: %+v\ngot:      %+v", expected, result)
+	}
+
+	// A missing path fails the whole resolution
+	config["missing"] = "vault:parallel/missing#value"
+	raw, err = json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal config: %v", err)
+	}
+	if err := ResolveSecrets(context.Background(), vaultClient, &runtime.RawExtension{Raw: raw}); err == nil {
+		t.Errorf("expected error for missing secret but got none")
+	}
+}
@@ -0,0 +1,202 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+var (
+	ErrSchemeAlreadyRegistered = errors.New("a secret resolver is already registered for scheme")
+	ErrInvalidScheme           = errors.New("invalid secret reference scheme")
+)
+
+// SecretResolver resolves the references of one URI scheme, such as secret:namespace/name#key
+type SecretResolver interface {
+	// Resolve returns the value a reference points to. The reference is passed without its
+	// scheme and transforms, e.g. namespace/name#key.
+	Resolve(ctx context.Context, ref string) (any, error)
+}
+
+// nonSensitiveResolver is implemented by resolvers of values that are not secret, such as ConfigMap
+// entries, so they are not redacted from output
+type nonSensitiveResolver interface {
+	NonSensitive() bool
+}
+
+// SecretResolverFunc adapts a function to the SecretResolver interface
+type SecretResolverFunc func(ctx context.Context, ref string) (any, error)
+
+// Resolve calls f(ctx, ref)
+func (f SecretResolverFunc) Resolve(ctx context.Context, ref string) (any, error) {
+	return f(ctx, ref)
+}
+
+// ClientFunc returns the Vault client that vault: and vaultDynamic: references are read with. It
+// is only called when a configuration references Vault, so configurations that do not are resolved
+// without Vault. Without a ClientFunc, Vault references fail with ErrVaultNotConfigured.
+type ClientFunc func(ctx context.Context) (*vaultpkg.VaultClient, error)
+
+// StaticClient returns a ClientFunc for an existing client, which may be nil
+func StaticClient(vaultClient *vaultpkg.VaultClient) ClientFunc {
+	if vaultClient == nil {
+		return nil
+	}
+	return func(context.Context) (*vaultpkg.VaultClient, error) {
+		return vaultClient, nil
+	}
+}
+
+// Registry maps URI schemes to the resolvers handling them. The vault: and vaultDynamic: schemes
+// are built in, since Vault references also record secret versions and leases, and are read with
+// the client of the ClientFunc given to ResolveAll. A nil Registry resolves Vault references only.
+type Registry struct {
+	resolvers map[string]SecretResolver
+	env       EnvSource
+	envPrefix string
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{resolvers: make(map[string]SecretResolver), envPrefix: DefaultEnvPrefix}
+}
+
+// Register adds the resolver for a scheme, given without its trailing colon. It is not safe to
+// call concurrently with Resolve, so resolvers are expected to be registered at startup.
+func (r *Registry) Register(scheme string, resolver SecretResolver) error {
+	if scheme == "" || strings.ContainsAny(scheme, ":/#|{} ") {
+		return fmt.Errorf("%w '%s'", ErrInvalidScheme, scheme)
+	}
+	if scheme+":" == referencePrefix || scheme+":" == dynamicReferencePrefix {
+		return fmt.Errorf("%w '%s'", ErrSchemeAlreadyRegistered, scheme)
+	}
+	if _, exists := r.resolvers[scheme]; exists {
+		return fmt.Errorf("%w '%s'", ErrSchemeAlreadyRegistered, scheme)
+	}
+	r.resolvers[scheme] = resolver
+	return nil
+}
+
+// Schemes returns the registered schemes, excluding the built-in Vault schemes
+func (r *Registry) Schemes() []string {
+	if r == nil {
+		return nil
+	}
+	schemes := make([]string, 0, len(r.resolvers))
+	for scheme := range r.resolvers {
+		schemes = append(schemes, scheme)
+	}
+	sort.Strings(schemes)
+	return schemes
+}
+
+// IsSecretReference reports whether a value is read from a secret store when it is resolved: a
+// Vault reference or template, an ${ENV:NAME} placeholder, or a reference of a registered scheme
+// whose values are sensitive. A nil Registry recognizes Vault references and placeholders only.
+func (r *Registry) IsSecretReference(value string) bool {
+	if isReference(value) || isTemplate(value) || envPlaceholderPattern.MatchString(value) {
+		return true
+	}
+	resolver, _, ok := r.lookup(value)
+	if !ok {
+		return false
+	}
+	if ns, ok := resolver.(nonSensitiveResolver); ok {
+		return !ns.NonSensitive()
+	}
+	return true
+}
+
+// Resolve behaves like the package-level Resolve and additionally resolves references of the
+// registered schemes
+func (r *Registry) Resolve(ctx context.Context, vaultClient *vaultpkg.VaultClient, rawConfig *runtime.RawExtension) (*Result, error) {
+	results, err := r.ResolveAll(ctx, StaticClient(vaultClient), rawConfig)
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// ResolveAll resolves several configurations, such as a connector's config and auth, as one and
+// returns a result for each. Secrets read for one configuration are reused by the others, so keys
+// referencing the same vaultDynamic: path in any of them come from a single set of credentials,
+// whose lease is reported in the result of the first configuration referencing it. The Vault
+// client is requested from vaultClient the first time a configuration references Vault. Nil
+// configurations are skipped, and none is updated unless all of them resolve.
+func (r *Registry) ResolveAll(ctx context.Context, vaultClient ClientFunc, rawConfigs ...*runtime.RawExtension) ([]*Result, error) {
+	return resolveAll(ctx, vaultClient, r, rawConfigs)
+}
+
+// lookup returns the resolver for a reference and the reference without its scheme
+func (r *Registry) lookup(value string) (SecretResolver, string, bool) {
+	if r == nil {
+		return nil, "", false
+	}
+	scheme, ref, ok := strings.Cut(value, ":")
+	if !ok {
+		return nil, "", false
+	}
+	resolver, ok := r.resolvers[scheme]
+	if !ok {
+		return nil, "", false
+	}
+	return resolver, ref, true
+}
+
+// resolveExternal resolves a reference handled by a registered resolver, applying any transforms
+func resolveExternal(ctx context.Context, res *resolution, resolver SecretResolver, value, ref, keyPath string) (any, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("Resolving secret reference", "value", value)
+
+	ref, transformNames, err := splitTransforms(ref)
+	if err != nil {
+		return "", NewInvalidReferenceError(keyPath, value, err.Error())
+	}
+
+	resolved, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return "", NewResolverError(keyPath, value, err)
+	}
+
+	sensitive := true
+	if ns, ok := resolver.(nonSensitiveResolver); ok {
+		sensitive = !ns.NonSensitive()
+	}
+	if sensitive {
+		res.recordSensitive(resolved)
+	}
+
+	if len(transformNames) > 0 {
+		resolved, err = applyTransforms(resolved, transformNames, keyPath, value)
+		if err != nil {
+			return "", err
+		}
+		if sensitive {
+			res.recordSensitive(resolved)
+		}
+	}
+	res.recordReference(keyPath, value)
+	return resolved, nil
+}
+
+// NewResolverError wraps an error returned by a registered resolver. Errors that already carry
+// retryability information keep it; others are retried.
+func NewResolverError(keyPath, ref string, err error) *VaultError {
+	var vErr *VaultError
+	if errors.As(err, &vErr) {
+		return &VaultError{Err: err, Retryable: vErr.Retryable, KeyPath: keyPath, VaultRef: ref}
+	}
+	return &VaultError{
+		Err:       err,
+		Retryable: true,
+		KeyPath:   keyPath,
+		VaultRef:  ref,
+	}
+}
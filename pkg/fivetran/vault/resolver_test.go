@@ -0,0 +1,296 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+var errFakeBackend = errors.New("backend unavailable")
+
+// fakeResolver serves references of the form name#key from a fixed set of secrets
+func fakeResolver(secrets map[string]map[string]any) SecretResolver {
+	return SecretResolverFunc(func(_ context.Context, ref string) (any, error) {
+		name, key, _ := strings.Cut(ref, "#")
+		if name == "unavailable" {
+			return nil, errFakeBackend
+		}
+		secret, ok := secrets[name]
+		if !ok {
+			return nil, NewSecretNotFoundError("", ref, name)
+		}
+		if key == wholeSecretKey {
+			return secret, nil
+		}
+		return secret[key], nil
+	})
+}
+
+func TestRegistryResolve(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.Register("fake", fakeResolver(map[string]map[string]any{
+		"db": {"host": "db.example.com", "password": "cGFzc3dvcmQ="},
+	})); err != nil {
+		t.Fatalf("failed to register resolver: %v", err)
+	}
+	vaultClient := &vaultpkg.VaultClient{Config: &vaultpkg.ClientConfig{MountPath: "apps"}}
+
+	tests := []struct {
+		name          string
+		input         string
+		expected      map[string]any
+		expectError   bool
+		expectRetries bool
+	}{
+		{
+			name:     "single key",
+			input:    `{"host":"fake:db#host"}`,
+			expected: map[string]any{"host": "db.example.com"},
+		},
+		{
+			name:     "transforms",
+			input:    `{"password":"fake:db#password|b64dec"}`,
+			expected: map[string]any{"password": "password"},
+		},
+		{
+			name:     "secret ref merge",
+			input:    `{"auth":{"host":"override","vaultSecretRef":"fake:db#*"}}`,
+			expected: map[string]any{"auth": map[string]any{"host": "override", "password": "cGFzc3dvcmQ="}},
+		},
+		{
+			name:     "unregistered scheme is left untouched",
+			input:    `{"url":"https://db.example.com"}`,
+			expected: map[string]any{"url": "https://db.example.com"},
+		},
+		{
+			name:          "resolver error is retryable",
+			input:         `{"host":"fake:unavailable#host"}`,
+			expectError:   true,
+			expectRetries: true,
+		},
+		{
+			name:        "resolver error keeps retryability",
+			input:       `{"host":"fake:missing#host"}`,
+			expectError: true,
+		},
+		{
+			name:        "unknown transform",
+			input:       `{"host":"fake:db#host|upper"}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawExt := &runtime.RawExtension{Raw: []byte(tt.input)}
+
+			_, err := registry.Resolve(context.Background(), vaultClient, rawExt)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error but got none")
+				}
+				if IsRetryableError(err) != tt.expectRetries {
+					t.Errorf("expected retryable %v, got %v for error: %v", tt.expectRetries, IsRetryableError(err), err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			var result map[string]any
+			if err := json.Unmarshal(rawExt.Raw, &result); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("result mismatch:\nexpected: %+v\ngot:      %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestRegistryRegister(t *testing.T) {
+	registry := NewRegistry()
+	resolver := fakeResolver(nil)
+
+	if err := registry.Register("fake", resolver); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	tests := []struct {
+		scheme   string
+		expected error
+	}{
+		{scheme: "fake", expected: ErrSchemeAlreadyRegistered},
+		{scheme: "vault", expected: ErrSchemeAlreadyRegistered},
+		{scheme: "vaultDynamic", expected: ErrSchemeAlreadyRegistered},
+		{scheme: "", expected: ErrInvalidScheme},
+		{scheme: "fake:", expected: ErrInvalidScheme},
+	}
+	for _, tt := range tests {
+		if err := registry.Register(tt.scheme, resolver); !errors.Is(err, tt.expected) {
+			t.Errorf("expected %v registering scheme %q, got: %v", tt.expected, tt.scheme, err)
+		}
+	}
+
+	if schemes := registry.Schemes(); !reflect.DeepEqual(schemes, []string{"fake"}) {
+		t.Errorf("expected schemes [fake], got %v", schemes)
+	}
+}
+
+// nonSensitiveFakeResolver serves values that are not secret
+type nonSensitiveFakeResolver struct {
+	SecretResolver
+}
+
+func (nonSensitiveFakeResolver) NonSensitive() bool {
+	return true
+}
+
+func TestIsSecretReference(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.Register("fake", fakeResolver(nil)); err != nil {
+		t.Fatalf("failed to register resolver: %v", err)
+	}
+	if err := registry.Register("plain", nonSensitiveFakeResolver{fakeResolver(nil)}); err != nil {
+		t.Fatalf("failed to register resolver: %v", err)
+	}
+
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{value: "vault:db#password", expected: true},
+		{value: "vaultDynamic:database/creds/app#password", expected: true},
+		{value: `{{vault "db" "password"}}`, expected: true},
+		{value: "${ENV:DB_PASSWORD}", expected: true},
+		{value: "fake:db#password", expected: true},
+		{value: "plain:shared#password"},
+		{value: "other:db#password"},
+		{value: "hunter2"},
+	}
+	for _, tt := range tests {
+		if got := registry.IsSecretReference(tt.value); got != tt.expected {
+			t.Errorf("expected IsSecretReference(%q) to be %t, got %t", tt.value, tt.expected, got)
+		}
+	}
+
+	var nilRegistry *Registry
+	if nilRegistry.IsSecretReference("fake:db#password") {
+		t.Error("expected a nil registry to recognize Vault references only")
+	}
+}
+
+func TestResolveSensitiveValues(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.Register("fake", fakeResolver(map[string]map[string]any{
+		"db": {"host": "db.example.com", "password": "cGFzc3dvcmQ="},
+	})); err != nil {
+		t.Fatalf("failed to register resolver: %v", err)
+	}
+	if err := registry.Register("plain", nonSensitiveFakeResolver{fakeResolver(map[string]map[string]any{
+		"shared": {"region": "us-east-1"},
+	})}); err != nil {
+		t.Fatalf("failed to register resolver: %v", err)
+	}
+	vaultClient := &vaultpkg.VaultClient{Config: &vaultpkg.ClientConfig{MountPath: "apps"}}
+
+	rawExt := &runtime.RawExtension{Raw: []byte(`{"password":"fake:db#password|b64dec","region":"plain:shared#region","port":5432}`)}
+	result, err := registry.Resolve(context.Background(), vaultClient, rawExt)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+
+	expected := []string{"cGFzc3dvcmQ=", "password"}
+	if !reflect.DeepEqual(result.SensitiveValues, expected) {
+		t.Errorf("expected sensitive values %v, got %v", expected, result.SensitiveValues)
+	}
+}
+
+func TestResolveReferences(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.Register("fake", fakeResolver(map[string]map[string]any{
+		"db": {"user": "admin", "password": "s3cr3t-password"},
+	})); err != nil {
+		t.Fatalf("failed to register resolver: %v", err)
+	}
+	vaultClient := &vaultpkg.VaultClient{Config: &vaultpkg.ClientConfig{MountPath: "apps"}}
+
+	rawExt := &runtime.RawExtension{Raw: []byte(`{"db":{"user":"fake:db#user","password":"fake:db#password"},"login":"fake:db#user","port":5432}`)}
+	result, err := registry.Resolve(context.Background(), vaultClient, rawExt)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+
+	expected := []ResolvedReference{
+		{KeyPath: "db.password", Reference: "fake:db#password"},
+		{KeyPath: "db.user", Reference: "fake:db#user"},
+		{KeyPath: "login", Reference: "fake:db#user"},
+	}
+	if !reflect.DeepEqual(result.References, expected) {
+		t.Errorf("expected references %v, got %v", expected, result.References)
+	}
+}
+
+func TestRegistryResolveAllLazyVaultClient(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.Register("fake", fakeResolver(map[string]map[string]any{
+		"db": {"host": "db.example.com"},
+	})); err != nil {
+		t.Fatalf("failed to register resolver: %v", err)
+	}
+	requests := 0
+	client := func(context.Context) (*vaultpkg.VaultClient, error) {
+		requests++
+		return nil, errFakeBackend
+	}
+
+	// Configurations without Vault references resolve without a Vault client
+	rawExt := &runtime.RawExtension{Raw: []byte(`{"host":"fake:db#host","port":5432}`)}
+	if _, err := registry.ResolveAll(context.Background(), client, rawExt); err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("expected the vault client not to be requested, got %d requests", requests)
+	}
+
+	// A Vault reference requests the client
+	rawExt = &runtime.RawExtension{Raw: []byte(`{"password":"vault:db#password"}`)}
+	if _, err := registry.ResolveAll(context.Background(), client, rawExt); !errors.Is(err, errFakeBackend) {
+		t.Errorf("expected the vault client error, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected the vault client to be requested once, got %d requests", requests)
+	}
+
+	// Without a client, Vault references cannot be resolved
+	rawExt = &runtime.RawExtension{Raw: []byte(`{"url":"postgres://{{vault \"db\" \"user\"}}@db"}`)}
+	if _, err := registry.ResolveAll(context.Background(), nil, rawExt); !errors.Is(err, ErrVaultNotConfigured) {
+		t.Errorf("expected %v, got %v", ErrVaultNotConfigured, err)
+	}
+}
+
+func TestReferencesVault(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{`{"host":"db.example.com","password":"secret:ns/db#password"}`, false},
+		{`{"password":"vault:db#password"}`, true},
+		{`{"users":[{"name":"vaultDynamic:database/creds/readonly#username"}]}`, true},
+		{`{"url":"postgres://{{vault \"db\" \"user\"}}@db"}`, true},
+		{`{"auth":{"vaultSecretRef":"fivetran/auth"}}`, true},
+	}
+	for _, tt := range tests {
+		if got := ReferencesVault(nil, &runtime.RawExtension{Raw: []byte(tt.input)}); got != tt.expected {
+			t.Errorf("ReferencesVault(%s) = %v, expected %v", tt.input, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	vaultapi "github.com/hashicorp/vault/api"
+)
+
+const (
+	// maxReadAttempts is the number of times a secret read is attempted on transient errors
+	maxReadAttempts = 3
+)
+
+// readRetryBackoff is the delay before the first retry; it doubles on each further attempt
+var readRetryBackoff = 200 * time.Millisecond
+
+// readWithRetry runs read, retrying transient Vault errors with exponential backoff
+func readWithRetry[T any](ctx context.Context, read func() (T, error)) (T, error) {
+	backoff := readRetryBackoff
+	for attempt := 1; ; attempt++ {
+		secret, err := read()
+		if err == nil || attempt >= maxReadAttempts || !isTransientVaultError(err) {
+			return secret, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return secret, err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// isTransientVaultError reports whether a failed read may succeed if repeated: timeouts,
+// connection failures, rate limiting, server errors and a sealed or standby Vault
+func isTransientVaultError(err error) bool {
+	var respErr *vaultapi.ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.StatusCode == http.StatusTooManyRequests || respErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestIsTransientVaultError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "server error", err: &vaultapi.ResponseError{StatusCode: http.StatusInternalServerError}, expected: true},
+		{name: "sealed", err: fmt.Errorf("read: %w", &vaultapi.ResponseError{StatusCode: http.StatusServiceUnavailable}), expected: true},
+		{name: "rate limited", err: &vaultapi.ResponseError{StatusCode: http.StatusTooManyRequests}, expected: true},
+		{name: "timeout", err: fmt.Errorf("read: %w", context.DeadlineExceeded), expected: true},
+		{name: "permission denied", err: &vaultapi.ResponseError{StatusCode: http.StatusForbidden}, expected: false},
+		{name: "secret not found", err: vaultapi.ErrSecretNotFound, expected: false},
+		{name: "other error", err: errors.New("boom"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransientVaultError(tt.err); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestResolveSecretsRetriesTransientErrors(t *testing.T) {
+	defer func(backoff time.Duration) { readRetryBackoff = backoff }(readRetryBackoff)
+	readRetryBackoff = time.Millisecond
+
+	tests := []struct {
+		name             string
+		failures         int32
+		status           int
+		expectError      bool
+		expectedRequests int32
+	}{
+		{name: "recovers after transient errors", failures: 2, status: http.StatusServiceUnavailable, expectedRequests: 3},
+		{name: "gives up after max attempts", failures: 5, status: http.StatusInternalServerError, expectError: true, expectedRequests: maxReadAttempts},
+		{name: "does not retry permanent errors", failures: 5, status: http.StatusForbidden, expectError: true, expectedRequests: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) <= tt.failures {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(`{"errors":["unavailable"]}`))
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":{"data":{"api_key":"my-test-key"},"metadata":{"version":1}}}`))
+			}))
+			defer server.Close()
+
+			config := vaultapi.DefaultConfig()
+			config.Address = server.URL
+			config.MaxRetries = 0
+			client, err := vaultapi.NewClient(config)
+			if err != nil {
+				t.Fatalf("failed to create vault client: %v", err)
+			}
+
+			vaultClient := &vaultpkg.VaultClient{
+				Client: client,
+				Config: &vaultpkg.ClientConfig{MountPath: "apps", KVVersion: vaultpkg.KVVersion2},
+			}
+			rawExt := &runtime.RawExtension{Raw: []byte(`{"key":"vault:test-secret#api_key"}`)}
+
+			err = ResolveSecrets(context.Background(), vaultClient, rawExt)
+			if tt.expectError && err == nil {
+				t.Errorf("expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("expected no error but got: %v", err)
+			}
+			if got := requests.Load(); got != tt.expectedRequests {
+				t.Errorf("expected %d requests, got %d", tt.expectedRequests, got)
+			}
+		})
+	}
+}
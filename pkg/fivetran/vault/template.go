@@ -0,0 +1,66 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+// templatePattern matches strings that call the vault template function, so that other
+// values containing "{{" are passed through untouched
+var templatePattern = regexp.MustCompile(`\{\{-?\s*vault(Dynamic)?\s`)
+
+// isTemplate reports whether a string value interpolates vault secrets
+func isTemplate(value string) bool {
+	return templatePattern.MatchString(value)
+}
+
+// resolveTemplate renders a string containing {{vault "path" "key"}} calls. The path accepts the
+// same forms as a reference, so {{vault "//mount/path" "key@2"}} reads a pinned version from another mount.
+// Transforms are available as template functions, e.g. {{vault "path" "key" | b64dec}}, and
+// {{vaultDynamic "database/creds/role" "username"}} reads from a dynamic secrets engine.
+func resolveTemplate(ctx context.Context, vaultClient *vaultpkg.VaultClient, res *resolution, value, keyPath string) (any, error) {
+	// Keep the resolution error so its retryability survives template execution
+	var resolveErr error
+	lookup := func(prefix string) func(path, key string) (string, error) {
+		return func(path, key string) (string, error) {
+			ref := prefix + path + "#" + key
+			if key == wholeSecretKey {
+				resolveErr = NewInvalidReferenceError(keyPath, ref, "templates must reference a single key")
+				return "", resolveErr
+			}
+			resolved, err := resolveString(ctx, vaultClient, res, ref, keyPath)
+			if err != nil {
+				resolveErr = err
+				return "", err
+			}
+			return fmt.Sprint(resolved), nil
+		}
+	}
+	funcs := template.FuncMap{
+		"vault":        lookup(referencePrefix),
+		"vaultDynamic": lookup(dynamicReferencePrefix),
+	}
+	for name, fn := range transforms {
+		funcs[name] = fn
+	}
+
+	tmpl, err := template.New(keyPath).Funcs(funcs).Option("missingkey=error").Parse(value)
+	if err != nil {
+		return "", NewInvalidReferenceError(keyPath, value, fmt.Sprintf("invalid template: %v", err))
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, nil); err != nil {
+		if resolveErr != nil {
+			return "", resolveErr
+		}
+		return "", NewInvalidReferenceError(keyPath, value, fmt.Sprintf("failed to render template: %v", err))
+	}
+
+	return out.String(), nil
+}
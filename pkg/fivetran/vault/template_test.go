@@ -0,0 +1,102 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestResolveSecretsTemplates(t *testing.T) {
+	client, cleanup := setupTestVault(t)
+	defer cleanup()
+
+	vaultClient := &vaultpkg.VaultClient{
+		Client: client,
+		Config: &vaultpkg.ClientConfig{MountPath: "apps"},
+	}
+
+	tests := []struct {
+		name           string
+		input          string
+		expected       string
+		expectError    bool
+		expectRetryErr bool
+	}{
+		{
+			name:     "multiple secrets in one value",
+			input:    `jdbc:postgresql://db:5432/app?user={{vault "test-secret" "username"}}&password={{ vault "test-secret" "password" }}`,
+			expected: "jdbc:postgresql://db:5432/app?user=test-user&password=test-pass",
+		},
+		{
+			name:     "secret from another mount",
+			input:    `key={{vault "//legacy/test-secret" "api_key"}}`,
+			expected: "key=my-legacy-key",
+		},
+		{
+			name:     "transforms as template functions",
+			input:    `user={{vault "test-secret" "username" | trim}}`,
+			expected: "user=test-user",
+		},
+		{
+			name:     "braces without vault calls are left untouched",
+			input:    `SELECT '{{not a template}}'`,
+			expected: `SELECT '{{not a template}}'`,
+		},
+		{
+			name:        "missing key",
+			input:       `{{vault "test-secret" "missing"}}`,
+			expectError: true,
+		},
+		{
+			name:        "whole secret is not allowed",
+			input:       `{{vault "test-secret" "*"}}`,
+			expectError: true,
+		},
+		{
+			name:        "invalid template",
+			input:       `{{vault "test-secret" "username"`,
+			expectError: true,
+		},
+		{
+			name:           "vault API error stays retryable",
+			input:          `{{vault "//nonexistent/test-secret" "username"}}`,
+			expectError:    true,
+			expectRetryErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := json.Marshal(map[string]any{"url": tt.input})
+			if err != nil {
+				t.Fatalf("failed to marshal input: %v", err)
+			}
+			rawExt := &runtime.RawExtension{Raw: raw}
+
+			err = ResolveSecrets(context.Background(), vaultClient, rawExt)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error but got none")
+				}
+				if IsRetryableError(err) != tt.expectRetryErr {
+					t.Errorf("expected retryable=%v, got %v (error: %v)", tt.expectRetryErr, IsRetryableError(err), err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			var result map[string]any
+			if err := json.Unmarshal(rawExt.Raw, &result); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if result["url"] != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result["url"])
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrUnknownTransform = errors.New("unknown transform")
+
+// transformFunc normalizes a resolved secret value
+type transformFunc func(string) (string, error)
+
+// transforms are applied to resolved values in the order given, e.g. vault:path#key|b64dec|trim
+var transforms = map[string]transformFunc{
+	"b64dec":     base64Decode,
+	"unescape":   unescapeNewlines,
+	"trim":       trimSpace,
+	"jsonescape": jsonEscape,
+}
+
+// splitTransforms separates the reference from its |transform suffixes and validates their names
+func splitTransforms(value string) (string, []string, error) {
+	parts := strings.Split(value, "|")
+	names := parts[1:]
+	for _, name := range names {
+		if _, ok := transforms[name]; !ok {
+			return "", nil, fmt.Errorf("%w '%s'", ErrUnknownTransform, name)
+		}
+	}
+	return parts[0], names, nil
+}
+
+// applyTransforms runs the named transforms over a resolved value
+func applyTransforms(value any, names []string, keyPath, vaultRef string) (any, error) {
+	str, ok := value.(string)
+	if !ok {
+		return "", NewInvalidReferenceError(keyPath, vaultRef, "transforms require a string value")
+	}
+
+	for _, name := range names {
+		var err error
+		str, err = transforms[name](str)
+		if err != nil {
+			return "", NewInvalidReferenceError(keyPath, vaultRef, fmt.Sprintf("transform '%s' failed: %v", name, err))
+		}
+	}
+	return str, nil
+}
+
+// base64Decode decodes standard or URL-safe base64, with or without padding
+func base64Decode(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if decoded, err := enc.DecodeString(value); err == nil {
+			return string(decoded), nil
+		}
+	}
+	return "", errors.New("value is not valid base64")
+}
+
+// unescapeNewlines turns literal \n sequences into newlines, as needed for PEM keys stored on one line
+func unescapeNewlines(value string) (string, error) {
+	return strings.NewReplacer(`\r\n`, "\n", `\n`, "\n").Replace(value), nil
+}
+
+// trimSpace removes leading and trailing whitespace
+func trimSpace(value string) (string, error) {
+	return strings.TrimSpace(value), nil
+}
+
+// jsonEscape escapes the value for embedding inside a JSON string
+func jsonEscape(value string) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return "", err
+	}
+	encoded := strings.TrimSuffix(buf.String(), "\n")
+	return encoded[1 : len(encoded)-1], nil
+}
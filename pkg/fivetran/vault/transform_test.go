@@ -0,0 +1,86 @@
+package vault
+
+import (
+	"testing"
+)
+
+func TestSplitTransforms(t *testing.T) {
+	tests := []struct {
+		input       string
+		ref         string
+		names       []string
+		expectError bool
+	}{
+		{input: "vault:path#key", ref: "vault:path#key"},
+		{input: "vault:path#key|b64dec", ref: "vault:path#key", names: []string{"b64dec"}},
+		{input: "vault:path#key@2|unescape|trim", ref: "vault:path#key@2", names: []string{"unescape", "trim"}},
+		{input: "vault:path#key|rot13", expectError: true},
+		{input: "vault:path#key|", expectError: true},
+	}
+
+	for _, tt := range tests {
+		ref, names, err := splitTransforms(tt.input)
+		if tt.expectError {
+			if err == nil {
+				t.Errorf("splitTransforms(%q) expected error but got none", tt.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("splitTransforms(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if ref != tt.ref || len(names) != len(tt.names) {
+			t.Errorf("splitTransforms(%q) = (%q, %v), expected (%q, %v)", tt.input, ref, names, tt.ref, tt.names)
+			continue
+		}
+		for i := range names {
+			if names[i] != tt.names[i] {
+				t.Errorf("splitTransforms(%q) = (%q, %v), expected (%q, %v)", tt.input, ref, names, tt.ref, tt.names)
+				break
+			}
+		}
+	}
+}
+
+func TestApplyTransforms(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		transforms  []string
+		expected    string
+		expectError bool
+	}{
+		{name: "base64 decode", value: "c2VjcmV0", transforms: []string{"b64dec"}, expected: "secret"},
+		{name: "unpadded base64 decode", value: "c2VjcmV0MQ", transforms: []string{"b64dec"}, expected: "secret1"},
+		{name: "invalid base64", value: "not base64!", transforms: []string{"b64dec"}, expectError: true},
+		{
+			name:       "unescape PEM newlines",
+			value:      `-----BEGIN KEY-----\nabc\n-----END KEY-----`,
+			transforms: []string{"unescape"},
+			expected:   "-----BEGIN KEY-----\nabc\n-----END KEY-----",
+		},
+		{name: "trim", value: "  secret\n", transforms: []string{"trim"}, expected: "secret"},
+		{name: "json escape", value: "a\"b\n<c>", transforms: []string{"jsonescape"}, expected: `a\"b\n<c>`},
+		{name: "chained", value: "ICBzZWNyZXQKCg==", transforms: []string{"b64dec", "trim"}, expected: "secret"},
+		{name: "non-string value", value: float64(42), transforms: []string{"trim"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := applyTransforms(tt.value, tt.transforms, "key", "vault:path#key")
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+// referenceUse is a vault reference found in a config, with the key path it is used at
+type referenceUse struct {
+	ref      vaultReference
+	keyPath  string
+	vaultRef string
+}
+
+// ValidateReferences checks the syntax of the vault: and vaultDynamic: references in a config
+// without reading Vault, including their transforms, vaultSecretRef fields and the references in
+// templates. References parameterized by ${ENV:NAME} placeholders are checked once substituted,
+// when the config is resolved, and references of other schemes are left to their resolvers.
+func ValidateReferences(rawConfig *runtime.RawExtension) ([]*VaultError, error) {
+	_, invalid, err := collectReferences(rawConfig)
+	return invalid, err
+}
+
+// CheckReferences validates the references in a config like ValidateReferences and reads the KV
+// secrets they reference, reporting secrets and keys that do not exist. Dynamic references are not
+// read, since reading them issues credentials, and neither are references in templates. An error
+// is returned when Vault cannot be read, such as when it is unavailable or denies access.
+func CheckReferences(ctx context.Context, vaultClient *vaultpkg.VaultClient, rawConfig *runtime.RawExtension) ([]*VaultError, error) {
+	uses, invalid, err := collectReferences(rawConfig)
+	if err != nil || len(invalid) > 0 {
+		return invalid, err
+	}
+
+	var missing []*VaultError
+	secrets := make(map[string]map[string]any)
+	for _, use := range uses {
+		if use.ref.Dynamic {
+			continue
+		}
+		if use.ref.Mount == "" {
+			use.ref.Mount = vaultClient.Config.MountPath
+		}
+
+		cacheKey := use.ref.cacheKey()
+		data, read := secrets[cacheKey]
+		if !read {
+			var err error
+			cached, ok := getSharedCache(vaultClient, use.ref)
+			if !ok {
+				cached, err = fetchPathData(ctx, vaultClient, use.ref, use.keyPath, use.vaultRef)
+			}
+			if err != nil {
+				if errors.Is(err, vaultapi.ErrSecretNotFound) {
+					err = NewSecretNotFoundError(use.keyPath, use.vaultRef, use.ref.Path)
+				}
+				var vErr *VaultError
+				if !errors.As(err, &vErr) || vErr.IsRetryable() {
+					return nil, err
+				}
+				// Report the secret at its first use only
+				missing = append(missing, vErr)
+				secrets[cacheKey] = nil
+				continue
+			}
+			data = cached.Data
+			secrets[cacheKey] = data
+		}
+
+		if data == nil || use.ref.Key == wholeSecretKey {
+			continue
+		}
+		if _, ok := data[use.ref.Key]; !ok {
+			err := NewKeyNotFoundError(use.keyPath, use.ref.Key, use.ref.Path, getKeys(data))
+			err.VaultRef = use.vaultRef
+			missing = append(missing, err)
+		}
+	}
+	return missing, nil
+}
+
+// collectReferences walks a config in key order, returning the references it uses outside of
+// templates and an error for each malformed reference
+func collectReferences(rawConfig *runtime.RawExtension) ([]referenceUse, []*VaultError, error) {
+	if rawConfig == nil || rawConfig.Raw == nil {
+		return nil, nil, nil
+	}
+
+	var data any
+	if err := json.Unmarshal(rawConfig.Raw, &data); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	var uses []referenceUse
+	var invalid []*VaultError
+	walkReferences(data, "", &uses, &invalid)
+	return uses, invalid, nil
+}
+
+// walkReferences records the references used in data and the malformed ones
+func walkReferences(data any, keyPath string, uses *[]referenceUse, invalid *[]*VaultError) {
+	switch v := data.(type) {
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			currentPath := buildKeyPath(keyPath, key)
+			if key != SecretRefKey {
+				walkReferences(v[key], currentPath, uses, invalid)
+				continue
+			}
+			ref, ok := v[key].(string)
+			if !ok {
+				*invalid = append(*invalid, NewInvalidReferenceError(currentPath, fmt.Sprint(v[key]),
+					"vaultSecretRef must be a vault reference string"))
+				continue
+			}
+			if isReference(ref) && !strings.Contains(ref, "#") {
+				ref += "#" + wholeSecretKey
+			}
+			walkReferences(ref, currentPath, uses, invalid)
+		}
+	case []any:
+		for i, item := range v {
+			walkReferences(item, fmt.Sprintf("%s[%d]", keyPath, i), uses, invalid)
+		}
+	case string:
+		if envPlaceholderPattern.MatchString(v) {
+			return
+		}
+		if isTemplate(v) {
+			if err := validateTemplate(v, keyPath); err != nil {
+				*invalid = append(*invalid, err)
+			}
+			return
+		}
+		if !isReference(v) {
+			return
+		}
+		ref, _, err := parseValue(v, keyPath)
+		if err != nil {
+			*invalid = append(*invalid, err.(*VaultError))
+			return
+		}
+		*uses = append(*uses, referenceUse{ref: ref, keyPath: keyPath, vaultRef: v})
+	}
+}
+
+// validateTemplate parses a template and executes it with lookups that only check the syntax of
+// the references it makes
+func validateTemplate(value, keyPath string) *VaultError {
+	var refErr *VaultError
+	lookup := func(prefix string) func(path, key string) (string, error) {
+		return func(path, key string) (string, error) {
+			ref := prefix + path + "#" + key
+			if key == wholeSecretKey {
+				refErr = NewInvalidReferenceError(keyPath, ref, "templates must reference a single key")
+				return "", refErr
+			}
+			if _, _, err := parseValue(ref, keyPath); err != nil {
+				refErr = err.(*VaultError)
+				return "", err
+			}
+			return "", nil
+		}
+	}
+	funcs := template.FuncMap{
+		"vault":        lookup(referencePrefix),
+		"vaultDynamic": lookup(dynamicReferencePrefix),
+	}
+	for name, fn := range transforms {
+		funcs[name] = fn
+	}
+
+	tmpl, err := template.New(keyPath).Funcs(funcs).Option("missingkey=error").Parse(value)
+	if err != nil {
+		return NewInvalidReferenceError(keyPath, value, fmt.Sprintf("invalid template: %v", err))
+	}
+	var out strings.Builder
+	if err := tmpl.Execute(&out, nil); err != nil && refErr != nil {
+		return refErr
+	}
+	return nil
+}
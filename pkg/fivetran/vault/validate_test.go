@@ -0,0 +1,154 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+// keyPaths returns the key paths of errors
+func keyPaths(errs []*VaultError) []string {
+	paths := make([]string, 0, len(errs))
+	for _, err := range errs {
+		paths = append(paths, err.KeyPath)
+	}
+	return paths
+}
+
+func TestValidateReferences(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected []string
+	}{
+		{
+			name: "valid references",
+			config: `{
+				"a": "vault:db#user",
+				"b": "vault://legacy/db#password@2|trim",
+				"c": "vaultDynamic:database/creds/app#username",
+				"d": {"vaultSecretRef": "vault:oauth"},
+				"e": "{{vault \"db\" \"user\" | b64dec}}@host",
+				"f": ["plain", "secret:app#password"]
+			}`,
+		},
+		{
+			name: "malformed references",
+			config: `{
+				"a": "vault:db",
+				"b": "vault:db#user|upper",
+				"c": "vault:db#*|trim",
+				"d": ["vault:#user"],
+				"e": {"vaultSecretRef": 42},
+				"f": {"vaultSecretRef": "vault:oauth#*|b64dec"}
+			}`,
+			expected: []string{"a", "b", "c", "d[0]", "e.vaultSecretRef", "f.vaultSecretRef"},
+		},
+		{
+			name: "malformed templates",
+			config: `{
+				"a": "{{vault \"db\"",
+				"b": "{{vault \"db\" \"*\"}}",
+				"c": "{{vault \"\" \"user\"}}"
+			}`,
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:   "references with environment placeholders",
+			config: `{"a": "vault:${ENV:DB_PATH}"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := ValidateReferences(&runtime.RawExtension{Raw: []byte(tt.config)})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := keyPaths(errs)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected errors at %v, got %v", tt.expected, errs)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("expected errors at %v, got %v", tt.expected, got)
+				}
+				if !errors.Is(errs[i], ErrInvalidVaultReference) {
+					t.Errorf("expected an invalid reference error, got %v", errs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckReferences(t *testing.T) {
+	client, cleanup := setupTestVault(t)
+	defer cleanup()
+
+	vaultClient := &vaultpkg.VaultClient{
+		Client: client,
+		Config: &vaultpkg.ClientConfig{MountPath: "apps"},
+	}
+
+	t.Run("existing secrets", func(t *testing.T) {
+		errs, err := CheckReferences(context.Background(), vaultClient, &runtime.RawExtension{Raw: []byte(`{
+			"a": "vault:test-secret#api_key",
+			"b": "vault://legacy/test-secret#api_key",
+			"c": {"vaultSecretRef": "vault:test-secret"},
+			"d": "vaultDynamic:database/creds/app#username"
+		}`)})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(errs) != 0 {
+			t.Errorf("expected no errors, got %v", errs)
+		}
+	})
+
+	t.Run("missing secrets and keys", func(t *testing.T) {
+		errs, err := CheckReferences(context.Background(), vaultClient, &runtime.RawExtension{Raw: []byte(`{
+			"a": "vault:test-secret#missing",
+			"b": "vault:missing-secret#key",
+			"c": "vault:missing-secret#other"
+		}`)})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := keyPaths(errs)
+		if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+			t.Fatalf("expected errors at [a b], got %v", errs)
+		}
+		if !errors.Is(errs[0], ErrKeyNotFound) || errs[0].VaultRef != "vault:test-secret#missing" {
+			t.Errorf("expected a key not found error, got %v", errs[0])
+		}
+		if !errors.Is(errs[1], ErrSecretNotFound) {
+			t.Errorf("expected a secret not found error, got %v", errs[1])
+		}
+	})
+
+	t.Run("malformed references are not read", func(t *testing.T) {
+		errs, err := CheckReferences(context.Background(), vaultClient, &runtime.RawExtension{Raw: []byte(`{
+			"a": "vault:missing-secret#key",
+			"b": "vault:db"
+		}`)})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := keyPaths(errs); len(got) != 1 || got[0] != "b" {
+			t.Errorf("expected an error at b, got %v", errs)
+		}
+	})
+
+	t.Run("vault unavailable", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := CheckReferences(ctx, vaultClient, &runtime.RawExtension{Raw: []byte(`{"a": "vault:other-secret#key"}`)})
+		if err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
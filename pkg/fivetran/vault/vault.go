@@ -5,6 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 
 	vaultapi "github.com/hashicorp/vault/api"
@@ -15,10 +19,23 @@ import (
 )
 
 var (
-	ErrInvalidVaultReference = errors.New("invalid vault reference format (expected format: vault:path#key)")
+	ErrInvalidVaultReference = errors.New("invalid vault reference format (expected format: vault:path#key[@version], vault://mount/path#key[@version] or vaultDynamic:path#key)")
 	ErrSecretDataNil         = errors.New("secret data is nil")
 	ErrSecretNotFound        = errors.New("secret not found at path")
 	ErrKeyNotFound           = errors.New("key not found in vault secret")
+	ErrVersionNotSupported   = errors.New("secret versions are only supported on KV v2 mounts")
+	ErrVaultNotConfigured    = errors.New("vault is not configured")
+)
+
+const (
+	// referencePrefix marks a KV secret reference
+	referencePrefix = "vault:"
+	// dynamicReferencePrefix marks a reference to credentials generated by a dynamic secrets engine
+	dynamicReferencePrefix = "vaultDynamic:"
+	// wholeSecretKey expands a reference to every key of the secret
+	wholeSecretKey = "*"
+	// SecretRefKey names an object field whose vault reference is merged into the enclosing object
+	SecretRefKey = "vaultSecretRef"
 )
 
 // VaultError represents a vault resolution error with retryability information
@@ -30,12 +47,19 @@ type VaultError struct {
 }
 
 func (e *VaultError) Error() string {
+	if e.KeyPath != "" && e.VaultRef != "" && !isReference(e.VaultRef) {
+		return fmt.Sprintf("%s: reference '%s': %s", e.KeyPath, e.VaultRef, e.Err.Error())
+	}
 	if e.KeyPath != "" && e.VaultRef != "" {
 		return fmt.Sprintf("%s: vault reference '%s': %s", e.KeyPath, e.VaultRef, e.Err.Error())
 	}
 	return e.Err.Error()
 }
 
+func (e *VaultError) Unwrap() error {
+	return e.Err
+}
+
 func (e *VaultError) IsRetryable() bool {
 	return e.Retryable
 }
@@ -86,6 +110,16 @@ func NewSecretDataNilError(keyPath, vaultRef string) *VaultError {
 	}
 }
 
+// NewVaultNotConfiguredError reports a Vault reference resolved without a Vault client
+func NewVaultNotConfiguredError(keyPath, vaultRef string) *VaultError {
+	return &VaultError{
+		Err:       ErrVaultNotConfigured,
+		Retryable: false,
+		KeyPath:   keyPath,
+		VaultRef:  vaultRef,
+	}
+}
+
 func NewVaultAPIError(keyPath, vaultRef string, err error) *VaultError {
 	return &VaultError{
 		Err:       fmt.Errorf("failed to read vault secret: %w", err),
@@ -95,71 +129,370 @@ func NewVaultAPIError(keyPath, vaultRef string, err error) *VaultError {
 	}
 }
 
-// ResolveSecrets resolves string values that start with "vault:" (vault:path#key)
-// throughout the given RawExtension. It minimizes Vault API usage by caching
-// path lookups and fails fast on any error.
-func ResolveSecrets(ctx context.Context, vaultClient *vaultpkg.VaultClient, rawConfig *runtime.RawExtension) error {
-	if rawConfig == nil || rawConfig.Raw == nil {
-		return nil
-	}
+// SecretVersion records the KV v2 version of a Vault secret that was read during resolution
+type SecretVersion struct {
+	Mount   string
+	Path    string
+	Version int
+	// Pinned reports whether the version was requested explicitly in the reference
+	Pinned bool
+}
 
-	var data any
-	if err := json.Unmarshal(rawConfig.Raw, &data); err != nil {
-		return fmt.Errorf("ResolveSecrets: failed to unmarshal config: %w", err)
+// SecretLease records the lease of dynamic credentials issued during resolution
+type SecretLease struct {
+	Path    string
+	LeaseID string
+	// LeaseDuration is the lease TTL in seconds
+	LeaseDuration int
+	Renewable     bool
+}
+
+// ResolvedReference records a reference that was resolved and the config key it was found at. It
+// never holds the resolved value, so it can be written to audit logs.
+type ResolvedReference struct {
+	KeyPath   string
+	Reference string
+}
+
+// Result describes the secrets read while resolving a configuration
+type Result struct {
+	// Versions holds the KV v2 versions read, sorted by mount, path and version
+	Versions []SecretVersion
+	// Leases holds the leases of the dynamic credentials issued, sorted by path
+	Leases []SecretLease
+	// SensitiveValues holds the secret values that were resolved, so they can be redacted from output
+	SensitiveValues []string
+	// References holds the references that were resolved, sorted by key path and reference
+	References []ResolvedReference
+	// CacheKeys holds the keys of the KV secrets read in the Vault client's shared cache, sorted,
+	// so they can be invalidated with InvalidateCached when Fivetran rejects the resolved values
+	CacheKeys []string
+}
+
+// resolution holds the resolvers of other schemes, the per-call path cache and the versions and
+// leases of the secrets that were read. The cache is shared by every configuration resolved
+// together; the records are collected per configuration.
+type resolution struct {
+	registry *Registry
+	cache    map[string]map[string]any
+	versions map[string]SecretVersion
+	leases   map[string]SecretLease
+	// issued holds the leases recorded for configurations already resolved
+	issued []SecretLease
+	// sensitive holds the resolved secret values
+	sensitive map[string]struct{}
+	// references holds the resolved references
+	references map[ResolvedReference]struct{}
+	// cacheKeys holds the shared cache keys of the KV secrets read
+	cacheKeys map[string]struct{}
+}
+
+// newResolution creates an empty resolution using the registry's resolvers
+func newResolution(registry *Registry) *resolution {
+	res := &resolution{
+		registry: registry,
+		cache:    make(map[string]map[string]any),
 	}
+	res.reset()
+	return res
+}
 
-	// Use a simple cache map for this call
-	cache := make(map[string]map[string]any)
+// ResolveSecrets resolves string values that start with "vault:" (vault:path#key or
+// vault://mount/path#key) or "vaultDynamic:" (vaultDynamic:path#key) throughout the given RawExtension. A key of "*" expands to the whole secret,
+// and a vaultSecretRef field merges a whole secret into its enclosing object. It minimizes Vault API
+// usage by caching path lookups and fails fast on any error.
+func ResolveSecrets(ctx context.Context, vaultClient *vaultpkg.VaultClient, rawConfig *runtime.RawExtension) error {
+	_, err := ResolveSecretsWithVersions(ctx, vaultClient, rawConfig)
+	return err
+}
 
-	resolvedData, err := resolveValue(ctx, vaultClient, cache, data, "")
+// ResolveSecretsWithVersions behaves like ResolveSecrets and additionally returns the KV v2 versions
+// of the secrets that were read, sorted by mount, path and version. KV v1 secrets are not versioned
+// and are omitted.
+func ResolveSecretsWithVersions(ctx context.Context, vaultClient *vaultpkg.VaultClient, rawConfig *runtime.RawExtension) ([]SecretVersion, error) {
+	result, err := Resolve(ctx, vaultClient, rawConfig)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return result.Versions, nil
+}
 
-	updatedConfig, err := json.Marshal(resolvedData)
+// Resolve behaves like ResolveSecrets and additionally describes the secrets that were read. When
+// resolution fails, the leases of any dynamic credentials issued along the way are revoked.
+func Resolve(ctx context.Context, vaultClient *vaultpkg.VaultClient, rawConfig *runtime.RawExtension) (*Result, error) {
+	results, err := resolveAll(ctx, StaticClient(vaultClient), nil, []*runtime.RawExtension{rawConfig})
 	if err != nil {
-		return fmt.Errorf("ResolveSecrets: failed to marshal resolved config: %w", err)
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// resolveAll walks each of rawConfigs in turn, resolving Vault references and references of the
+// registry's schemes, and returns one result per configuration. A path read for one configuration
+// is reused by the others, so the keys referencing a vaultDynamic: path in any of them come from a
+// single set of credentials, recorded in the result of the first configuration referencing it.
+// The Vault client is only requested once a configuration references Vault. The configurations
+// are only updated once all of them resolve.
+func resolveAll(ctx context.Context, client ClientFunc, registry *Registry, rawConfigs []*runtime.RawExtension) ([]*Result, error) {
+	res := newResolution(registry)
+	var vaultClient *vaultpkg.VaultClient
+	results := make([]*Result, len(rawConfigs))
+	updated := make([][]byte, len(rawConfigs))
+
+	for i, rawConfig := range rawConfigs {
+		if rawConfig == nil || rawConfig.Raw == nil {
+			results[i] = &Result{}
+			continue
+		}
+
+		var data any
+		if err := json.Unmarshal(rawConfig.Raw, &data); err != nil {
+			res.revokeLeases(ctx, vaultClient)
+			return nil, fmt.Errorf("ResolveSecrets: failed to unmarshal config: %w", err)
+		}
+		if vaultClient == nil && client != nil && referencesVault(data) {
+			var err error
+			// No credentials were issued without a client, so there is nothing to revoke
+			if vaultClient, err = client(ctx); err != nil {
+				return nil, fmt.Errorf("ResolveSecrets: %w", err)
+			}
+		}
+
+		resolvedData, err := res.resolve(ctx, vaultClient, data)
+		if err != nil {
+			res.revokeLeases(ctx, vaultClient)
+			res.invalidateAll(vaultClient)
+			return nil, err
+		}
+
+		updated[i], err = json.Marshal(resolvedData)
+		if err != nil {
+			res.revokeLeases(ctx, vaultClient)
+			res.invalidateAll(vaultClient)
+			return nil, fmt.Errorf("ResolveSecrets: failed to marshal resolved config: %w", err)
+		}
+		results[i] = res.result()
 	}
 
-	rawConfig.Raw = updatedConfig
-	return nil
+	for i, rawConfig := range rawConfigs {
+		if updated[i] != nil {
+			rawConfig.Raw = updated[i]
+		}
+	}
+	return results, nil
+}
+
+// resolve prefetches the referenced secrets and walks data to replace references with their values
+func (r *resolution) resolve(ctx context.Context, vaultClient *vaultpkg.VaultClient, data any) (any, error) {
+	// Without a client, the walk reports the Vault references that cannot be resolved
+	if vaultClient != nil {
+		if err := prefetch(ctx, vaultClient, r, data); err != nil {
+			return nil, err
+		}
+	}
+	return resolveValue(ctx, vaultClient, r, data, "")
+}
+
+// result returns what was recorded for the configuration just resolved and resets the records for
+// the next one, keeping the cache
+func (r *resolution) result() *Result {
+	result := &Result{
+		Versions:        r.sortedVersions(),
+		Leases:          r.sortedLeases(),
+		SensitiveValues: r.sensitiveValues(),
+		References:      r.sortedReferences(),
+		CacheKeys:       slices.Sorted(maps.Keys(r.cacheKeys)),
+	}
+	r.issued = append(r.issued, result.Leases...)
+	r.reset()
+	return result
+}
+
+// reset clears the records of the configuration being resolved
+func (r *resolution) reset() {
+	r.versions = make(map[string]SecretVersion)
+	r.leases = make(map[string]SecretLease)
+	r.sensitive = make(map[string]struct{})
+	r.references = make(map[ResolvedReference]struct{})
+	r.cacheKeys = make(map[string]struct{})
+}
+
+// revokeLeases revokes the leases issued during a failed resolution, on a best-effort basis
+func (r *resolution) revokeLeases(ctx context.Context, vaultClient *vaultpkg.VaultClient) {
+	logger := log.FromContext(ctx)
+	for _, lease := range slices.Concat(r.issued, slices.Collect(maps.Values(r.leases))) {
+		if err := vaultpkg.RevokeLease(ctx, vaultClient, lease.LeaseID); err != nil {
+			logger.Error(err, "failed to revoke unused vault lease", "path", lease.Path)
+		}
+	}
+}
+
+// recordSensitive records the strings in a resolved secret value. Numbers and booleans are too
+// common to be worth redacting.
+func (r *resolution) recordSensitive(value any) {
+	switch v := value.(type) {
+	case string:
+		r.sensitive[v] = struct{}{}
+	case map[string]any:
+		for _, item := range v {
+			r.recordSensitive(item)
+		}
+	case []any:
+		for _, item := range v {
+			r.recordSensitive(item)
+		}
+	}
+}
+
+// sensitiveValues returns the recorded secret values in a stable order
+func (r *resolution) sensitiveValues() []string {
+	if len(r.sensitive) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(r.sensitive))
+	for v := range r.sensitive {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return values
+}
+
+// recordReference records a reference that resolved successfully
+func (r *resolution) recordReference(keyPath, reference string) {
+	r.references[ResolvedReference{KeyPath: keyPath, Reference: reference}] = struct{}{}
+}
+
+// sortedReferences returns the recorded references in a stable order
+func (r *resolution) sortedReferences() []ResolvedReference {
+	if len(r.references) == 0 {
+		return nil
+	}
+	references := make([]ResolvedReference, 0, len(r.references))
+	for ref := range r.references {
+		references = append(references, ref)
+	}
+	sort.Slice(references, func(i, j int) bool {
+		if references[i].KeyPath != references[j].KeyPath {
+			return references[i].KeyPath < references[j].KeyPath
+		}
+		return references[i].Reference < references[j].Reference
+	})
+	return references
+}
+
+// sortedLeases returns the recorded leases in a stable order
+func (r *resolution) sortedLeases() []SecretLease {
+	if len(r.leases) == 0 {
+		return nil
+	}
+	leases := make([]SecretLease, 0, len(r.leases))
+	for _, l := range r.leases {
+		leases = append(leases, l)
+	}
+	sort.Slice(leases, func(i, j int) bool {
+		return leases[i].Path < leases[j].Path
+	})
+	return leases
+}
+
+// sortedVersions returns the recorded secret versions in a stable order
+func (r *resolution) sortedVersions() []SecretVersion {
+	if len(r.versions) == 0 {
+		return nil
+	}
+	versions := make([]SecretVersion, 0, len(r.versions))
+	for _, v := range r.versions {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		if versions[i].Mount != versions[j].Mount {
+			return versions[i].Mount < versions[j].Mount
+		}
+		if versions[i].Path != versions[j].Path {
+			return versions[i].Path < versions[j].Path
+		}
+		return versions[i].Version < versions[j].Version
+	})
+	return versions
 }
 
 // resolveValue recursively processes data structures to resolve vault secrets
-func resolveValue(ctx context.Context, vaultClient *vaultpkg.VaultClient, cache map[string]map[string]any, data any, keyPath string) (any, error) {
+func resolveValue(ctx context.Context, vaultClient *vaultpkg.VaultClient, res *resolution, data any, keyPath string) (any, error) {
 	switch v := data.(type) {
 	case map[string]any:
-		return resolveMap(ctx, vaultClient, cache, v, keyPath)
+		return resolveMap(ctx, vaultClient, res, v, keyPath)
 	case []any:
-		return resolveSlice(ctx, vaultClient, cache, v, keyPath)
+		return resolveSlice(ctx, vaultClient, res, v, keyPath)
 	case string:
-		return resolveString(ctx, vaultClient, cache, v, keyPath)
+		return resolveString(ctx, vaultClient, res, v, keyPath)
 	default:
 		return data, nil
 	}
 }
 
-func resolveMap(ctx context.Context, vaultClient *vaultpkg.VaultClient, cache map[string]map[string]any, data map[string]any, keyPath string) (map[string]any, error) {
+func resolveMap(ctx context.Context, vaultClient *vaultpkg.VaultClient, res *resolution, data map[string]any, keyPath string) (map[string]any, error) {
 	result := make(map[string]any)
 
 	for key, value := range data {
+		if key == SecretRefKey {
+			continue
+		}
 		currentPath := buildKeyPath(keyPath, key)
-		resolvedValue, err := resolveValue(ctx, vaultClient, cache, value, currentPath)
+		resolvedValue, err := resolveValue(ctx, vaultClient, res, value, currentPath)
 		if err != nil {
 			return nil, err
 		}
 		result[key] = resolvedValue
 	}
 
+	if ref, ok := data[SecretRefKey]; ok {
+		if err := mergeSecretRef(ctx, vaultClient, res, result, ref, buildKeyPath(keyPath, SecretRefKey)); err != nil {
+			return nil, err
+		}
+	}
+
 	return result, nil
 }
 
-func resolveSlice(ctx context.Context, vaultClient *vaultpkg.VaultClient, cache map[string]map[string]any, data []any, keyPath string) ([]any, error) {
+// mergeSecretRef expands a vaultSecretRef field into the enclosing object. Keys set explicitly
+// on the object take precedence over keys from the secret.
+func mergeSecretRef(ctx context.Context, vaultClient *vaultpkg.VaultClient, res *resolution, result map[string]any, ref any, keyPath string) error {
+	refValue, ok := ref.(string)
+	if !ok {
+		return NewInvalidReferenceError(keyPath, fmt.Sprint(ref), "vaultSecretRef must be a vault reference string")
+	}
+	if _, _, external := res.registry.lookup(refValue); !external {
+		if !isReference(refValue) {
+			return NewInvalidReferenceError(keyPath, refValue, "vaultSecretRef must be a vault reference string")
+		}
+		if !strings.Contains(refValue, "#") {
+			refValue += "#" + wholeSecretKey
+		}
+	}
+
+	resolved, err := resolveString(ctx, vaultClient, res, refValue, keyPath)
+	if err != nil {
+		return err
+	}
+	secretData, ok := resolved.(map[string]any)
+	if !ok {
+		return NewInvalidReferenceError(keyPath, refValue, "vaultSecretRef must reference a whole secret (path#*)")
+	}
+
+	for key, value := range secretData {
+		if _, exists := result[key]; !exists {
+			result[key] = value
+		}
+	}
+	return nil
+}
+
+func resolveSlice(ctx context.Context, vaultClient *vaultpkg.VaultClient, res *resolution, data []any, keyPath string) ([]any, error) {
 	result := make([]any, len(data))
 
 	for i, item := range data {
 		currentPath := fmt.Sprintf("%s[%d]", keyPath, i)
-		resolvedValue, err := resolveValue(ctx, vaultClient, cache, item, currentPath)
+		resolvedValue, err := resolveValue(ctx, vaultClient, res, item, currentPath)
 		if err != nil {
 			return nil, err
 		}
@@ -169,73 +502,406 @@ func resolveSlice(ctx context.Context, vaultClient *vaultpkg.VaultClient, cache
 	return result, nil
 }
 
-func resolveString(ctx context.Context, vaultClient *vaultpkg.VaultClient, cache map[string]map[string]any, value string, keyPath string) (any, error) {
-	if !strings.HasPrefix(value, "vault:") {
+func resolveString(ctx context.Context, vaultClient *vaultpkg.VaultClient, res *resolution, value string, keyPath string) (any, error) {
+	value, err := substituteEnv(ctx, res, value, keyPath)
+	if err != nil {
+		return "", err
+	}
+	if isTemplate(value) {
+		return resolveTemplate(ctx, vaultClient, res, value, keyPath)
+	}
+	if resolver, ref, ok := res.registry.lookup(value); ok {
+		return resolveExternal(ctx, res, resolver, value, ref, keyPath)
+	}
+	if !isReference(value) {
 		return value, nil
 	}
 
 	logger := log.FromContext(ctx)
 	logger.V(1).Info("Resolving vault reference", "value", value)
 
-	path, key, err := parseVaultReference(value)
+	ref, transforms, err := parseValue(value, keyPath)
 	if err != nil {
 		logger.V(1).Info("Failed to parse vault reference", "value", value, "error", err)
-		return "", NewInvalidReferenceError(keyPath, value, err.Error())
+		return "", err
+	}
+	if vaultClient == nil {
+		return "", NewVaultNotConfiguredError(keyPath, value)
+	}
+	if ref.Mount == "" && !ref.Dynamic {
+		ref.Mount = vaultClient.Config.MountPath
 	}
 
 	// Get secret data with caching
-	secretData, err := getPathData(vaultClient, cache, path, keyPath, value)
+	secretData, err := getPathData(ctx, vaultClient, res, ref, keyPath, value)
 	if err != nil {
 		logger.V(1).Info("Failed to get vault secret", "value", value, "error", err)
 		return "", err
 	}
 
-	secretValue, exists := secretData[key]
+	if ref.Key == wholeSecretKey {
+		res.recordSensitive(secretData)
+		res.recordReference(keyPath, value)
+		return maps.Clone(secretData), nil
+	}
+
+	secretValue, exists := secretData[ref.Key]
+	if !exists && vaultClient.Cache != nil && !ref.Dynamic {
+		// A cached copy may predate the key being added; read the secret again before giving up
+		res.invalidate(vaultClient, ref.cacheKey())
+		secretData, err = getPathData(ctx, vaultClient, res, ref, keyPath, value)
+		if err != nil {
+			return "", err
+		}
+		secretValue, exists = secretData[ref.Key]
+	}
 	if !exists {
 		availableKeys := getKeys(secretData)
-		return "", NewKeyNotFoundError(keyPath, key, path, availableKeys)
+		return "", NewKeyNotFoundError(keyPath, ref.Key, ref.Path, availableKeys)
 	}
 
+	res.recordSensitive(secretValue)
+	if len(transforms) > 0 {
+		transformed, err := applyTransforms(secretValue, transforms, keyPath, value)
+		if err != nil {
+			return "", err
+		}
+		res.recordSensitive(transformed)
+		res.recordReference(keyPath, value)
+		return transformed, nil
+	}
+	res.recordReference(keyPath, value)
 	return secretValue, nil
 }
 
-// getPathData returns secret data for a Vault KV path, using cache when possible
-func getPathData(vaultClient *vaultpkg.VaultClient, cache map[string]map[string]any, path, keyPath, vaultRef string) (map[string]any, error) {
+// getPathData returns secret data for a Vault KV reference, using the per-call cache and the
+// client's shared cache when possible
+func getPathData(ctx context.Context, vaultClient *vaultpkg.VaultClient, res *resolution, ref vaultReference, keyPath, vaultRef string) (map[string]any, error) {
 	// Check cache first
-	if data, ok := cache[path]; ok {
+	cacheKey := ref.cacheKey()
+	if data, ok := res.cache[cacheKey]; ok {
 		return data, nil
 	}
 
-	secret, err := vaultClient.Client.KVv2(vaultClient.Config.MountPath).Get(context.Background(), path)
+	if cached, ok := getSharedCache(vaultClient, ref); ok {
+		res.store(cacheKey, ref, cached)
+		return cached.Data, nil
+	}
+
+	cached, err := fetchPathData(ctx, vaultClient, ref, keyPath, vaultRef)
 	if err != nil {
-		return nil, NewVaultAPIError(keyPath, vaultRef, err)
+		return nil, err
 	}
 
-	data, err := extractSecretData(secret.Raw)
+	// Cache the result
+	res.store(cacheKey, ref, cached)
+	return cached.Data, nil
+}
+
+// fetchPathData reads a secret from Vault and stores it in the client's shared cache
+func fetchPathData(ctx context.Context, vaultClient *vaultpkg.VaultClient, ref vaultReference, keyPath, vaultRef string) (vaultpkg.CachedSecret, error) {
+	if ref.Dynamic {
+		return fetchDynamicData(ctx, vaultClient, ref, keyPath, vaultRef)
+	}
+
+	kvVersion := vaultpkg.KVVersion(ctx, vaultClient, ref.Mount)
+	if kvVersion == vaultpkg.KVVersion1 && ref.Version > 0 {
+		return vaultpkg.CachedSecret{}, NewInvalidReferenceError(keyPath, vaultRef, ErrVersionNotSupported.Error())
+	}
+
+	ctx, endRead := startSecretRead(ctx, engineKV, ref.Mount+"/"+ref.Path)
+	secret, err := readWithRetry(ctx, func() (*vaultapi.KVSecret, error) {
+		switch {
+		case kvVersion == vaultpkg.KVVersion1:
+			return vaultClient.Client.KVv1(ref.Mount).Get(ctx, ref.Path)
+		case ref.Version > 0:
+			return vaultClient.Client.KVv2(ref.Mount).GetVersion(ctx, ref.Path, ref.Version)
+		default:
+			return vaultClient.Client.KVv2(ref.Mount).Get(ctx, ref.Path)
+		}
+	})
+	endRead(err)
+	if err != nil {
+		return vaultpkg.CachedSecret{}, NewVaultAPIError(keyPath, vaultRef, err)
+	}
+
+	data, err := extractSecretData(secret.Raw, kvVersion)
 	if err != nil {
 		if errors.Is(err, ErrSecretDataNil) {
-			return nil, NewSecretDataNilError(keyPath, vaultRef)
+			return vaultpkg.CachedSecret{}, NewSecretDataNilError(keyPath, vaultRef)
 		}
-		return nil, NewSecretNotFoundError(keyPath, vaultRef, path)
+		return vaultpkg.CachedSecret{}, NewSecretNotFoundError(keyPath, vaultRef, ref.Path)
 	}
 
-	// Cache the result
-	cache[path] = data
-	return data, nil
+	cached := vaultpkg.CachedSecret{Data: data}
+	if kvVersion != vaultpkg.KVVersion1 && secret.VersionMetadata != nil {
+		cached.Version = secret.VersionMetadata.Version
+	}
+
+	vaultClient.Cache.Set(ref.cacheKey(), cached)
+	return cached, nil
+}
+
+// fetchDynamicData reads a path of a dynamic secrets engine, which issues new credentials on every
+// read. The result is never stored in the shared cache.
+func fetchDynamicData(ctx context.Context, vaultClient *vaultpkg.VaultClient, ref vaultReference, keyPath, vaultRef string) (vaultpkg.CachedSecret, error) {
+	ctx, endRead := startSecretRead(ctx, engineDynamic, ref.Path)
+	secret, err := readWithRetry(ctx, func() (*vaultapi.Secret, error) {
+		return vaultClient.Client.Logical().ReadWithContext(ctx, ref.Path)
+	})
+	endRead(err)
+	if err != nil {
+		return vaultpkg.CachedSecret{}, NewVaultAPIError(keyPath, vaultRef, err)
+	}
+	if secret == nil {
+		return vaultpkg.CachedSecret{}, NewSecretNotFoundError(keyPath, vaultRef, ref.Path)
+	}
+	if secret.Data == nil {
+		return vaultpkg.CachedSecret{}, NewSecretDataNilError(keyPath, vaultRef)
+	}
+	return vaultpkg.CachedSecret{
+		Data:          secret.Data,
+		LeaseID:       secret.LeaseID,
+		LeaseDuration: secret.LeaseDuration,
+		Renewable:     secret.Renewable,
+	}, nil
+}
+
+// getSharedCache looks a reference up in the client's shared cache; dynamic credentials are never cached
+func getSharedCache(vaultClient *vaultpkg.VaultClient, ref vaultReference) (vaultpkg.CachedSecret, bool) {
+	if ref.Dynamic {
+		return vaultpkg.CachedSecret{}, false
+	}
+	return vaultClient.Cache.Get(ref.cacheKey())
+}
+
+// isReference reports whether a string is a vault: or vaultDynamic: reference
+func isReference(value string) bool {
+	return strings.HasPrefix(value, referencePrefix) || strings.HasPrefix(value, dynamicReferencePrefix)
+}
+
+// ReferencesVault reports whether any of the configurations holds a Vault reference, template or
+// vaultSecretRef field, and so needs a Vault client to be resolved
+func ReferencesVault(rawConfigs ...*runtime.RawExtension) bool {
+	for _, rawConfig := range rawConfigs {
+		if rawConfig == nil || rawConfig.Raw == nil {
+			continue
+		}
+		var data any
+		if err := json.Unmarshal(rawConfig.Raw, &data); err != nil {
+			continue
+		}
+		if referencesVault(data) {
+			return true
+		}
+	}
+	return false
+}
+
+// referencesVault reports whether data holds a Vault reference, template or vaultSecretRef field
+func referencesVault(data any) bool {
+	switch v := data.(type) {
+	case map[string]any:
+		if _, ok := v[SecretRefKey]; ok {
+			return true
+		}
+		for _, value := range v {
+			if referencesVault(value) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if referencesVault(item) {
+				return true
+			}
+		}
+	case string:
+		return isReference(v) || isTemplate(v)
+	}
+	return false
+}
+
+// store caches secret data for this call and records its version
+func (r *resolution) store(cacheKey string, ref vaultReference, secret vaultpkg.CachedSecret) {
+	r.cache[cacheKey] = secret.Data
+	if !ref.Dynamic {
+		r.cacheKeys[cacheKey] = struct{}{}
+	}
+	if secret.Version > 0 {
+		r.versions[cacheKey] = SecretVersion{
+			Mount:   ref.Mount,
+			Path:    ref.Path,
+			Version: secret.Version,
+			Pinned:  ref.Version > 0,
+		}
+	}
+	if secret.LeaseID != "" {
+		r.leases[cacheKey] = SecretLease{
+			Path:          ref.Path,
+			LeaseID:       secret.LeaseID,
+			LeaseDuration: secret.LeaseDuration,
+			Renewable:     secret.Renewable,
+		}
+	}
+}
+
+// invalidate drops a path from this call's cache and the shared cache so it is read again
+func (r *resolution) invalidate(vaultClient *vaultpkg.VaultClient, cacheKey string) {
+	delete(r.cache, cacheKey)
+	delete(r.versions, cacheKey)
+	vaultClient.Cache.Invalidate(cacheKey)
+}
+
+// invalidateAll drops the secrets read by a failed resolution from the shared cache, so a stale
+// copy that caused the failure, such as a value that no longer decodes, is not served again
+func (r *resolution) invalidateAll(vaultClient *vaultpkg.VaultClient) {
+	if vaultClient == nil {
+		return
+	}
+	for cacheKey := range r.cache {
+		vaultClient.Cache.Invalidate(cacheKey)
+	}
+}
+
+// InvalidateCached drops the secrets with the given keys, as reported in Result.CacheKeys, from the
+// client's shared cache, so they are read from Vault again. It is meant for resolved values that
+// Fivetran rejects, which a cached copy of a rotated secret would otherwise keep sending.
+func InvalidateCached(vaultClient *vaultpkg.VaultClient, cacheKeys []string) {
+	if vaultClient == nil {
+		return
+	}
+	for _, cacheKey := range cacheKeys {
+		vaultClient.Cache.Invalidate(cacheKey)
+	}
+}
+
+// vaultReference is a parsed vault secret reference
+type vaultReference struct {
+	// Mount is the KV mount to read from; empty means the configured default mount
+	Mount string
+	Path  string
+	Key   string
+	// Version pins a KV v2 secret version; zero means the latest version
+	Version int
+	// Dynamic marks a vaultDynamic: reference, whose Path is a full logical path such as database/creds/role
+	Dynamic bool
+}
+
+// cacheKey identifies the secret a reference reads, independent of the key within it
+func (r vaultReference) cacheKey() string {
+	if r.Dynamic {
+		return dynamicReferencePrefix + r.Path
+	}
+	key := r.Mount + "/" + r.Path
+	if r.Version > 0 {
+		key += "@" + strconv.Itoa(r.Version)
+	}
+	return key
+}
+
+// parseValue parses a reference value and its |transform suffixes, returning an invalid reference
+// error for keyPath when either is malformed
+func parseValue(value, keyPath string) (vaultReference, []string, error) {
+	refValue, transforms, err := splitTransforms(value)
+	if err != nil {
+		return vaultReference{}, nil, NewInvalidReferenceError(keyPath, value, err.Error())
+	}
+
+	ref, err := parseReference(refValue)
+	if err != nil {
+		return vaultReference{}, nil, NewInvalidReferenceError(keyPath, value, err.Error())
+	}
+	if ref.Key == wholeSecretKey && len(transforms) > 0 {
+		return vaultReference{}, nil, NewInvalidReferenceError(keyPath, value, "transforms cannot be applied to a whole secret")
+	}
+	return ref, transforms, nil
+}
+
+// parseReference parses the vault:path#key and vault://mount/path#key formats, each optionally
+// followed by an @version suffix, and the vaultDynamic:path#key format
+func parseReference(value string) (vaultReference, error) {
+	if rest, ok := strings.CutPrefix(value, dynamicReferencePrefix); ok {
+		path, key, err := splitPathAndKey(rest)
+		if err != nil {
+			return vaultReference{}, fmt.Errorf("%w: '%s'", ErrInvalidVaultReference, value)
+		}
+		return vaultReference{Path: path, Key: key, Dynamic: true}, nil
+	}
+
+	ref, err := parseUnversionedReference(value)
+	if err != nil {
+		return vaultReference{}, err
+	}
+
+	key, version, err := splitKeyAndVersion(ref.Key)
+	if err != nil {
+		return vaultReference{}, fmt.Errorf("%w: '%s'", ErrInvalidVaultReference, value)
+	}
+	ref.Key = key
+	ref.Version = version
+	return ref, nil
+}
+
+// parseUnversionedReference parses a reference without interpreting a version suffix
+func parseUnversionedReference(value string) (vaultReference, error) {
+	if rest, ok := strings.CutPrefix(value, "vault://"); ok {
+		mount, pathAndKey, found := strings.Cut(rest, "/")
+		if !found || mount == "" {
+			return vaultReference{}, fmt.Errorf("%w: '%s'", ErrInvalidVaultReference, value)
+		}
+		path, key, err := splitPathAndKey(pathAndKey)
+		if err != nil {
+			return vaultReference{}, fmt.Errorf("%w: '%s'", ErrInvalidVaultReference, value)
+		}
+		return vaultReference{Mount: mount, Path: path, Key: key}, nil
+	}
+
+	path, key, err := parseVaultReference(value)
+	if err != nil {
+		return vaultReference{}, err
+	}
+	return vaultReference{Path: path, Key: key}, nil
 }
 
 // parseVaultReference parses vault:path#key format
 func parseVaultReference(value string) (path, key string, err error) {
-	ref := strings.TrimPrefix(value, "vault:")
+	path, key, err = splitPathAndKey(strings.TrimPrefix(value, "vault:"))
+	if err != nil {
+		return "", "", fmt.Errorf("%w: '%s'", ErrInvalidVaultReference, value)
+	}
+	return path, key, nil
+}
+
+// splitPathAndKey splits path#key into its non-empty parts
+func splitPathAndKey(ref string) (path, key string, err error) {
 	parts := strings.SplitN(ref, "#", 2)
 	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
-		return "", "", fmt.Errorf("%w: '%s'", ErrInvalidVaultReference, value)
+		return "", "", ErrInvalidVaultReference
 	}
 	return parts[0], parts[1], nil
 }
 
-// extractSecretData extracts secret data from KV v2 format
-func extractSecretData(secret *vaultapi.Secret) (map[string]any, error) {
+// splitKeyAndVersion splits a key@version suffix. Keys without a numeric suffix are returned
+// unchanged so that keys containing '@' keep working.
+func splitKeyAndVersion(ref string) (key string, version int, err error) {
+	idx := strings.LastIndex(ref, "@")
+	if idx < 0 {
+		return ref, 0, nil
+	}
+	suffix := ref[idx+1:]
+	if suffix == "" || strings.Trim(suffix, "0123456789") != "" {
+		return ref, 0, nil
+	}
+	version, err = strconv.Atoi(suffix)
+	if err != nil || version < 1 || idx == 0 {
+		return "", 0, ErrInvalidVaultReference
+	}
+	return ref[:idx], version, nil
+}
+
+// extractSecretData extracts secret data from KV v1 or KV v2 format
+func extractSecretData(secret *vaultapi.Secret, kvVersion int) (map[string]any, error) {
 	if secret == nil {
 		return nil, ErrSecretDataNil
 	}
@@ -244,6 +910,11 @@ func extractSecretData(secret *vaultapi.Secret) (map[string]any, error) {
 		return nil, ErrSecretDataNil
 	}
 
+	// KV v1 returns the secret data at the top level
+	if kvVersion == vaultpkg.KVVersion1 {
+		return secret.Data, nil
+	}
+
 	// Extract data from KV v2 format (data is nested under "data" key)
 	data, ok := secret.Data["data"].(map[string]any)
 	if !ok {
@@ -7,6 +7,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	vaultapi "github.com/hashicorp/vault/api"
 	vaulthttp "github.com/hashicorp/vault/http"
@@ -52,6 +53,20 @@ func setupTestVault(t *testing.T) (*vaultapi.Client, func()) {
 		t.Fatalf("failed to write test secret: %v", err)
 	}
 
+	// Create "legacy" KV v1 mount
+	if err := client.Sys().Mount("legacy", &vaultapi.MountInput{
+		Type:    "kv",
+		Options: map[string]string{"version": "1"},
+	}); err != nil {
+		t.Fatalf("failed to create legacy mount: %v", err)
+	}
+
+	if err := client.KVv1("legacy").Put(context.Background(), "test-secret", map[string]any{
+		"api_key": "my-legacy-key",
+	}); err != nil {
+		t.Fatalf("failed to write legacy test secret: %v", err)
+	}
+
 	return client, func() {
 		if err := os.Unsetenv("VAULT_SKIP_VERIFY"); err != nil {
 			t.Logf("failed to unset VAULT_SKIP_VERIFY: %v", err)
@@ -122,6 +137,20 @@ func TestResolveSecrets(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "per-reference mount paths",
+			input: map[string]any{
+				"default": "vault:test-secret#api_key",
+				"legacy":  "vault://legacy/test-secret#api_key",
+				"apps":    "vault://apps/test-secret#username",
+			},
+			expected: map[string]any{
+				"default": "my-test-key",
+				"legacy":  "my-legacy-key",
+				"apps":    "test-user",
+			},
+			expectError: false,
+		},
 		{
 			name: "failure stops processing",
 			input: map[string]any{
@@ -186,6 +215,336 @@ func TestResolveSecrets(t *testing.T) {
 	}
 }
 
+func TestResolveSecretsKVv1(t *testing.T) {
+	client, cleanup := setupTestVault(t)
+	defer cleanup()
+
+	tests := []struct {
+		name        string
+		mountPath   string
+		kvVersion   int
+		expected    string
+		expectError bool
+	}{
+		{
+			name:      "explicit KV v1",
+			mountPath: "legacy",
+			kvVersion: vaultpkg.KVVersion1,
+			expected:  "my-legacy-key",
+		},
+		{
+			name:      "detected KV v1",
+			mountPath: "legacy",
+			expected:  "my-legacy-key",
+		},
+		{
+			name:      "detected KV v2",
+			mountPath: "apps",
+			expected:  "my-test-key",
+		},
+		{
+			name:        "KV v2 configured for a KV v1 mount",
+			mountPath:   "legacy",
+			kvVersion:   vaultpkg.KVVersion2,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultClient := &vaultpkg.VaultClient{
+				Client: client,
+				Config: &vaultpkg.ClientConfig{
+					MountPath: tt.mountPath,
+					KVVersion: tt.kvVersion,
+				},
+			}
+			rawExt := &runtime.RawExtension{Raw: []byte(`{"key":"vault:test-secret#api_key"}`)}
+
+			err := ResolveSecrets(context.Background(), vaultClient, rawExt)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			var result map[string]any
+			if err := json.Unmarshal(rawExt.Raw, &result); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if result["key"] != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result["key"])
+			}
+		})
+	}
+}
+
+func TestResolveSecretsWithVersions(t *testing.T) {
+	client, cleanup := setupTestVault(t)
+	defer cleanup()
+
+	// Create a second version of the test secret
+	if _, err := client.KVv2("apps").Put(context.Background(), "test-secret", map[string]any{
+		"api_key": "my-rotated-key",
+	}); err != nil {
+		t.Fatalf("failed to write test secret version: %v", err)
+	}
+
+	vaultClient := &vaultpkg.VaultClient{
+		Client: client,
+		Config: &vaultpkg.ClientConfig{MountPath: "apps"},
+	}
+
+	tests := []struct {
+		name             string
+		input            string
+		expected         map[string]any
+		expectedVersions []SecretVersion
+		expectError      bool
+	}{
+		{
+			name:     "latest version",
+			input:    `{"key":"vault:test-secret#api_key"}`,
+			expected: map[string]any{"key": "my-rotated-key"},
+			expectedVersions: []SecretVersion{
+				{Mount: "apps", Path: "test-secret", Version: 2},
+			},
+		},
+		{
+			name:     "pinned version",
+			input:    `{"key":"vault:test-secret#api_key@1"}`,
+			expected: map[string]any{"key": "my-test-key"},
+			expectedVersions: []SecretVersion{
+				{Mount: "apps", Path: "test-secret", Version: 1, Pinned: true},
+			},
+		},
+		{
+			name:     "pinned and latest versions together",
+			input:    `{"old":"vault:test-secret#api_key@1","new":"vault:test-secret#api_key"}`,
+			expected: map[string]any{"old": "my-test-key", "new": "my-rotated-key"},
+			expectedVersions: []SecretVersion{
+				{Mount: "apps", Path: "test-secret", Version: 1, Pinned: true},
+				{Mount: "apps", Path: "test-secret", Version: 2},
+			},
+		},
+		{
+			name:     "KV v1 secrets are not versioned",
+			input:    `{"key":"vault://legacy/test-secret#api_key"}`,
+			expected: map[string]any{"key": "my-legacy-key"},
+		},
+		{
+			name:        "pinned version on KV v1 mount",
+			input:       `{"key":"vault://legacy/test-secret#api_key@1"}`,
+			expectError: true,
+		},
+		{
+			name:        "missing version",
+			input:       `{"key":"vault:test-secret#api_key@9"}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawExt := &runtime.RawExtension{Raw: []byte(tt.input)}
+
+			versions, err := ResolveSecretsWithVersions(context.Background(), vaultClient, rawExt)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			var result map[string]any
+			if err := json.Unmarshal(rawExt.Raw, &result); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("result mismatch:\nexpected: %+v\ngot:      %+v", tt.expected, result)
+			}
+			if !reflect.DeepEqual(versions, tt.expectedVersions) {
+				t.Errorf("versions mismatch:\nexpected: %+v\ngot:      %+v", tt.expectedVersions, versions)
+			}
+		})
+	}
+}
+
+func TestResolveSecretsWholeSecret(t *testing.T) {
+	client, cleanup := setupTestVault(t)
+	defer cleanup()
+
+	vaultClient := &vaultpkg.VaultClient{
+		Client: client,
+		Config: &vaultpkg.ClientConfig{MountPath: "apps"},
+	}
+	secret := map[string]any{"api_key": "my-test-key", "username": "test-user", "password": "test-pass"}
+
+	tests := []struct {
+		name        string
+		input       string
+		expected    map[string]any
+		expectError bool
+	}{
+		{
+			name:     "whole secret value",
+			input:    `{"oauth":"vault:test-secret#*"}`,
+			expected: map[string]any{"oauth": secret},
+		},
+		{
+			name:     "whole secret from another mount",
+			input:    `{"oauth":"vault://legacy/test-secret#*"}`,
+			expected: map[string]any{"oauth": map[string]any{"api_key": "my-legacy-key"}},
+		},
+		{
+			name:  "secret ref merged into object",
+			input: `{"vaultSecretRef":"vault:test-secret","host":"db.example.com"}`,
+			expected: map[string]any{
+				"api_key":  "my-test-key",
+				"username": "test-user",
+				"password": "test-pass",
+				"host":     "db.example.com",
+			},
+		},
+		{
+			name:  "explicit keys override secret ref",
+			input: `{"nested":{"vaultSecretRef":"vault:test-secret#*","username":"override"}}`,
+			expected: map[string]any{"nested": map[string]any{
+				"api_key":  "my-test-key",
+				"username": "override",
+				"password": "test-pass",
+			}},
+		},
+		{
+			name:        "secret ref with single key",
+			input:       `{"vaultSecretRef":"vault:test-secret#api_key"}`,
+			expectError: true,
+		},
+		{
+			name:        "secret ref that is not a reference",
+			input:       `{"vaultSecretRef":"test-secret"}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawExt := &runtime.RawExtension{Raw: []byte(tt.input)}
+
+			err := ResolveSecrets(context.Background(), vaultClient, rawExt)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			var result map[string]any
+			if err := json.Unmarshal(rawExt.Raw, &result); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("result mismatch:\nexpected: %+v\ngot:      %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestResolveSecretsSharedCache(t *testing.T) {
+	client, cleanup := setupTestVault(t)
+	defer cleanup()
+
+	vaultClient := &vaultpkg.VaultClient{
+		Client: client,
+		Config: &vaultpkg.ClientConfig{MountPath: "apps"},
+		Cache:  vaultpkg.NewSecretCache(time.Hour, 10),
+	}
+
+	resolve := func(input string) (map[string]any, error) {
+		rawExt := &runtime.RawExtension{Raw: []byte(input)}
+		if err := ResolveSecrets(context.Background(), vaultClient, rawExt); err != nil {
+			return nil, err
+		}
+		var result map[string]any
+		if err := json.Unmarshal(rawExt.Raw, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	if _, err := resolve(`{"key":"vault:test-secret#api_key"}`); err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if vaultClient.Cache.Len() != 1 {
+		t.Fatalf("expected secret to be cached, got %d entries", vaultClient.Cache.Len())
+	}
+
+	// Rotate the secret; the cached copy is served until it expires
+	if _, err := client.KVv2("apps").Put(context.Background(), "test-secret", map[string]any{
+		"api_key":  "my-rotated-key",
+		"new_key":  "new-value",
+		"username": "test-user",
+	}); err != nil {
+		t.Fatalf("failed to rotate test secret: %v", err)
+	}
+
+	result, err := resolve(`{"key":"vault:test-secret#api_key"}`)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if result["key"] != "my-test-key" {
+		t.Errorf("expected cached value %q, got %q", "my-test-key", result["key"])
+	}
+
+	// A key missing from the cached copy invalidates it and reads the secret again
+	result, err = resolve(`{"key":"vault:test-secret#new_key","old":"vault:test-secret#api_key"}`)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if result["key"] != "new-value" {
+		t.Errorf("expected refreshed value %q, got %q", "new-value", result["key"])
+	}
+
+	result, err = resolve(`{"key":"vault:test-secret#api_key"}`)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if result["key"] != "my-rotated-key" {
+		t.Errorf("expected refreshed value %q, got %q", "my-rotated-key", result["key"])
+	}
+
+	// A failed resolution drops the secrets it read from the cache
+	if _, err := resolve(`{"key":"vault:test-secret#username|b64dec"}`); err == nil {
+		t.Fatalf("expected error for a value that does not decode but got none")
+	}
+	if vaultClient.Cache.Len() != 0 {
+		t.Errorf("expected the failed read to be invalidated, got %d entries", vaultClient.Cache.Len())
+	}
+
+	// Values Fivetran rejects are invalidated with the keys of the result
+	resolved, err := Resolve(context.Background(), vaultClient, &runtime.RawExtension{Raw: []byte(`{"key":"vault:test-secret#api_key"}`)})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if !reflect.DeepEqual(resolved.CacheKeys, []string{"apps/test-secret"}) {
+		t.Errorf("expected cache keys %v, got %v", []string{"apps/test-secret"}, resolved.CacheKeys)
+	}
+	InvalidateCached(vaultClient, resolved.CacheKeys)
+	if vaultClient.Cache.Len() != 0 {
+		t.Errorf("expected the secret to be invalidated, got %d entries", vaultClient.Cache.Len())
+	}
+}
+
 func TestParseVaultReference(t *testing.T) {
 	tests := []struct {
 		input       string
@@ -216,6 +575,43 @@ func TestParseVaultReference(t *testing.T) {
 	}
 }
 
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    vaultReference
+		expectError bool
+	}{
+		{input: "vault:apps/secret#mykey", expected: vaultReference{Path: "apps/secret", Key: "mykey"}},
+		{input: "vault://legacy/db/creds#password", expected: vaultReference{Mount: "legacy", Path: "db/creds", Key: "password"}},
+		{input: "vault://legacy#password", expectError: true}, // missing path
+		{input: "vault:///path#key", expectError: true},       // empty mount
+		{input: "vault://legacy/path", expectError: true},     // missing key
+		{input: "vault:apps/secret#mykey@3", expected: vaultReference{Path: "apps/secret", Key: "mykey", Version: 3}},
+		{input: "vault://legacy/db/creds#password@12", expected: vaultReference{Mount: "legacy", Path: "db/creds", Key: "password", Version: 12}},
+		{input: "vault:apps/secret#user@example.com", expected: vaultReference{Path: "apps/secret", Key: "user@example.com"}},
+		{input: "vault:apps/secret#mykey@0", expectError: true}, // versions start at 1
+		{input: "vault:apps/secret#@3", expectError: true},      // empty key
+		{input: "vaultDynamic:database/creds/role#username", expected: vaultReference{Path: "database/creds/role", Key: "username", Dynamic: true}},
+		{input: "vaultDynamic:database/creds/role", expectError: true}, // missing key
+	}
+
+	for _, tt := range tests {
+		ref, err := parseReference(tt.input)
+		if tt.expectError {
+			if err == nil {
+				t.Errorf("parseReference(%q) expected error but got none", tt.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseReference(%q) unexpected error: %v", tt.input, err)
+		}
+		if ref != tt.expected {
+			t.Errorf("parseReference(%q) = %+v, expected %+v", tt.input, ref, tt.expected)
+		}
+	}
+}
+
 func TestVaultErrorRetryability(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -0,0 +1,73 @@
+package fivetran
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	httputils "github.com/fivetran/go-fivetran/http_utils"
+)
+
+// APIVersion is a version of the Fivetran REST API, selected per request with the Accept header
+type APIVersion string
+
+const (
+	// APIVersionDefault keeps the Accept header the SDK sends for each endpoint, which changes
+	// with SDK releases
+	APIVersionDefault APIVersion = ""
+	// APIVersion1 requests the original response shapes
+	APIVersion1 APIVersion = "1"
+	// APIVersion2 requests the version 2 response shapes, such as connection config with the
+	// fields of the connection's service only
+	APIVersion2 APIVersion = "2"
+)
+
+// acceptHeaders are the Accept headers requesting each pinned API version
+var acceptHeaders = map[APIVersion]string{
+	APIVersion1: "application/json",
+	APIVersion2: "application/json;version=2",
+}
+
+// Validate returns an error when v is not a known API version
+func (v APIVersion) Validate() error {
+	if _, ok := acceptHeaders[v]; !ok && v != APIVersionDefault {
+		return fmt.Errorf("unsupported Fivetran API version %q, expected 1 or 2", string(v))
+	}
+	return nil
+}
+
+// versionPinningClient sets the Accept header of every request to the pinned API version, so
+// Fivetran or SDK changes to the default version do not change the responses the operator parses
+type versionPinningClient struct {
+	version            APIVersion
+	connectionsVersion APIVersion
+	next               httputils.HttpClient
+}
+
+// newVersionPinningClient wraps next with version pinning, or returns next when no version is
+// pinned
+func newVersionPinningClient(next httputils.HttpClient, version, connectionsVersion APIVersion) httputils.HttpClient {
+	if version == APIVersionDefault && connectionsVersion == APIVersionDefault {
+		return next
+	}
+	return &versionPinningClient{version: version, connectionsVersion: connectionsVersion, next: next}
+}
+
+// Do sets the Accept header of the request's API version and performs it
+func (c *versionPinningClient) Do(req *http.Request) (*http.Response, error) {
+	version := c.version
+	if c.connectionsVersion != APIVersionDefault && isConnectionsPath(req.URL.Path) {
+		version = c.connectionsVersion
+	}
+	if accept, ok := acceptHeaders[version]; ok {
+		req.Header.Set("Accept", accept)
+	}
+	return c.next.Do(req)
+}
+
+// isConnectionsPath reports whether path is a connections endpoint, including a group's list of
+// connections
+func isConnectionsPath(path string) bool {
+	return slices.Contains(strings.Split(path, "/"), "connections")
+}
@@ -0,0 +1,40 @@
+package fivetran
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVersionPinning(t *testing.T) {
+	var accepts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accepts = append(accepts, r.Header.Get("Accept"))
+		_, _ = w.Write([]byte(`{"code":"Success","data":{"id":"id"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{
+		Retry:                 RetryConfig{MaxAttempts: 1},
+		BaseURL:               server.URL,
+		APIVersion:            APIVersion1,
+		ConnectionsAPIVersion: APIVersion2,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, err := client.Groups.GetGroup(t.Context(), "id"); err != nil {
+		t.Fatalf("failed to get group: %v", err)
+	}
+	if _, err := client.Connections.GetConnection(t.Context(), "id"); err != nil {
+		t.Fatalf("failed to get connection: %v", err)
+	}
+	if len(accepts) != 2 || accepts[0] != "application/json" || accepts[1] != "application/json;version=2" {
+		t.Errorf("expected the pinned Accept headers, got %v", accepts)
+	}
+
+	if _, err := NewClientWithConfig("key", "secret", ClientConfig{APIVersion: "3"}); err == nil {
+		t.Errorf("expected an error for an unsupported API version")
+	}
+}
@@ -0,0 +1,71 @@
+package fivetran
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WebhookSignatureHeader is the header of Fivetran webhook requests holding the hex-encoded
+// HMAC-SHA256 of the body, keyed with the webhook's secret
+const WebhookSignatureHeader = "X-Fivetran-Signature-256"
+
+// ErrInvalidWebhookSignature is returned for webhook requests without a valid signature
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// WebhookEvent is an event Fivetran sends to a webhook, such as sync_start or sync_end
+type WebhookEvent struct {
+	Event string `json:"event"`
+	// Created is when the event happened
+	Created       time.Time `json:"created"`
+	ConnectorType string    `json:"connector_type"`
+	// ConnectorID is the ID of the connection; newer payloads name it connection_id
+	ConnectorID   string         `json:"connector_id"`
+	ConnectionID  string         `json:"connection_id"`
+	ConnectorName string         `json:"connector_name"`
+	SyncID        string         `json:"sync_id"`
+	GroupID       string         `json:"destination_group_id"`
+	Data          map[string]any `json:"data"`
+}
+
+// ID returns the ID of the connection the event is about, whichever field names it
+func (e *WebhookEvent) ID() string {
+	if e.ConnectionID != "" {
+		return e.ConnectionID
+	}
+	return e.ConnectorID
+}
+
+// Status returns the status of the event's data, such as SUCCESSFUL or FAILURE_WITH_TASK for a
+// sync_end event, or empty when it has none
+func (e *WebhookEvent) Status() string {
+	status, _ := e.Data["status"].(string)
+	return status
+}
+
+// ParseWebhookEvent verifies the signature of a webhook request body against the webhook's secret
+// and decodes the event it holds. The signature is compared in constant time.
+func ParseWebhookEvent(body []byte, signature string, secret []byte) (*WebhookEvent, error) {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || len(expected) == 0 {
+		return nil, ErrInvalidWebhookSignature
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	event := &WebhookEvent{}
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook event: %w", err)
+	}
+	if event.Event == "" {
+		return nil, errors.New("webhook event has no event type")
+	}
+	return event, nil
+}
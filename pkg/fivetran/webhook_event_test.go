@@ -0,0 +1,80 @@
+package fivetran
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func signWebhookBody(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return strings.ToUpper(hex.EncodeToString(mac.Sum(nil)))
+}
+
+func TestParseWebhookEvent(t *testing.T) {
+	body := `{"event":"sync_end","created":"2025-03-04T11:38:34.386Z","connector_type":"postgres",
+		"connector_id":"mystified_presiding","destination_group_id":"deck_enjoy","data":{"status":"SUCCESSFUL"}}`
+
+	event, err := ParseWebhookEvent([]byte(body), signWebhookBody("secret", body), []byte("secret"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Event != "sync_end" || event.ID() != "mystified_presiding" || event.GroupID != "deck_enjoy" {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	if event.Status() != "SUCCESSFUL" {
+		t.Errorf("expected status SUCCESSFUL, got %q", event.Status())
+	}
+	if event.Created.IsZero() {
+		t.Error("expected the creation time to be decoded")
+	}
+
+	// Lowercase signatures are accepted as well
+	if _, err := ParseWebhookEvent([]byte(body), strings.ToLower(signWebhookBody("secret", body)), []byte("secret")); err != nil {
+		t.Errorf("unexpected error for a lowercase signature: %v", err)
+	}
+}
+
+func TestParseWebhookEventConnectionID(t *testing.T) {
+	body := `{"event":"sync_start","connection_id":"new_id","connector_id":"old_id"}`
+	event, err := ParseWebhookEvent([]byte(body), signWebhookBody("secret", body), []byte("secret"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.ID() != "new_id" {
+		t.Errorf("expected connection_id to take precedence, got %q", event.ID())
+	}
+}
+
+func TestParseWebhookEventRejected(t *testing.T) {
+	body := `{"event":"sync_end","connector_id":"mystified_presiding"}`
+	tests := []struct {
+		name      string
+		body      string
+		signature string
+	}{
+		{name: "missing signature", body: body},
+		{name: "malformed signature", body: body, signature: "not-hex"},
+		{name: "other secret", body: body, signature: signWebhookBody("other", body)},
+		{name: "tampered body", body: strings.Replace(body, "sync_end", "sync_start", 1), signature: signWebhookBody("secret", body)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseWebhookEvent([]byte(tt.body), tt.signature, []byte("secret"))
+			if !errors.Is(err, ErrInvalidWebhookSignature) {
+				t.Errorf("expected ErrInvalidWebhookSignature, got %v", err)
+			}
+		})
+	}
+
+	for _, invalid := range []string{`not json`, `{"connector_id":"mystified_presiding"}`} {
+		if _, err := ParseWebhookEvent([]byte(invalid), signWebhookBody("secret", invalid), []byte("secret")); err == nil ||
+			errors.Is(err, ErrInvalidWebhookSignature) {
+			t.Errorf("expected a decoding error for %s, got %v", invalid, err)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package fivetran
+
+import (
+	"context"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/go-fivetran/webhooks"
+)
+
+type webhookServiceImpl struct {
+	client *fivetran.Client
+	retry  RetryConfig
+}
+
+func newWebhookService(client *fivetran.Client, retry RetryConfig) WebhooksService {
+	return &webhookServiceImpl{client: client, retry: retry}
+}
+
+// Webhook represents a Fivetran webhook configuration
+type Webhook struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Active *bool    `json:"active"`
+	// Secret is used by Fivetran to sign webhook payloads
+	Secret string `json:"secret,omitempty"`
+}
+
+// ListWebhooks retrieves every account and group webhook
+func (s *webhookServiceImpl) ListWebhooks(ctx context.Context) ([]webhooks.WebhookCommonData, error) {
+	return listAll(ctx, func(ctx context.Context, cursor string) ([]webhooks.WebhookCommonData, string, error) {
+		service := s.client.NewWebhookList().Limit(listPageSize)
+		if cursor != "" {
+			service = service.Cursor(cursor)
+		}
+		resp, err := callWithRetry(ctx, s.retry, func() (webhooks.WebhookListResponse, error) {
+			return callAPI(ctx, operationListWebhooks, service.Do)
+		})
+		return resp.Data.Items, resp.Data.NextCursor, err
+	})
+}
+
+// CreateAccountWebhook creates a webhook for events of every group in the account
+func (s *webhookServiceImpl) CreateAccountWebhook(ctx context.Context, webhook *Webhook) (webhooks.WebhookResponse, error) {
+	service := s.client.NewWebhookAccountCreate().Url(webhook.URL).Events(webhook.Events)
+
+	if webhook.Active != nil {
+		service = service.Active(*webhook.Active)
+	}
+
+	if webhook.Secret != "" {
+		service = service.Secret(webhook.Secret)
+	}
+
+	resp, err := callAPI(ctx, operationCreateAccountWebhook, service.Do)
+	return resp, scrubWebhookError(err, webhook)
+}
+
+// CreateGroupWebhook creates a webhook for events of a single group
+func (s *webhookServiceImpl) CreateGroupWebhook(ctx context.Context, groupID string, webhook *Webhook) (webhooks.WebhookResponse, error) {
+	service := s.client.NewWebhookGroupCreate().GroupId(groupID).Url(webhook.URL).Events(webhook.Events)
+
+	if webhook.Active != nil {
+		service = service.Active(*webhook.Active)
+	}
+
+	if webhook.Secret != "" {
+		service = service.Secret(webhook.Secret)
+	}
+
+	resp, err := callAPI(ctx, operationCreateGroupWebhook, service.Do)
+	return resp, scrubWebhookError(err, webhook)
+}
+
+// GetWebhook retrieves a webhook by ID
+func (s *webhookServiceImpl) GetWebhook(ctx context.Context, webhookID string) (webhooks.WebhookResponse, error) {
+	service := s.client.NewWebhookDetails().WebhookId(webhookID)
+	return callWithRetry(ctx, s.retry, func() (webhooks.WebhookResponse, error) {
+		return callAPI(ctx, operationGetWebhook, service.Do)
+	})
+}
+
+// UpdateWebhook updates an existing webhook; a new secret replaces the current one
+func (s *webhookServiceImpl) UpdateWebhook(ctx context.Context, webhookID string, webhook *Webhook) (webhooks.WebhookResponse, error) {
+	service := s.client.NewWebhookUpdate().WebhookId(webhookID).RunTests(false)
+
+	if webhook.URL != "" {
+		service = service.Url(webhook.URL)
+	}
+
+	if webhook.Events != nil {
+		service = service.Events(webhook.Events)
+	}
+
+	if webhook.Active != nil {
+		service = service.Active(*webhook.Active)
+	}
+
+	if webhook.Secret != "" {
+		service = service.Secret(webhook.Secret)
+	}
+
+	return callWithRetry(ctx, s.retry, func() (webhooks.WebhookResponse, error) {
+		resp, err := callAPI(ctx, operationUpdateWebhook, service.Do)
+		return resp, scrubWebhookError(err, webhook)
+	})
+}
+
+// DeleteWebhook deletes a webhook
+func (s *webhookServiceImpl) DeleteWebhook(ctx context.Context, webhookID string) (common.CommonResponse, error) {
+	service := s.client.NewWebhookDelete().WebhookId(webhookID)
+	return callAPI(ctx, operationDeleteWebhook, service.Do)
+}
+
+// TestWebhook sends a test payload for the given event to a webhook's URL
+func (s *webhookServiceImpl) TestWebhook(ctx context.Context, webhookID, event string) (webhooks.WebhookTestResponse, error) {
+	service := s.client.NewWebhookTest().WebhookId(webhookID).Event(event)
+	return callAPI(ctx, operationTestWebhook, service.Do)
+}
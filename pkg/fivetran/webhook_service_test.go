@@ -0,0 +1,46 @@
+package fivetran
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCreateGroupWebhook(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":"InvalidInput","message":"Secret webhook-secret is too short"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig("key", "secret", ClientConfig{Retry: RetryConfig{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	client.sdk.BaseURL(server.URL)
+
+	_, err = client.Webhooks.CreateGroupWebhook(context.Background(), "group_id", &Webhook{
+		URL:    "https://hooks.example.com/fivetran",
+		Events: []string{"sync_end"},
+		Secret: "webhook-secret",
+	})
+
+	if gotPath != "/webhooks/group/group_id" || gotBody["url"] != "https://hooks.example.com/fivetran" {
+		t.Errorf("expected the webhook posted to /webhooks/group/group_id, got %s %v", gotPath, gotBody)
+	}
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if strings.Contains(apiErr.Error(), "webhook-secret") {
+		t.Errorf("expected the secret to be masked, got %q", apiErr.Error())
+	}
+}
@@ -0,0 +1,83 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+)
+
+// errUnexpectedStatus is returned when a webhook answers with a status other than 2xx
+var errUnexpectedStatus = errors.New("unexpected response status")
+
+// SlackSink posts notifications to a Slack incoming webhook
+type SlackSink struct {
+	URL    string
+	Client *http.Client
+}
+
+// Name implements Sink
+func (*SlackSink) Name() string {
+	return "slack"
+}
+
+// Send implements Sink
+func (s *SlackSink) Send(ctx context.Context, n Notification) error {
+	return postJSON(ctx, s.Client, s.URL, map[string]string{"text": n.Text()})
+}
+
+// WebhookSink posts notifications as JSON to a generic HTTP endpoint
+type WebhookSink struct {
+	URL    string
+	Client *http.Client
+}
+
+// Name implements Sink
+func (*WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Send implements Sink
+func (s *WebhookSink) Send(ctx context.Context, n Notification) error {
+	return postJSON(ctx, s.Client, s.URL, n)
+}
+
+// postJSON posts body as JSON to url, failing on responses other than 2xx
+func postJSON(ctx context.Context, client *http.Client, url string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("postJSON: failed to marshal body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("postJSON: failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		// The URL of a webhook is its credential, so keep it out of the error
+		var urlErr *neturl.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("postJSON: request failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("postJSON: %w: %d", errUnexpectedStatus, resp.StatusCode)
+	}
+	return nil
+}
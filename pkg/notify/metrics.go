@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	resultSuccess = "success"
+	resultFailure = "failure"
+)
+
+var notificationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "fivetran_operator_notifications_total",
+	Help: "Number of notifications sent by sink and result.",
+}, []string{"sink", "result"})
+
+func init() {
+	metrics.Registry.MustRegister(notificationsTotal)
+}
+
+// observeNotification counts the delivery of a notification to a sink
+func observeNotification(sink string, err error) {
+	result := resultSuccess
+	if err != nil {
+		result = resultFailure
+	}
+	notificationsTotal.WithLabelValues(sink, result).Inc()
+}
@@ -0,0 +1,128 @@
+// Package notify sends notifications about failing FivetranConnectors to Slack, generic HTTP
+// webhooks and email, for teams that do not alert on the operator's metrics.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// defaultTimeout is how long a sink may take to deliver a notification
+const defaultTimeout = 10 * time.Second
+
+// Notification describes a FivetranConnector failure
+type Notification struct {
+	Namespace    string    `json:"namespace"`
+	Name         string    `json:"name"`
+	ConnectorID  string    `json:"connectorId,omitempty"`
+	ConnectorURL string    `json:"connectorUrl,omitempty"`
+	Condition    string    `json:"condition"`
+	Reason       string    `json:"reason"`
+	Message      string    `json:"message"`
+	Time         time.Time `json:"time"`
+}
+
+// Subject returns a one-line summary of the notification
+func (n Notification) Subject() string {
+	return fmt.Sprintf("FivetranConnector %s/%s: %s is False (%s)", n.Namespace, n.Name, n.Condition, n.Reason)
+}
+
+// Text returns the summary, message and connector URL of the notification
+func (n Notification) Text() string {
+	text := n.Subject() + "\n" + n.Message
+	if n.ConnectorURL != "" {
+		text += "\n" + n.ConnectorURL
+	}
+	return text
+}
+
+// Sink delivers notifications to one destination
+type Sink interface {
+	// Name identifies the sink in logs and metrics
+	Name() string
+	// Send delivers the notification
+	Send(ctx context.Context, n Notification) error
+}
+
+// Config configures the sinks notifications are sent to. Sinks without a destination are disabled.
+type Config struct {
+	// SlackWebhookURL is the URL of a Slack incoming webhook
+	SlackWebhookURL string
+	// WebhookURL receives each notification as a JSON POST request
+	WebhookURL string
+	// SMTP configures email notifications
+	SMTP SMTPConfig
+	// Timeout is how long a sink may take to deliver a notification; zero uses 10 seconds
+	Timeout time.Duration
+}
+
+// Notifier sends each notification to every configured sink
+type Notifier struct {
+	sinks   []Sink
+	timeout time.Duration
+}
+
+// New returns a notifier for the configured sinks, or nil when none is configured
+func New(cfg Config) (*Notifier, error) {
+	httpClient := &http.Client{}
+
+	var sinks []Sink
+	if cfg.SlackWebhookURL != "" {
+		sinks = append(sinks, &SlackSink{URL: cfg.SlackWebhookURL, Client: httpClient})
+	}
+	if cfg.WebhookURL != "" {
+		sinks = append(sinks, &WebhookSink{URL: cfg.WebhookURL, Client: httpClient})
+	}
+	if cfg.SMTP.Addr != "" {
+		if err := cfg.SMTP.validate(); err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, &SMTPSink{Config: cfg.SMTP})
+	}
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+	return NewNotifier(cfg.Timeout, sinks...), nil
+}
+
+// NewNotifier returns a notifier for the given sinks; a zero timeout uses 10 seconds
+func NewNotifier(timeout time.Duration, sinks ...Sink) *Notifier {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &Notifier{sinks: sinks, timeout: timeout}
+}
+
+// Notify sends the notification to every sink in the background, so slow destinations never
+// delay a reconcile. Delivery failures are logged and counted. A nil notifier discards it.
+func (n *Notifier) Notify(ctx context.Context, notification Notification) {
+	if n == nil {
+		return
+	}
+	if notification.Time.IsZero() {
+		notification.Time = time.Now()
+	}
+
+	// Deliver after the reconcile that triggered the notification has returned
+	ctx = context.WithoutCancel(ctx)
+	for _, sink := range n.sinks {
+		go n.send(ctx, sink, notification)
+	}
+}
+
+// send delivers the notification to one sink within the notifier's timeout
+func (n *Notifier) send(ctx context.Context, sink Sink, notification Notification) {
+	ctx, cancel := context.WithTimeout(ctx, n.timeout)
+	defer cancel()
+
+	err := sink.Send(ctx, notification)
+	observeNotification(sink.Name(), err)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to send notification", "sink", sink.Name(),
+			"condition", notification.Condition, "reason", notification.Reason)
+	}
+}
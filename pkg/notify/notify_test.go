@@ -0,0 +1,171 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testNotification = Notification{
+	Namespace:    "fivetran-operator",
+	Name:         "postgres",
+	ConnectorID:  "connector_id",
+	ConnectorURL: "https://fivetran.com/dashboard/connectors/connector_id",
+	Condition:    "SetupTestReady",
+	Reason:       "ReconciliationFailed",
+	Message:      "setup tests failed: Connecting to host (status: FAILED)",
+}
+
+func TestSlackSink(t *testing.T) {
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	sink := &SlackSink{URL: server.URL, Client: server.Client()}
+	if err := sink.Send(context.Background(), testNotification); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "FivetranConnector fivetran-operator/postgres: SetupTestReady is False (ReconciliationFailed)\n" +
+		"setup tests failed: Connecting to host (status: FAILED)\n" +
+		"https://fivetran.com/dashboard/connectors/connector_id"
+	if body["text"] != expected {
+		t.Errorf("expected text %q, got %q", expected, body["text"])
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received Notification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("expected JSON content type, got %q", r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	sink := &WebhookSink{URL: server.URL, Client: server.Client()}
+	if err := sink.Send(context.Background(), testNotification); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received != testNotification {
+		t.Errorf("expected %+v, got %+v", testNotification, received)
+	}
+}
+
+func TestWebhookSinkErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	sink := &WebhookSink{URL: server.URL + "/secret-token", Client: server.Client()}
+	err := sink.Send(context.Background(), testNotification)
+	if !errors.Is(err, errUnexpectedStatus) {
+		t.Errorf("expected unexpected status error, got %v", err)
+	}
+
+	server.Close()
+	err = sink.Send(context.Background(), testNotification)
+	if err == nil {
+		t.Fatal("expected error from closed server")
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("expected the webhook URL to be kept out of the error, got %q", err.Error())
+	}
+}
+
+func TestSMTPSinkMessage(t *testing.T) {
+	sink := &SMTPSink{Config: SMTPConfig{
+		Addr: "smtp.example.com:587",
+		From: "operator@example.com",
+		To:   []string{"data@example.com", "oncall@example.com"},
+	}}
+	notification := testNotification
+	notification.Reason = "Failed\r\nBcc: attacker@example.com"
+
+	message := string(sink.message(notification))
+	for _, expected := range []string{
+		"To: data@example.com, oncall@example.com\r\n",
+		"Subject: FivetranConnector fivetran-operator/postgres: SetupTestReady is False (Failed  Bcc: attacker@example.com)\r\n",
+		"\r\n\r\nFivetranConnector",
+	} {
+		if !strings.Contains(message, expected) {
+			t.Errorf("expected message to contain %q, got %q", expected, message)
+		}
+	}
+	header, _, _ := strings.Cut(message, "\r\n\r\n")
+	if strings.Contains(header, "\r\nBcc:") {
+		t.Errorf("expected header injection to be stripped, got %q", message)
+	}
+}
+
+func TestNew(t *testing.T) {
+	notifier, err := New(Config{})
+	if err != nil || notifier != nil {
+		t.Errorf("expected no notifier without sinks, got %v, %v", notifier, err)
+	}
+
+	if _, err := New(Config{SMTP: SMTPConfig{Addr: "smtp.example.com:587", From: "operator@example.com"}}); err == nil {
+		t.Error("expected error for SMTP without recipients")
+	}
+
+	notifier, err = New(Config{SlackWebhookURL: "https://hooks.slack.com/services/x", WebhookURL: "https://example.com/hook"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(notifier.sinks) != 2 {
+		t.Errorf("expected 2 sinks, got %d", len(notifier.sinks))
+	}
+}
+
+// recordingSink records the notifications sent to it
+type recordingSink struct {
+	sent chan Notification
+	err  error
+}
+
+func (*recordingSink) Name() string {
+	return "recording"
+}
+
+func (s *recordingSink) Send(ctx context.Context, n Notification) error {
+	s.sent <- n
+	return s.err
+}
+
+func TestNotifierNotify(t *testing.T) {
+	failing := &recordingSink{sent: make(chan Notification, 1), err: errors.New("unavailable")}
+	working := &recordingSink{sent: make(chan Notification, 1)}
+	notifier := NewNotifier(time.Second, failing, working)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	notifier.Notify(ctx, testNotification)
+	// Delivery outlives the reconcile that triggered it
+	cancel()
+
+	for _, sink := range []*recordingSink{failing, working} {
+		select {
+		case n := <-sink.sent:
+			if n.Name != testNotification.Name || n.Time.IsZero() {
+				t.Errorf("expected notification with its time set, got %+v", n)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for notification")
+		}
+	}
+
+	var nilNotifier *Notifier
+	nilNotifier.Notify(context.Background(), testNotification)
+}
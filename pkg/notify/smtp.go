@@ -0,0 +1,92 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+// SMTPConfig configures email notifications
+type SMTPConfig struct {
+	// Addr is the host:port of the SMTP server
+	Addr string
+	// From is the sender address
+	From string
+	// To are the recipient addresses
+	To []string
+	// Username and Password authenticate with PLAIN auth when Username is set
+	Username string
+	Password string
+}
+
+// validate checks that an enabled SMTP sink has a sender and recipients
+func (c SMTPConfig) validate() error {
+	if _, _, err := net.SplitHostPort(c.Addr); err != nil {
+		return fmt.Errorf("invalid SMTP address %q: %w", c.Addr, err)
+	}
+	if c.From == "" {
+		return errors.New("SMTP notifications require a sender address")
+	}
+	if len(c.To) == 0 {
+		return errors.New("SMTP notifications require at least one recipient")
+	}
+	return nil
+}
+
+// SMTPSink emails notifications through an SMTP server
+type SMTPSink struct {
+	Config SMTPConfig
+}
+
+// Name implements Sink
+func (*SMTPSink) Name() string {
+	return "smtp"
+}
+
+// Send implements Sink. net/smtp has no context support, so the send is abandoned rather than
+// interrupted when ctx is done.
+func (s *SMTPSink) Send(ctx context.Context, n Notification) error {
+	var auth smtp.Auth
+	if s.Config.Username != "" {
+		host, _, _ := net.SplitHostPort(s.Config.Addr)
+		auth = smtp.PlainAuth("", s.Config.Username, s.Config.Password, host)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(s.Config.Addr, auth, s.Config.From, s.Config.To, s.message(n))
+	}()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("SMTPSink.Send: %w", ctx.Err())
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("SMTPSink.Send: %w", err)
+		}
+		return nil
+	}
+}
+
+// message formats the notification as a plain text email
+func (s *SMTPSink) message(n Notification) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", s.Config.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(s.Config.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", headerValue(n.Subject()))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	body := strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(n.Text())
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
+
+// headerValue strips line breaks that would end a header early
+func headerValue(value string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
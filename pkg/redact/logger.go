@@ -0,0 +1,70 @@
+package redact
+
+import (
+	"errors"
+
+	"github.com/go-logr/logr"
+)
+
+// NewLogger returns a logger that masks the redactor's values in messages, errors, and string
+// values before passing them to the logger's sink
+func NewLogger(logger logr.Logger, r *Redactor) logr.Logger {
+	sink := logger.GetSink()
+	if sink == nil {
+		return logger
+	}
+	// Attribute log lines to the caller rather than to the redacting sink
+	if callDepthSink, ok := sink.(logr.CallDepthLogSink); ok {
+		sink = callDepthSink.WithCallDepth(1)
+	}
+	return logger.WithSink(&logSink{sink: sink, redactor: r})
+}
+
+// logSink masks secret values on their way to the wrapped sink
+type logSink struct {
+	sink     logr.LogSink
+	redactor *Redactor
+}
+
+func (s *logSink) Init(info logr.RuntimeInfo) {
+	s.sink.Init(info)
+}
+
+func (s *logSink) Enabled(level int) bool {
+	return s.sink.Enabled(level)
+}
+
+func (s *logSink) Info(level int, msg string, keysAndValues ...any) {
+	s.sink.Info(level, s.redactor.Redact(msg), s.redactValues(keysAndValues)...)
+}
+
+func (s *logSink) Error(err error, msg string, keysAndValues ...any) {
+	if err != nil {
+		err = errors.New(s.redactor.Redact(err.Error()))
+	}
+	s.sink.Error(err, s.redactor.Redact(msg), s.redactValues(keysAndValues)...)
+}
+
+func (s *logSink) WithValues(keysAndValues ...any) logr.LogSink {
+	return &logSink{sink: s.sink.WithValues(s.redactValues(keysAndValues)...), redactor: s.redactor}
+}
+
+func (s *logSink) WithName(name string) logr.LogSink {
+	return &logSink{sink: s.sink.WithName(name), redactor: s.redactor}
+}
+
+// redactValues masks string and error values
+func (s *logSink) redactValues(keysAndValues []any) []any {
+	redacted := make([]any, len(keysAndValues))
+	for i, value := range keysAndValues {
+		switch v := value.(type) {
+		case string:
+			redacted[i] = s.redactor.Redact(v)
+		case error:
+			redacted[i] = s.redactor.Redact(v.Error())
+		default:
+			redacted[i] = value
+		}
+	}
+	return redacted
+}
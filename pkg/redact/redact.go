@@ -0,0 +1,222 @@
+package redact
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Mask replaces redacted values
+const Mask = "[REDACTED]"
+
+// minValueLength keeps short values such as ports, booleans, and usernames like "admin" from being
+// masked wherever they appear
+const minValueLength = 6
+
+// sensitiveKeyParts mark config keys whose values are redacted even when set in plaintext
+var sensitiveKeyParts = []string{
+	"password",
+	"passwd",
+	"passphrase",
+	"secret",
+	"token",
+	"private_key",
+	"api_key",
+	"apikey",
+	"access_key",
+	"credential",
+}
+
+// sensitiveFieldPattern matches sensitive fields echoed as "key": "value", key: value or key=value
+var sensitiveFieldPattern = regexp.MustCompile(`(?i)("?[a-z0-9_]*(?:` + strings.Join(sensitiveKeyParts, "|") + `)[a-z0-9_]*"?\s*[:=]\s*)("(?:[^"\\]|\\.)*"|[^\s,;&}\]]+)`)
+
+// Redactor masks known secret values in text. It is safe for concurrent use, and a nil Redactor
+// returns text unchanged.
+type Redactor struct {
+	mu       sync.RWMutex
+	values   map[string]struct{}
+	replacer *strings.Replacer
+}
+
+// New creates a Redactor with no known values
+func New() *Redactor {
+	return &Redactor{values: make(map[string]struct{})}
+}
+
+// Add records values to mask
+func (r *Redactor) Add(values ...string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, value := range values {
+		if len(value) < minValueLength {
+			continue
+		}
+		if _, ok := r.values[value]; !ok {
+			r.values[value] = struct{}{}
+			r.replacer = nil
+		}
+	}
+}
+
+// AddConfig records the values of sensitive keys in decoded config, at any depth
+func (r *Redactor) AddConfig(data any) {
+	switch v := data.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if IsSensitiveKey(key) {
+				r.Add(stringValues(value)...)
+				continue
+			}
+			r.AddConfig(value)
+		}
+	case []any:
+		for _, item := range v {
+			r.AddConfig(item)
+		}
+	}
+}
+
+// AddAll records every string in decoded data, for data such as connector auth where any value may
+// be a credential
+func (r *Redactor) AddAll(data any) {
+	r.Add(stringValues(data)...)
+}
+
+// Redact masks every known value in text
+func (r *Redactor) Redact(text string) string {
+	if r == nil || text == "" {
+		return text
+	}
+
+	r.mu.RLock()
+	replacer := r.replacer
+	empty := len(r.values) == 0
+	r.mu.RUnlock()
+	if empty {
+		return text
+	}
+	if replacer == nil {
+		replacer = r.buildReplacer()
+	}
+	return replacer.Replace(text)
+}
+
+// RedactError returns an error whose message is masked, keeping err in the chain for errors.Is and
+// errors.As. It returns err itself when nothing needs masking.
+func (r *Redactor) RedactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	message := err.Error()
+	redacted := r.Redact(message)
+	if redacted == message {
+		return err
+	}
+	return &redactedError{message: redacted, err: err}
+}
+
+// buildReplacer builds the replacer for the current values, matching longer values first so a
+// secret containing another is masked whole
+func (r *Redactor) buildReplacer() *strings.Replacer {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.replacer != nil {
+		return r.replacer
+	}
+
+	values := make([]string, 0, len(r.values))
+	for value := range r.values {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if len(values[i]) != len(values[j]) {
+			return len(values[i]) > len(values[j])
+		}
+		return values[i] < values[j]
+	})
+
+	pairs := make([]string, 0, len(values)*2)
+	for _, value := range values {
+		pairs = append(pairs, value, Mask)
+	}
+	r.replacer = strings.NewReplacer(pairs...)
+	return r.replacer
+}
+
+// MaskSensitiveFields masks the values of sensitive fields echoed in text, such as a request body
+// quoted in an API error, whether or not the values are known
+func MaskSensitiveFields(text string) string {
+	return sensitiveFieldPattern.ReplaceAllStringFunc(text, func(field string) string {
+		match := sensitiveFieldPattern.FindStringSubmatch(field)
+		if strings.HasPrefix(match[2], `"`) {
+			return match[1] + `"` + Mask + `"`
+		}
+		return match[1] + Mask
+	})
+}
+
+// IsSensitiveKey reports whether a config key names a credential
+func IsSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// stringValues collects the strings in a decoded value
+func stringValues(data any) []string {
+	switch v := data.(type) {
+	case string:
+		return []string{v}
+	case map[string]any:
+		var values []string
+		for _, value := range v {
+			values = append(values, stringValues(value)...)
+		}
+		return values
+	case []any:
+		var values []string
+		for _, item := range v {
+			values = append(values, stringValues(item)...)
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// redactedError masks the message of the error it wraps
+type redactedError struct {
+	message string
+	err     error
+}
+
+func (e *redactedError) Error() string {
+	return e.message
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+type redactorKey struct{}
+
+// IntoContext returns a context carrying the redactor
+func IntoContext(ctx context.Context, r *Redactor) context.Context {
+	return context.WithValue(ctx, redactorKey{}, r)
+}
+
+// FromContext returns the redactor carried by the context, or nil
+func FromContext(ctx context.Context) *Redactor {
+	r, _ := ctx.Value(redactorKey{}).(*Redactor)
+	return r
+}
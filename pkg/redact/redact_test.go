@@ -0,0 +1,136 @@
+package redact
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+)
+
+func TestRedact(t *testing.T) {
+	r := New()
+	r.Add("hunter2-password", "hunter2", "short")
+	r.AddConfig(map[string]any{
+		"host":     "db.example.com",
+		"password": "plaintext-password",
+		"nested": map[string]any{
+			"client_secret": "oauth-client-secret",
+			"ports":         []any{"5432"},
+		},
+		"credentials": []any{map[string]any{"json": "service-account-json"}},
+	})
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "resolved value",
+			input:    "login failed for hunter2",
+			expected: "login failed for " + Mask,
+		},
+		{
+			name:     "longer value is masked whole",
+			input:    "password hunter2-password rejected",
+			expected: "password " + Mask + " rejected",
+		},
+		{
+			name:     "sensitive config keys",
+			input:    "config: plaintext-password oauth-client-secret service-account-json",
+			expected: "config: " + Mask + " " + Mask + " " + Mask,
+		},
+		{
+			name:     "short and non-sensitive values are kept",
+			input:    "short db.example.com:5432",
+			expected: "short db.example.com:5432",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Redact(tt.input); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	var nilRedactor *Redactor
+	if got := nilRedactor.Redact("hunter2"); got != "hunter2" {
+		t.Errorf("expected nil redactor to return text unchanged, got %q", got)
+	}
+}
+
+func TestMaskSensitiveFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "json field",
+			input:    `invalid config {"host":"db","password":"p@ss \"word\""}`,
+			expected: `invalid config {"host":"db","password":"` + Mask + `"}`,
+		},
+		{
+			name:     "key value pairs",
+			input:    "rejected client_secret=abc123&user=admin, api_key: xyz",
+			expected: "rejected client_secret=" + Mask + "&user=admin, api_key: " + Mask,
+		},
+		{
+			name:     "non-sensitive fields are kept",
+			input:    `{"host": "db.example.com", "port": 5432}`,
+			expected: `{"host": "db.example.com", "port": 5432}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MaskSensitiveFields(tt.input); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRedactError(t *testing.T) {
+	r := New()
+	r.Add("hunter2")
+	errBase := errors.New("authentication failed")
+
+	err := r.RedactError(fmt.Errorf("%w: password hunter2", errBase))
+	if err.Error() != "authentication failed: password "+Mask {
+		t.Errorf("expected redacted message, got %q", err.Error())
+	}
+	if !errors.Is(err, errBase) {
+		t.Errorf("expected redacted error to wrap the original error")
+	}
+
+	if err := r.RedactError(errBase); err != errBase {
+		t.Errorf("expected error without secrets to be returned unchanged, got %v", err)
+	}
+}
+
+func TestNewLogger(t *testing.T) {
+	var lines []string
+	base := funcr.New(func(prefix, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{})
+
+	r := New()
+	logger := NewLogger(base, r).WithValues("user", "svc-fivetran")
+	r.Add("hunter2", "svc-fivetran")
+
+	logger.Info("connecting with hunter2", "dsn", "postgres://svc-fivetran:hunter2@db", "port", 5432)
+	logger.Error(errors.New("bad password hunter2"), "failed")
+
+	output := strings.Join(lines, "\n")
+	if strings.Contains(output, "hunter2") {
+		t.Errorf("expected secret to be redacted from log output:\n%s", output)
+	}
+	if !strings.Contains(output, Mask) || !strings.Contains(output, "5432") {
+		t.Errorf("expected masked values and untouched non-string values in log output:\n%s", output)
+	}
+}
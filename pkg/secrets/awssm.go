@@ -0,0 +1,155 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+)
+
+// AWSSecretsManagerScheme is the scheme of AWS Secrets Manager references, e.g. awssm:secret-name#json-key
+const AWSSecretsManagerScheme = "awssm"
+
+var ErrAWSRegionNotConfigured = errors.New("no AWS region configured (set AWS_REGION or reference the secret by ARN)")
+
+const (
+	awsSecretsManagerService = "secretsmanager"
+	awsGetSecretValueTarget  = "secretsmanager.GetSecretValue"
+	awsJSONContentType       = "application/x-amz-json-1.1"
+)
+
+// AWSSecretsManagerResolver resolves awssm:secret-name#json-key references with AWS Secrets Manager.
+// Credentials come from the default AWS chain, which picks up IRSA through the AWS_ROLE_ARN and
+// AWS_WEB_IDENTITY_TOKEN_FILE variables injected by the EKS pod identity webhook.
+type AWSSecretsManagerResolver struct {
+	httpClient *http.Client
+	signer     *v4.Signer
+	// endpoint overrides the regional Secrets Manager endpoint
+	endpoint string
+
+	loadOnce sync.Once
+	cfg      aws.Config
+	cfgErr   error
+}
+
+// NewAWSSecretsManagerResolver creates a resolver that loads the AWS configuration on first use, so
+// operators not using AWS are unaffected
+func NewAWSSecretsManagerResolver() *AWSSecretsManagerResolver {
+	return &AWSSecretsManagerResolver{
+		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
+		signer:     v4.NewSigner(),
+	}
+}
+
+// awsGetSecretValueOutput holds the fields of a GetSecretValue response used by the resolver
+type awsGetSecretValueOutput struct {
+	SecretString *string `json:"SecretString"`
+	SecretBinary []byte  `json:"SecretBinary"`
+}
+
+// Resolve returns a key of a JSON secret, every key for secret-name#*, or the raw secret when no
+// key is given. The secret may be referenced by name or ARN.
+func (r *AWSSecretsManagerResolver) Resolve(ctx context.Context, ref string) (any, error) {
+	secretID, key, _ := strings.Cut(ref, "#")
+	if secretID == "" {
+		return nil, nonRetryable(fmt.Errorf("%w: expected format awssm:secret-name#json-key", ErrInvalidObjectReference))
+	}
+
+	secret, err := r.getSecretValue(ctx, secretID)
+	if err != nil {
+		return nil, err
+	}
+	return parseJSONSecret(secretID, secret, key)
+}
+
+// getSecretValue calls GetSecretValue, signing the request with the resolver's AWS credentials
+func (r *AWSSecretsManagerResolver) getSecretValue(ctx context.Context, secretID string) (string, error) {
+	cfg, err := r.loadConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	region := cfg.Region
+	if parsed, err := arn.Parse(secretID); err == nil {
+		region = parsed.Region
+	}
+	if region == "" {
+		return "", nonRetryable(ErrAWSRegionNotConfigured)
+	}
+	if cfg.Credentials == nil {
+		return "", nonRetryable(errors.New("no AWS credentials configured"))
+	}
+
+	body, err := json.Marshal(map[string]string{"SecretId": secretID})
+	if err != nil {
+		return "", err
+	}
+	endpoint := r.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.%s.amazonaws.com/", awsSecretsManagerService, region)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", awsJSONContentType)
+	req.Header.Set("X-Amz-Target", awsGetSecretValueTarget)
+
+	creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+	payloadHash := sha256.Sum256(body)
+	if err := r.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(payloadHash[:]), awsSecretsManagerService, region, time.Now()); err != nil {
+		return "", fmt.Errorf("failed to sign AWS request: %w", err)
+	}
+
+	var out awsGetSecretValueOutput
+	if err := doJSON(r.httpClient, req, &out); err != nil {
+		var sErr *statusError
+		if errors.As(err, &sErr) && isAWSThrottlingError(sErr) {
+			// Drop the permanent marking doJSON gives 4xx responses
+			err = sErr
+		}
+		return "", fmt.Errorf("failed to get AWS secret %s: %w", secretID, err)
+	}
+
+	switch {
+	case out.SecretString != nil:
+		return *out.SecretString, nil
+	case out.SecretBinary != nil:
+		return base64.StdEncoding.EncodeToString(out.SecretBinary), nil
+	default:
+		return "", nonRetryable(fmt.Errorf("AWS secret %s has no value", secretID))
+	}
+}
+
+// loadConfig loads the default AWS configuration once
+func (r *AWSSecretsManagerResolver) loadConfig(ctx context.Context) (aws.Config, error) {
+	r.loadOnce.Do(func() {
+		r.cfg, r.cfgErr = config.LoadDefaultConfig(ctx)
+		if r.cfgErr != nil {
+			r.cfgErr = fmt.Errorf("failed to load AWS configuration: %w", r.cfgErr)
+		}
+	})
+	return r.cfg, r.cfgErr
+}
+
+// isAWSThrottlingError reports whether AWS rejected a request for exceeding its rate limit, which it
+// signals with a 400 status rather than 429
+func isAWSThrottlingError(err *statusError) bool {
+	return err.StatusCode == http.StatusBadRequest && strings.Contains(err.Body, "ThrottlingException")
+}
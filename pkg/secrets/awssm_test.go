@@ -0,0 +1,144 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+)
+
+// newAWSSecretsManagerServer emulates GetSecretValue for a fixed set of secrets
+func newAWSSecretsManagerServer(t *testing.T, secrets map[string]string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Amz-Target") != awsGetSecretValueTarget || !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var input struct{ SecretId string }
+		_ = json.NewDecoder(r.Body).Decode(&input)
+
+		w.Header().Set("Content-Type", awsJSONContentType)
+		if input.SecretId == "throttled" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"ThrottlingException","message":"Rate exceeded"}`))
+			return
+		}
+		secret, ok := secrets[input.SecretId]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"ResourceNotFoundException","message":"Secrets Manager can't find the specified secret."}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"Name": input.SecretId, "SecretString": secret})
+	}))
+}
+
+func TestAWSSecretsManagerResolver(t *testing.T) {
+	server := newAWSSecretsManagerServer(t, map[string]string{
+		"prod/db": `{"username":"fivetran","password":"secret-password","port":5432}`,
+		"api-key": "raw-key",
+	})
+	defer server.Close()
+
+	resolver := NewAWSSecretsManagerResolver()
+	resolver.endpoint = server.URL
+	resolver.loadOnce.Do(func() {
+		resolver.cfg = aws.Config{
+			Region:      "us-east-1",
+			Credentials: credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "secret", ""),
+		}
+	})
+
+	tests := []struct {
+		name             string
+		ref              string
+		expected         any
+		expectError      bool
+		expectRetryable  bool
+		expectErrorMatch error
+	}{
+		{
+			name:     "json key",
+			ref:      "prod/db#password",
+			expected: "secret-password",
+		},
+		{
+			name:     "whole secret",
+			ref:      "prod/db#*",
+			expected: map[string]any{"username": "fivetran", "password": "secret-password", "port": float64(5432)},
+		},
+		{
+			name:     "raw secret",
+			ref:      "api-key",
+			expected: "raw-key",
+		},
+		{
+			name:             "missing key",
+			ref:              "prod/db#token",
+			expectError:      true,
+			expectErrorMatch: ErrObjectKeyNotFound,
+		},
+		{
+			name:        "key of non-json secret",
+			ref:         "api-key#value",
+			expectError: true,
+		},
+		{
+			name:        "missing secret",
+			ref:         "missing#password",
+			expectError: true,
+		},
+		{
+			name:            "throttled",
+			ref:             "throttled#password",
+			expectError:     true,
+			expectRetryable: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := resolver.Resolve(context.Background(), tt.ref)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error but got none")
+				}
+				if tt.expectErrorMatch != nil && !errors.Is(err, tt.expectErrorMatch) {
+					t.Errorf("expected error %v, got: %v", tt.expectErrorMatch, err)
+				}
+				if vault.IsRetryableError(err) != tt.expectRetryable {
+					t.Errorf("expected retryable %v for error: %v", tt.expectRetryable, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestAWSSecretsManagerResolverRegion(t *testing.T) {
+	resolver := NewAWSSecretsManagerResolver()
+	resolver.loadOnce.Do(func() {
+		resolver.cfg = aws.Config{Credentials: credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "secret", "")}
+	})
+
+	if _, err := resolver.Resolve(context.Background(), "prod/db#password"); !errors.Is(err, ErrAWSRegionNotConfigured) {
+		t.Errorf("expected %v, got: %v", ErrAWSRegionNotConfigured, err)
+	}
+}
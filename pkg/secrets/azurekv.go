@@ -0,0 +1,111 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+)
+
+// AzureKeyVaultScheme is the scheme of Azure Key Vault references, e.g. azurekv:vault-name/secret-name
+const AzureKeyVaultScheme = "azurekv"
+
+const (
+	azureKeyVaultScope      = "https://vault.azure.net/.default"
+	azureKeyVaultAPIVersion = "7.4"
+)
+
+// AzureKeyVaultResolver resolves azurekv:vault-name/secret-name[/version][#json-key] references with
+// Azure Key Vault, authenticating with workload identity through the AZURE_CLIENT_ID,
+// AZURE_TENANT_ID and AZURE_FEDERATED_TOKEN_FILE variables injected by the workload identity webhook.
+type AzureKeyVaultResolver struct {
+	httpClient *http.Client
+	// vaultURL returns the base URL of a key vault
+	vaultURL func(vaultName string) string
+
+	credOnce   sync.Once
+	credential azcore.TokenCredential
+	credErr    error
+}
+
+// NewAzureKeyVaultResolver creates a resolver that sets up its credential on first use, so operators
+// not using Azure are unaffected
+func NewAzureKeyVaultResolver() *AzureKeyVaultResolver {
+	return &AzureKeyVaultResolver{
+		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
+		vaultURL: func(vaultName string) string {
+			return fmt.Sprintf("https://%s.vault.azure.net", vaultName)
+		},
+	}
+}
+
+// azureSecretBundle holds the fields of a Get Secret response used by the resolver
+type azureSecretBundle struct {
+	Value string `json:"value"`
+}
+
+// Resolve returns a secret's value, a key of a secret stored as JSON, or every key for #*. The latest
+// version is read unless a version is given.
+func (r *AzureKeyVaultResolver) Resolve(ctx context.Context, ref string) (any, error) {
+	path, key, _ := strings.Cut(ref, "#")
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return nil, nonRetryable(fmt.Errorf("%w: expected format azurekv:vault-name/secret-name[/version][#json-key]", ErrInvalidObjectReference))
+	}
+	vaultName, secretName := parts[0], parts[1]
+	version := ""
+	if len(parts) == 3 {
+		version = parts[2]
+	}
+
+	secret, err := r.getSecret(ctx, vaultName, secretName, version)
+	if err != nil {
+		return nil, err
+	}
+	return parseJSONSecret(path, secret, key)
+}
+
+// getSecret reads a secret version, or the latest version when version is empty
+func (r *AzureKeyVaultResolver) getSecret(ctx context.Context, vaultName, secretName, version string) (string, error) {
+	credential, err := r.loadCredential()
+	if err != nil {
+		return "", err
+	}
+
+	token, err := credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{azureKeyVaultScope}})
+	if err != nil {
+		return "", fmt.Errorf("failed to get Azure access token: %w", err)
+	}
+
+	secretURL := fmt.Sprintf("%s/secrets/%s/%s?api-version=%s", r.vaultURL(vaultName), url.PathEscape(secretName), url.PathEscape(version), azureKeyVaultAPIVersion)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, secretURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+
+	var bundle azureSecretBundle
+	if err := doJSON(r.httpClient, req, &bundle); err != nil {
+		return "", fmt.Errorf("failed to get Azure Key Vault secret %s/%s: %w", vaultName, secretName, err)
+	}
+	return bundle.Value, nil
+}
+
+// loadCredential creates the workload identity credential once
+func (r *AzureKeyVaultResolver) loadCredential() (azcore.TokenCredential, error) {
+	r.credOnce.Do(func() {
+		credential, err := azidentity.NewWorkloadIdentityCredential(nil)
+		if err != nil {
+			r.credErr = nonRetryable(fmt.Errorf("failed to create Azure workload identity credential: %w", err))
+			return
+		}
+		r.credential = credential
+	})
+	return r.credential, r.credErr
+}
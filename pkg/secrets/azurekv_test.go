@@ -0,0 +1,117 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+)
+
+// staticTokenCredential returns a fixed access token
+type staticTokenCredential struct{}
+
+func (staticTokenCredential) GetToken(context.Context, policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "test-token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestAzureKeyVaultResolver(t *testing.T) {
+	secrets := map[string]string{
+		"/fivetran-kv/secrets/db-password/":   "latest-password",
+		"/fivetran-kv/secrets/db-password/v1": "old-password",
+		"/fivetran-kv/secrets/db-creds/":      `{"username":"fivetran","password":"secret-password"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("api-version") != azureKeyVaultAPIVersion {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		value, ok := secrets[r.URL.Path]
+		switch {
+		case r.URL.Path == "/fivetran-kv/secrets/throttled/":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case !ok:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"SecretNotFound"}}`))
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]any{"value": value})
+		}
+	}))
+	defer server.Close()
+
+	resolver := NewAzureKeyVaultResolver()
+	resolver.vaultURL = func(vaultName string) string { return server.URL + "/" + vaultName }
+	resolver.credOnce.Do(func() { resolver.credential = staticTokenCredential{} })
+
+	tests := []struct {
+		name            string
+		ref             string
+		expected        any
+		expectError     bool
+		expectRetryable bool
+	}{
+		{
+			name:     "latest version",
+			ref:      "fivetran-kv/db-password",
+			expected: "latest-password",
+		},
+		{
+			name:     "pinned version",
+			ref:      "fivetran-kv/db-password/v1",
+			expected: "old-password",
+		},
+		{
+			name:     "json key",
+			ref:      "fivetran-kv/db-creds#username",
+			expected: "fivetran",
+		},
+		{
+			name:        "missing secret",
+			ref:         "fivetran-kv/missing",
+			expectError: true,
+		},
+		{
+			name:            "throttled",
+			ref:             "fivetran-kv/throttled",
+			expectError:     true,
+			expectRetryable: true,
+		},
+		{
+			name:        "missing secret name",
+			ref:         "fivetran-kv",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := resolver.Resolve(context.Background(), tt.ref)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error but got none")
+				}
+				if vault.IsRetryableError(err) != tt.expectRetryable {
+					t.Errorf("expected retryable %v for error: %v", tt.expectRetryable, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
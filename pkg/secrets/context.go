@@ -0,0 +1,17 @@
+package secrets
+
+import "context"
+
+type namespaceKey struct{}
+
+// WithNamespace returns a context carrying the namespace of the connector whose references are
+// being resolved
+func WithNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, namespace)
+}
+
+// NamespaceFromContext returns the connector namespace set by WithNamespace, or an empty string
+func NamespaceFromContext(ctx context.Context) string {
+	namespace, _ := ctx.Value(namespaceKey{}).(string)
+	return namespace
+}
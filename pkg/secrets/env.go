@@ -0,0 +1,35 @@
+package secrets
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ConfigMapEnvSource serves ${ENV:NAME} placeholders from a designated ConfigMap. Names the ConfigMap
+// does not define are left to the registry, which only reads them from the operator's environment
+// when they have its environment prefix.
+type ConfigMapEnvSource struct {
+	client    client.Reader
+	configMap types.NamespacedName
+}
+
+// NewConfigMapEnvSource creates a placeholder source reading the given ConfigMap
+func NewConfigMapEnvSource(k8sClient client.Reader, namespace, name string) *ConfigMapEnvSource {
+	return &ConfigMapEnvSource{
+		client:    k8sClient,
+		configMap: types.NamespacedName{Namespace: namespace, Name: name},
+	}
+}
+
+// LookupEnv returns the ConfigMap's value for name
+func (s *ConfigMapEnvSource) LookupEnv(ctx context.Context, name string) (string, bool, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := s.client.Get(ctx, s.configMap, configMap); err != nil {
+		return "", false, objectGetError("configmap", s.configMap, err)
+	}
+	value, ok := configMap.Data[name]
+	return value, ok, nil
+}
@@ -0,0 +1,52 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestConfigMapEnvSource(t *testing.T) {
+	t.Setenv("FIVETRAN_TEST_ENVIRONMENT", "staging")
+	t.Setenv("FIVETRAN_TEST_REGION", "us-east-1")
+
+	k8sClient := fake.NewClientBuilder().
+		WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-env", Namespace: "fivetran-operator"},
+			Data:       map[string]string{"FIVETRAN_TEST_ENVIRONMENT": "prod"},
+		}).
+		Build()
+	source := NewConfigMapEnvSource(k8sClient, "fivetran-operator", "cluster-env")
+
+	tests := []struct {
+		name          string
+		env           string
+		expected      string
+		expectPresent bool
+	}{
+		{name: "configmap takes precedence", env: "FIVETRAN_TEST_ENVIRONMENT", expected: "prod", expectPresent: true},
+		{name: "environment is not read", env: "FIVETRAN_TEST_REGION"},
+		{name: "unset", env: "FIVETRAN_TEST_UNSET"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, ok, err := source.LookupEnv(context.Background(), tt.env)
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if ok != tt.expectPresent || value != tt.expected {
+				t.Errorf("expected (%q, %v), got (%q, %v)", tt.expected, tt.expectPresent, value, ok)
+			}
+		})
+	}
+
+	missing := NewConfigMapEnvSource(k8sClient, "fivetran-operator", "missing")
+	if _, _, err := missing.LookupEnv(context.Background(), "FIVETRAN_TEST_REGION"); !errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("expected %v, got: %v", ErrObjectNotFound, err)
+	}
+}
@@ -0,0 +1,130 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+// GCPSecretManagerScheme is the scheme of GCP Secret Manager references, e.g.
+// gcpsm:projects/p/secrets/s/versions/latest
+const GCPSecretManagerScheme = "gcpsm"
+
+const (
+	gcpSecretManagerEndpoint = "https://secretmanager.googleapis.com"
+	gcpCloudPlatformScope    = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// GCPSecretManagerResolver resolves gcpsm:projects/p/secrets/s[/versions/v][#json-key] references
+// with GCP Secret Manager. Credentials come from Application Default Credentials, which covers GKE
+// Workload Identity and workload identity federation configured through GOOGLE_APPLICATION_CREDENTIALS.
+type GCPSecretManagerResolver struct {
+	httpClient *http.Client
+	// endpoint overrides the Secret Manager API endpoint
+	endpoint string
+
+	tokenOnce   sync.Once
+	tokenSource oauth2.TokenSource
+	tokenErr    error
+}
+
+// NewGCPSecretManagerResolver creates a resolver that finds its credentials on first use, so
+// operators not using GCP are unaffected
+func NewGCPSecretManagerResolver() *GCPSecretManagerResolver {
+	return &GCPSecretManagerResolver{
+		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
+		endpoint:   gcpSecretManagerEndpoint,
+	}
+}
+
+// gcpAccessSecretVersionResponse holds the fields of an AccessSecretVersion response used by the resolver
+type gcpAccessSecretVersionResponse struct {
+	Payload struct {
+		Data string `json:"data"`
+	} `json:"payload"`
+}
+
+// Resolve returns a secret version's payload, a key of a payload stored as JSON, or every key for
+// #*. The latest version is read when no version is given.
+func (r *GCPSecretManagerResolver) Resolve(ctx context.Context, ref string) (any, error) {
+	name, key, _ := strings.Cut(ref, "#")
+	name, err := gcpSecretVersionName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := r.accessSecretVersion(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return parseJSONSecret(name, secret, key)
+}
+
+// gcpSecretVersionName validates a secret or secret version resource name, defaulting to the latest version
+func gcpSecretVersionName(name string) (string, error) {
+	parts := strings.Split(name, "/")
+	valid := (len(parts) == 4 || len(parts) == 6) && parts[0] == "projects" && parts[2] == "secrets"
+	if len(parts) == 6 {
+		valid = valid && parts[4] == "versions"
+	}
+	for _, part := range parts {
+		valid = valid && part != ""
+	}
+	if !valid {
+		return "", nonRetryable(fmt.Errorf("%w: expected format gcpsm:projects/project/secrets/secret[/versions/version][#json-key]", ErrInvalidObjectReference))
+	}
+
+	if len(parts) == 4 {
+		return name + "/versions/latest", nil
+	}
+	return name, nil
+}
+
+// accessSecretVersion reads the payload of a secret version
+func (r *GCPSecretManagerResolver) accessSecretVersion(ctx context.Context, name string) (string, error) {
+	tokenSource, err := r.loadTokenSource()
+	if err != nil {
+		return "", err
+	}
+	token, err := tokenSource.Token()
+	if err != nil {
+		return "", fmt.Errorf("failed to get GCP access token: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/%s:access", r.endpoint, name), nil)
+	if err != nil {
+		return "", err
+	}
+	token.SetAuthHeader(req)
+
+	var resp gcpAccessSecretVersionResponse
+	if err := doJSON(r.httpClient, req, &resp); err != nil {
+		return "", fmt.Errorf("failed to access GCP secret %s: %w", name, err)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(resp.Payload.Data)
+	if err != nil {
+		return "", nonRetryable(fmt.Errorf("failed to decode GCP secret %s: %w", name, err))
+	}
+	return string(data), nil
+}
+
+// loadTokenSource finds the application default credentials once
+func (r *GCPSecretManagerResolver) loadTokenSource() (oauth2.TokenSource, error) {
+	r.tokenOnce.Do(func() {
+		// The token source outlives any single reconcile, so it must not hold a reconcile's context
+		tokenSource, err := google.DefaultTokenSource(context.Background(), gcpCloudPlatformScope)
+		if err != nil {
+			r.tokenErr = nonRetryable(fmt.Errorf("failed to find GCP credentials: %w", err))
+			return
+		}
+		r.tokenSource = tokenSource
+	})
+	return r.tokenSource, r.tokenErr
+}
@@ -0,0 +1,121 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+)
+
+func TestGCPSecretManagerResolver(t *testing.T) {
+	secrets := map[string]string{
+		"/v1/projects/data/secrets/db-password/versions/latest:access": "latest-password",
+		"/v1/projects/data/secrets/db-password/versions/3:access":      "pinned-password",
+		"/v1/projects/data/secrets/db-creds/versions/latest:access":    `{"username":"fivetran","password":"secret-password"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if strings.Contains(r.URL.Path, "unavailable") {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		value, ok := secrets[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":404,"status":"NOT_FOUND"}}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"payload": map[string]any{"data": base64.StdEncoding.EncodeToString([]byte(value))},
+		})
+	}))
+	defer server.Close()
+
+	resolver := NewGCPSecretManagerResolver()
+	resolver.endpoint = server.URL
+	resolver.tokenOnce.Do(func() {
+		resolver.tokenSource = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "test-token", TokenType: "Bearer"})
+	})
+
+	tests := []struct {
+		name            string
+		ref             string
+		expected        any
+		expectError     bool
+		expectRetryable bool
+	}{
+		{
+			name:     "latest version",
+			ref:      "projects/data/secrets/db-password/versions/latest",
+			expected: "latest-password",
+		},
+		{
+			name:     "version defaults to latest",
+			ref:      "projects/data/secrets/db-password",
+			expected: "latest-password",
+		},
+		{
+			name:     "pinned version",
+			ref:      "projects/data/secrets/db-password/versions/3",
+			expected: "pinned-password",
+		},
+		{
+			name:     "json key",
+			ref:      "projects/data/secrets/db-creds#password",
+			expected: "secret-password",
+		},
+		{
+			name:        "missing secret",
+			ref:         "projects/data/secrets/missing",
+			expectError: true,
+		},
+		{
+			name:            "unavailable",
+			ref:             "projects/data/secrets/unavailable",
+			expectError:     true,
+			expectRetryable: true,
+		},
+		{
+			name:        "invalid name",
+			ref:         "data/db-password",
+			expectError: true,
+		},
+		{
+			name:        "invalid version segment",
+			ref:         "projects/data/secrets/db-password/revisions/3",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := resolver.Resolve(context.Background(), tt.ref)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error but got none")
+				}
+				if vault.IsRetryableError(err) != tt.expectRetryable {
+					t.Errorf("expected retryable %v for error: %v", tt.expectRetryable, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
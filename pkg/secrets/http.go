@@ -0,0 +1,68 @@
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// defaultHTTPTimeout bounds calls to cloud secret stores
+const defaultHTTPTimeout = 30 * time.Second
+
+// maxErrorBodyLength limits how much of an error response is kept in error messages
+const maxErrorBodyLength = 512
+
+// statusError reports a non-2xx response from a secret store
+type statusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.Body)
+}
+
+// doJSON sends a request and decodes its JSON response into out. Non-2xx responses are returned as
+// a statusError, which is marked retryable only for throttling and server errors.
+func doJSON(httpClient *http.Client, req *http.Request, out any) error {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLength))
+		sErr := &statusError{StatusCode: resp.StatusCode, Body: string(body)}
+		if !isRetryableStatus(resp.StatusCode) {
+			return nonRetryable(sErr)
+		}
+		return sErr
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// isRetryableStatus reports whether a response status is worth retrying
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// parseJSONSecret looks a key up in a secret stored as a JSON object. An empty key returns the raw
+// secret and a key of * returns every key of the object.
+func parseJSONSecret(name, secret, key string) (any, error) {
+	if key == "" {
+		return secret, nil
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal([]byte(secret), &data); err != nil {
+		return nil, nonRetryable(fmt.Errorf("secret %s is not a JSON object, so key '%s' cannot be read: %w", name, key, err))
+	}
+	return lookupKey("secret "+name, key, data)
+}
@@ -0,0 +1,164 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+)
+
+// SecretScheme is the scheme of Kubernetes Secret references, e.g. secret:namespace/name#key
+const SecretScheme = "secret"
+
+var (
+	ErrInvalidObjectReference = errors.New("invalid object reference format (expected format: name#key or namespace/name#key)")
+	ErrNamespaceNotAllowed    = errors.New("objects can only be referenced from the connector's namespace")
+	ErrObjectNotFound         = errors.New("object not found")
+	ErrObjectKeyNotFound      = errors.New("key not found in object")
+)
+
+// wholeObjectKey expands a reference to every key of the object
+const wholeObjectKey = "*"
+
+// KubernetesSecretResolver resolves secret:name#key and secret:namespace/name#key references to
+// the values of Kubernetes Secrets in the connector's namespace
+type KubernetesSecretResolver struct {
+	client client.Reader
+}
+
+// NewKubernetesSecretResolver creates a resolver reading Secrets through the given client
+func NewKubernetesSecretResolver(k8sClient client.Reader) *KubernetesSecretResolver {
+	return &KubernetesSecretResolver{client: k8sClient}
+}
+
+// Resolve returns the value of a Secret key, or every key of the Secret for name#*
+func (r *KubernetesSecretResolver) Resolve(ctx context.Context, ref string) (any, error) {
+	key, err := parseObjectReference(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, key.NamespacedName, secret); err != nil {
+		return nil, objectGetError("secret", key.NamespacedName, err)
+	}
+
+	data := make(map[string]any, len(secret.Data)+len(secret.StringData))
+	for k, v := range secret.Data {
+		data[k] = string(v)
+	}
+	for k, v := range secret.StringData {
+		data[k] = v
+	}
+	return lookupKey("secret "+key.String(), key.Key, data)
+}
+
+// objectKey identifies a key of a namespaced object
+type objectKey struct {
+	types.NamespacedName
+	Key string
+}
+
+// parseObjectReference parses name#key or namespace/name#key. The namespace defaults to, and must
+// match, the connector's namespace carried by the context.
+func parseObjectReference(ctx context.Context, ref string) (objectKey, error) {
+	path, key, ok := strings.Cut(ref, "#")
+	if !ok || path == "" || key == "" {
+		return objectKey{}, nonRetryable(ErrInvalidObjectReference)
+	}
+
+	connectorNamespace := NamespaceFromContext(ctx)
+	namespace, name, hasNamespace := strings.Cut(path, "/")
+	if !hasNamespace {
+		namespace, name = connectorNamespace, path
+	}
+	if name == "" || namespace == "" || strings.Contains(name, "/") {
+		return objectKey{}, nonRetryable(ErrInvalidObjectReference)
+	}
+	if connectorNamespace != "" && namespace != connectorNamespace {
+		return objectKey{}, nonRetryable(fmt.Errorf("%w: %s", ErrNamespaceNotAllowed, namespace))
+	}
+
+	return objectKey{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}, Key: key}, nil
+}
+
+// lookupKey returns one key of an object's data, or all of it for the * key. The source describes
+// the object in error messages.
+func lookupKey(source, key string, data map[string]any) (any, error) {
+	if key == wholeObjectKey {
+		return data, nil
+	}
+
+	value, ok := data[key]
+	if !ok {
+		available := make([]string, 0, len(data))
+		for k := range data {
+			available = append(available, k)
+		}
+		sort.Strings(available)
+		return nil, nonRetryable(fmt.Errorf("%w '%s' in %s (available keys: %v)", ErrObjectKeyNotFound, key, source, available))
+	}
+	return value, nil
+}
+
+// objectGetError describes a failed read, marking missing objects as not worth retrying
+func objectGetError(kind string, name types.NamespacedName, err error) error {
+	if apierrors.IsNotFound(err) {
+		return nonRetryable(fmt.Errorf("%w: %s %s", ErrObjectNotFound, kind, name))
+	}
+	return fmt.Errorf("failed to get %s %s: %w", kind, name, err)
+}
+
+// nonRetryable marks an error as permanent for the resolution walker
+func nonRetryable(err error) error {
+	return &vault.VaultError{Err: err, Retryable: false}
+}
+
+// ConfigMapScheme is the scheme of ConfigMap references, e.g. configmap:name#key
+const ConfigMapScheme = "configmap"
+
+// ConfigMapResolver resolves configmap:name#key and configmap:namespace/name#key references to
+// the values of ConfigMaps in the connector's namespace, for shared non-sensitive settings
+type ConfigMapResolver struct {
+	client client.Reader
+}
+
+// NewConfigMapResolver creates a resolver reading ConfigMaps through the given client
+func NewConfigMapResolver(k8sClient client.Reader) *ConfigMapResolver {
+	return &ConfigMapResolver{client: k8sClient}
+}
+
+// NonSensitive reports that ConfigMap values are not secret and need not be redacted
+func (*ConfigMapResolver) NonSensitive() bool {
+	return true
+}
+
+// Resolve returns the value of a ConfigMap key, or every key of the ConfigMap for name#*
+func (r *ConfigMapResolver) Resolve(ctx context.Context, ref string) (any, error) {
+	key, err := parseObjectReference(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.client.Get(ctx, key.NamespacedName, configMap); err != nil {
+		return nil, objectGetError("configmap", key.NamespacedName, err)
+	}
+
+	data := make(map[string]any, len(configMap.Data)+len(configMap.BinaryData))
+	for k, v := range configMap.BinaryData {
+		data[k] = string(v)
+	}
+	for k, v := range configMap.Data {
+		data[k] = v
+	}
+	return lookupKey("configmap "+key.String(), key.Key, data)
+}
@@ -0,0 +1,168 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+)
+
+func TestKubernetesSecretResolver(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().
+		WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "db-creds", Namespace: "connectors"},
+				Data: map[string][]byte{
+					"username": []byte("fivetran"),
+					"password": []byte("secret-password"),
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "db-creds", Namespace: "other"},
+				Data:       map[string][]byte{"username": []byte("other")},
+			},
+		).
+		Build()
+	resolver := NewKubernetesSecretResolver(k8sClient)
+	ctx := WithNamespace(context.Background(), "connectors")
+
+	tests := []struct {
+		name        string
+		ref         string
+		expected    any
+		expectError error
+	}{
+		{
+			name:     "key in connector namespace",
+			ref:      "db-creds#username",
+			expected: "fivetran",
+		},
+		{
+			name:     "explicit namespace",
+			ref:      "connectors/db-creds#password",
+			expected: "secret-password",
+		},
+		{
+			name:     "whole secret",
+			ref:      "db-creds#*",
+			expected: map[string]any{"username": "fivetran", "password": "secret-password"},
+		},
+		{
+			name:        "other namespace",
+			ref:         "other/db-creds#username",
+			expectError: ErrNamespaceNotAllowed,
+		},
+		{
+			name:        "missing secret",
+			ref:         "missing#username",
+			expectError: ErrObjectNotFound,
+		},
+		{
+			name:        "missing key",
+			ref:         "db-creds#token",
+			expectError: ErrObjectKeyNotFound,
+		},
+		{
+			name:        "missing key separator",
+			ref:         "db-creds",
+			expectError: ErrInvalidObjectReference,
+		},
+		{
+			name:        "empty name",
+			ref:         "connectors/#username",
+			expectError: ErrInvalidObjectReference,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := resolver.Resolve(ctx, tt.ref)
+			if tt.expectError != nil {
+				if !errors.Is(err, tt.expectError) {
+					t.Fatalf("expected error %v, got: %v", tt.expectError, err)
+				}
+				if vault.IsRetryableError(err) {
+					t.Errorf("expected error to not be retryable: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestConfigMapResolver(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().
+		WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared-db", Namespace: "connectors"},
+			Data: map[string]string{
+				"host": "db.example.com",
+				"port": "5432",
+			},
+		}).
+		Build()
+	resolver := NewConfigMapResolver(k8sClient)
+	ctx := WithNamespace(context.Background(), "connectors")
+
+	tests := []struct {
+		name        string
+		ref         string
+		expected    any
+		expectError error
+	}{
+		{
+			name:     "key",
+			ref:      "shared-db#host",
+			expected: "db.example.com",
+		},
+		{
+			name:     "whole configmap",
+			ref:      "connectors/shared-db#*",
+			expected: map[string]any{"host": "db.example.com", "port": "5432"},
+		},
+		{
+			name:        "missing configmap",
+			ref:         "missing#host",
+			expectError: ErrObjectNotFound,
+		},
+		{
+			name:        "missing key",
+			ref:         "shared-db#user",
+			expectError: ErrObjectKeyNotFound,
+		},
+		{
+			name:        "other namespace",
+			ref:         "other/shared-db#host",
+			expectError: ErrNamespaceNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := resolver.Resolve(ctx, tt.ref)
+			if tt.expectError != nil {
+				if !errors.Is(err, tt.expectError) {
+					t.Fatalf("expected error %v, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
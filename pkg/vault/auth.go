@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	vault "github.com/hashicorp/vault/api"
+	auth "github.com/hashicorp/vault/api/auth/approle"
+)
+
+// jwtAuth implements vault.AuthMethod for the JWT/OIDC auth backend.
+// The token is re-read from disk on every login so rotated workload identity
+// tokens (projected service account tokens, SPIFFE JWT-SVIDs) are picked up.
+type jwtAuth struct {
+	mountPath string
+	role      string
+	jwtPath   string
+}
+
+// Login reads the JWT from disk and exchanges it for a Vault token
+func (a *jwtAuth) Login(ctx context.Context, client *vault.Client) (*vault.Secret, error) {
+	jwt, err := os.ReadFile(a.jwtPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read JWT from %s: %w", a.jwtPath, err)
+	}
+
+	loginData := map[string]any{
+		"role": a.role,
+		"jwt":  strings.TrimSpace(string(jwt)),
+	}
+
+	path := fmt.Sprintf("auth/%s/login", a.mountPath)
+	resp, err := client.Logical().WriteWithContext(ctx, path, loginData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to log in with JWT auth: %w", err)
+	}
+	return resp, nil
+}
+
+// tokenFileAuth implements vault.AuthMethod for tokens rendered to disk by Vault Agent.
+// No login is performed; the token is read from disk and validated with a self lookup.
+type tokenFileAuth struct {
+	tokenPath string
+}
+
+// Login reads the agent-managed token from disk and verifies it is usable
+func (a *tokenFileAuth) Login(ctx context.Context, client *vault.Client) (*vault.Secret, error) {
+	token, err := readTokenFile(a.tokenPath)
+	if err != nil {
+		return nil, err
+	}
+
+	client.SetToken(token)
+	if _, err := client.Auth().Token().LookupSelfWithContext(ctx); err != nil {
+		client.ClearToken()
+		return nil, fmt.Errorf("token read from %s is not valid: %w", a.tokenPath, err)
+	}
+
+	return &vault.Secret{Auth: &vault.SecretAuth{ClientToken: token}}, nil
+}
+
+// readTokenFile reads a Vault token from disk
+func readTokenFile(path string) (string, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read vault token from %s: %w", path, err)
+	}
+	token := strings.TrimSpace(string(raw))
+	if token == "" {
+		return "", fmt.Errorf("vault token file %s is empty", path)
+	}
+	return token, nil
+}
+
+// newAuthMethod returns the vault.AuthMethod for the configured auth method
+func newAuthMethod(cfg *ClientConfig) (vault.AuthMethod, error) {
+	switch cfg.AuthMethod {
+	case AuthMethodAppRole, "":
+		return auth.NewAppRoleAuth(
+			cfg.RoleID,
+			&auth.SecretID{FromString: cfg.SecretID},
+		)
+	case AuthMethodJWT:
+		return &jwtAuth{
+			mountPath: cfg.AuthMountPath,
+			role:      cfg.Role,
+			jwtPath:   cfg.JWTPath,
+		}, nil
+	case AuthMethodToken:
+		return &tokenFileAuth{tokenPath: cfg.TokenPath}, nil
+	default:
+		return nil, fmt.Errorf("unsupported vault auth method %q", cfg.AuthMethod)
+	}
+}
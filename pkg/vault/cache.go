@@ -0,0 +1,136 @@
+package vault
+
+import (
+	"sync"
+	"time"
+)
+
+// SecretCache caches secret data read from Vault across reconciles. Entries expire after the
+// configured TTL and the oldest entry is evicted once the cache is full. It is safe for
+// concurrent use; a nil cache is valid and never holds entries.
+type SecretCache struct {
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+// CachedSecret is secret data together with the KV v2 version it was read at (0 for KV v1)
+// and, for dynamic credentials, the lease it was issued with
+type CachedSecret struct {
+	Data    map[string]any
+	Version int
+
+	LeaseID       string
+	LeaseDuration int
+	Renewable     bool
+}
+
+type cacheEntry struct {
+	secret   CachedSecret
+	storedAt time.Time
+}
+
+// NewSecretCache returns a cache holding up to maxEntries secrets for ttl. A non-positive ttl
+// disables caching and returns nil; a non-positive maxEntries means no size limit.
+func NewSecretCache(ttl time.Duration, maxEntries int) *SecretCache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &SecretCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]cacheEntry),
+	}
+}
+
+// Partition returns an empty cache with the same settings, for clients whose secrets must not be
+// served to other clients. It returns nil when c is nil.
+func (c *SecretCache) Partition() *SecretCache {
+	if c == nil {
+		return nil
+	}
+	return NewSecretCache(c.ttl, c.maxEntries)
+}
+
+// Get returns the cached secret for key if present and not expired
+func (c *SecretCache) Get(key string) (CachedSecret, bool) {
+	if c == nil {
+		return CachedSecret{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		cacheLookupsTotal.WithLabelValues(resultMiss).Inc()
+		return CachedSecret{}, false
+	}
+	if c.now().Sub(entry.storedAt) >= c.ttl {
+		delete(c.entries, key)
+		cacheLookupsTotal.WithLabelValues(resultMiss).Inc()
+		return CachedSecret{}, false
+	}
+	cacheLookupsTotal.WithLabelValues(resultHit).Inc()
+	return entry.secret, true
+}
+
+// Set stores a secret under key, evicting expired entries and then the oldest entry when full
+func (c *SecretCache) Set(key string, secret CachedSecret) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if _, exists := c.entries[key]; !exists && c.maxEntries > 0 && len(c.entries) >= c.maxEntries {
+		c.evictLocked(now)
+	}
+	c.entries[key] = cacheEntry{secret: secret, storedAt: now}
+}
+
+// Invalidate removes the entry for key
+func (c *SecretCache) Invalidate(key string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// Len returns the number of entries, including expired ones not yet evicted
+func (c *SecretCache) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// evictLocked drops expired entries, or the oldest entry when none have expired
+func (c *SecretCache) evictLocked(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if now.Sub(entry.storedAt) >= c.ttl {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.storedAt.Before(oldest) {
+			oldestKey, oldest = key, entry.storedAt
+		}
+	}
+	if len(c.entries) >= c.maxEntries && oldestKey != "" {
+		delete(c.entries, oldestKey)
+	}
+}
@@ -0,0 +1,89 @@
+package vault
+
+import (
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+// cacheLookups returns the number of cache lookups recorded with a result
+func cacheLookups(t *testing.T, result string) float64 {
+	t.Helper()
+	var metric dto.Metric
+	if err := cacheLookupsTotal.WithLabelValues(result).Write(&metric); err != nil {
+		t.Fatalf("failed to read metric: %v", err)
+	}
+	return metric.GetCounter().GetValue()
+}
+
+func TestSecretCache(t *testing.T) {
+	now := time.Unix(0, 0)
+	cache := NewSecretCache(time.Minute, 2)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("apps/a", CachedSecret{Data: map[string]any{"key": "a"}, Version: 1})
+	if got, ok := cache.Get("apps/a"); !ok || got.Data["key"] != "a" || got.Version != 1 {
+		t.Errorf("expected cached secret a, got %+v (found: %v)", got, ok)
+	}
+
+	// Expired entries are not returned
+	now = now.Add(time.Minute)
+	if _, ok := cache.Get("apps/a"); ok {
+		t.Errorf("expected entry to expire after ttl")
+	}
+
+	// The oldest entry is evicted when the cache is full
+	cache.Set("apps/a", CachedSecret{Data: map[string]any{"key": "a"}})
+	now = now.Add(time.Second)
+	cache.Set("apps/b", CachedSecret{Data: map[string]any{"key": "b"}})
+	now = now.Add(time.Second)
+	cache.Set("apps/c", CachedSecret{Data: map[string]any{"key": "c"}})
+	if _, ok := cache.Get("apps/a"); ok {
+		t.Errorf("expected oldest entry to be evicted")
+	}
+	if cache.Len() != 2 {
+		t.Errorf("expected 2 entries, got %d", cache.Len())
+	}
+
+	cache.Invalidate("apps/b")
+	if _, ok := cache.Get("apps/b"); ok {
+		t.Errorf("expected invalidated entry to be removed")
+	}
+	if _, ok := cache.Get("apps/c"); !ok {
+		t.Errorf("expected entry c to remain cached")
+	}
+}
+
+func TestNilSecretCache(t *testing.T) {
+	cache := NewSecretCache(0, 10)
+	if cache != nil {
+		t.Fatalf("expected zero ttl to disable the cache")
+	}
+
+	cache.Set("apps/a", CachedSecret{})
+	if _, ok := cache.Get("apps/a"); ok {
+		t.Errorf("expected nil cache to never hold entries")
+	}
+	cache.Invalidate("apps/a")
+	if cache.Len() != 0 {
+		t.Errorf("expected nil cache to be empty")
+	}
+}
+
+func TestSecretCacheMetrics(t *testing.T) {
+	cache := NewSecretCache(time.Minute, 0)
+	hits, misses := cacheLookups(t, resultHit), cacheLookups(t, resultMiss)
+
+	cache.Get("apps/a")
+	cache.Set("apps/a", CachedSecret{Data: map[string]any{"key": "a"}})
+	cache.Get("apps/a")
+	cache.Get("apps/a")
+
+	if got := cacheLookups(t, resultHit) - hits; got != 2 {
+		t.Errorf("expected 2 hits, got %v", got)
+	}
+	if got := cacheLookups(t, resultMiss) - misses; got != 1 {
+		t.Errorf("expected 1 miss, got %v", got)
+	}
+}
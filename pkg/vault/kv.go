@@ -0,0 +1,82 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// KVVersion returns the KV engine version of the given mount. The version configured in
+// the client config applies to the default mount; other mounts, and the default mount when
+// no version is configured, are detected from Vault on first use and remembered. When the
+// version cannot be detected KV v2 is assumed without remembering the result.
+func KVVersion(ctx context.Context, vc *VaultClient, mount string) int {
+	if mount == vc.Config.MountPath && vc.Config.KVVersion != 0 {
+		return vc.Config.KVVersion
+	}
+
+	vc.kvVersionMu.Lock()
+	defer vc.kvVersionMu.Unlock()
+
+	if version, ok := vc.kvVersions[mount]; ok {
+		return version
+	}
+
+	version, err := detectKVVersion(ctx, vc, mount)
+	if err != nil {
+		return KVVersion2
+	}
+	if vc.kvVersions == nil {
+		vc.kvVersions = make(map[string]int)
+	}
+	vc.kvVersions[mount] = version
+	return version
+}
+
+// detectKVVersion reads the mount's engine options through the UI mounts endpoint,
+// which is readable by any token with access to paths under the mount.
+func detectKVVersion(ctx context.Context, vc *VaultClient, mount string) (int, error) {
+	secret, err := vc.Client.Logical().ReadWithContext(ctx, "sys/internal/ui/mounts/"+mount)
+	if err != nil {
+		return 0, err
+	}
+	if secret == nil || secret.Data == nil {
+		return 0, fmt.Errorf("no mount information returned for %s", mount)
+	}
+
+	if mountType, _ := secret.Data["type"].(string); mountType != "kv" && mountType != "generic" {
+		return 0, fmt.Errorf("mount %s is not a KV secrets engine (type %q)", mount, mountType)
+	}
+
+	options, _ := secret.Data["options"].(map[string]any)
+	if version, _ := options["version"].(string); version == "2" {
+		return KVVersion2, nil
+	}
+	return KVVersion1, nil
+}
+
+// kvVersionFromSecret reads the optional kvVersion key from the vault credentials secret
+func kvVersionFromSecret(secret *corev1.Secret) (int, error) {
+	raw := strings.TrimPrefix(strings.TrimSpace(string(secret.Data["kvVersion"])), "v")
+	if raw == "" {
+		return 0, nil
+	}
+
+	version, err := strconv.Atoi(raw)
+	if err != nil || (version != KVVersion1 && version != KVVersion2) {
+		return 0, fmt.Errorf("invalid vault kvVersion %q: must be 1 or 2", string(secret.Data["kvVersion"]))
+	}
+	return version, nil
+}
+
+// LatestSecretVersion returns the current version of a KV v2 secret from its metadata
+func LatestSecretVersion(ctx context.Context, vc *VaultClient, mount, path string) (int, error) {
+	metadata, err := vc.Client.KVv2(mount).GetMetadata(ctx, path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read metadata for %s/%s: %w", mount, path, err)
+	}
+	return metadata.CurrentVersion, nil
+}
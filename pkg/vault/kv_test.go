@@ -0,0 +1,36 @@
+package vault
+
+import (
+	"context"
+	"testing"
+
+	vaultapi "github.com/hashicorp/vault/api"
+)
+
+func TestLatestSecretVersion(t *testing.T) {
+	client, _, cleanup := setupTestVault(t)
+	defer cleanup()
+
+	if err := client.Sys().Mount("apps", &vaultapi.MountInput{Type: "kv-v2"}); err != nil {
+		t.Fatalf("failed to create apps mount: %v", err)
+	}
+	for _, value := range []string{"first", "second"} {
+		if _, err := client.KVv2("apps").Put(context.Background(), "rotated", map[string]any{"key": value}); err != nil {
+			t.Fatalf("failed to write secret: %v", err)
+		}
+	}
+
+	vc := &VaultClient{Client: client, Config: &ClientConfig{MountPath: "apps"}}
+
+	version, err := LatestSecretVersion(context.Background(), vc, "apps", "rotated")
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if version != 2 {
+		t.Errorf("expected version 2, got %d", version)
+	}
+
+	if _, err := LatestSecretVersion(context.Background(), vc, "apps", "missing"); err == nil {
+		t.Errorf("expected error for missing secret but got none")
+	}
+}
@@ -0,0 +1,27 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+)
+
+// RenewLease extends a lease by increment seconds and returns the TTL granted, which Vault caps at
+// the lease's maximum TTL
+func RenewLease(ctx context.Context, vc *VaultClient, leaseID string, increment int) (int, error) {
+	secret, err := vc.Client.Sys().RenewWithContext(ctx, leaseID, increment)
+	if err != nil {
+		return 0, fmt.Errorf("failed to renew lease %s: %w", leaseID, err)
+	}
+	if secret == nil {
+		return 0, fmt.Errorf("no lease information returned when renewing lease %s", leaseID)
+	}
+	return secret.LeaseDuration, nil
+}
+
+// RevokeLease revokes a lease, invalidating the credentials issued with it
+func RevokeLease(ctx context.Context, vc *VaultClient, leaseID string) error {
+	if err := vc.Client.Sys().RevokeWithContext(ctx, leaseID); err != nil {
+		return fmt.Errorf("failed to revoke lease %s: %w", leaseID, err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	vaultapi "github.com/hashicorp/vault/api"
+)
+
+func TestLeases(t *testing.T) {
+	var revokedLease string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		switch r.URL.Path {
+		case "/v1/sys/leases/renew":
+			if body["lease_id"] != "database/creds/readonly/lease-1" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"errors":["lease not found"]}`))
+				return
+			}
+			// Grant less than requested, as Vault does when a lease nears its max TTL
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"lease_id":       body["lease_id"],
+				"lease_duration": 600,
+				"renewable":      true,
+			})
+		case "/v1/sys/leases/revoke":
+			revokedLease, _ = body["lease_id"].(string)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := vaultapi.DefaultConfig()
+	config.Address = server.URL
+	client, err := vaultapi.NewClient(config)
+	if err != nil {
+		t.Fatalf("failed to create vault client: %v", err)
+	}
+	vc := &VaultClient{Client: client}
+
+	granted, err := RenewLease(context.Background(), vc, "database/creds/readonly/lease-1", 3600)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if granted != 600 {
+		t.Errorf("expected granted TTL 600, got %d", granted)
+	}
+
+	if _, err := RenewLease(context.Background(), vc, "database/creds/readonly/unknown", 3600); err == nil {
+		t.Errorf("expected error renewing unknown lease, got none")
+	}
+
+	if err := RevokeLease(context.Background(), vc, "database/creds/readonly/lease-1"); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if revokedLease != "database/creds/readonly/lease-1" {
+		t.Errorf("expected lease database/creds/readonly/lease-1 to be revoked, got %q", revokedLease)
+	}
+}
@@ -0,0 +1,167 @@
+package vault
+
+import (
+	"context"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// minTokenTTLSeconds is the remaining token TTL below which the manager logs in again
+const minTokenTTLSeconds = 300
+
+// ClientManager hands out Vault clients to concurrent reconciles. It creates each client from its
+// vault connection secret on first use, replaces it when its token is no longer valid, and renews
+// the token of every current client in the background. Besides the operator-wide client, it keeps
+// one client per distinct ClientRef.
+type ClientManager struct {
+	k8sClient client.Client
+	cache     *SecretCache
+
+	mu      sync.Mutex
+	current *managedClient
+	scoped  map[ClientRef]*managedClient
+}
+
+// managedClient is a Vault client with the renewal of its token
+type managedClient struct {
+	client      *VaultClient
+	cache       *SecretCache
+	renewer     *TokenRenewer
+	stopRenewal context.CancelFunc
+}
+
+// NewClientManager returns a manager that reads vault connection secrets with k8sClient. The
+// operator-wide client uses the given secret cache, which may be nil; clients for a ClientRef each
+// use a partition of it, so secrets read with one Vault role are never served to another.
+func NewClientManager(k8sClient client.Client, cache *SecretCache) *ClientManager {
+	return &ClientManager{
+		k8sClient: k8sClient,
+		cache:     cache,
+		scoped:    make(map[ClientRef]*managedClient),
+	}
+}
+
+// Client returns the operator-wide authenticated Vault client, initializing it from the named
+// secret when there is no client yet or the current token is no longer valid. Callers are
+// serialized while the token is checked so that only one of them logs in.
+func (m *ClientManager) Client(ctx context.Context, namespace, secretName string) (*VaultClient, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.current == nil {
+		m.current = &managedClient{cache: m.cache}
+	}
+	return m.clientLocked(ctx, m.current, ClientRef{Namespace: namespace, SecretName: secretName})
+}
+
+// ClientFor behaves like Client for the client identified by ref, applying its overrides to the
+// settings read from its secret
+func (m *ClientManager) ClientFor(ctx context.Context, ref ClientRef) (*VaultClient, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	managed, ok := m.scoped[ref]
+	if !ok {
+		managed = &managedClient{cache: m.cache.Partition()}
+		m.scoped[ref] = managed
+	}
+	return m.clientLocked(ctx, managed, ref)
+}
+
+// clientLocked returns the managed client, logging in again when its token is no longer valid
+func (m *ClientManager) clientLocked(ctx context.Context, managed *managedClient, ref ClientRef) (*VaultClient, error) {
+	logger := log.FromContext(ctx)
+
+	// Pick up a token rotated on disk by Vault Agent before checking its validity
+	if reloaded, err := ReloadTokenFile(managed.client); err != nil {
+		logger.Error(err, "failed to reload vault token file")
+	} else if reloaded {
+		logger.Info("vault token reloaded from file")
+	}
+
+	if ttlSeconds, ok := tokenTTL(managed.client); ok {
+		tokenTTLSeconds.WithLabelValues(ref.metricLabel()).Set(float64(ttlSeconds))
+		if ttlSeconds > minTokenTTLSeconds {
+			return managed.client, nil
+		}
+	}
+
+	logger.Info("vault client is not initialized or expired, initializing new client")
+	vaultClient, err := InitializeVaultClient(ctx, m.k8sClient, ref)
+	if err != nil {
+		return nil, err
+	}
+	vaultClient.Cache = managed.cache
+	managed.replace(vaultClient)
+	if auth := vaultClient.authInfo.Auth; auth != nil {
+		tokenTTLSeconds.WithLabelValues(ref.metricLabel()).Set(float64(auth.LeaseDuration))
+	}
+	logger.Info("vault client initialized successfully")
+
+	return vaultClient, nil
+}
+
+// RenewalErr returns the last token renewal error of the operator-wide client, if any
+func (m *ClientManager) RenewalErr() error {
+	m.mu.Lock()
+	managed := m.current
+	m.mu.Unlock()
+
+	return managed.renewalErr()
+}
+
+// RenewalErrFor returns the last token renewal error of the client identified by ref, if any
+func (m *ClientManager) RenewalErrFor(ref ClientRef) error {
+	m.mu.Lock()
+	managed := m.scoped[ref]
+	m.mu.Unlock()
+
+	return managed.renewalErr()
+}
+
+// Start implements manager.Runnable; it stops token renewal when the context is cancelled
+func (m *ClientManager) Start(ctx context.Context) error {
+	<-ctx.Done()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.current != nil {
+		m.current.stop()
+	}
+	for _, managed := range m.scoped {
+		managed.stop()
+	}
+	return nil
+}
+
+// replace makes vaultClient the current client and moves token renewal over to it
+func (c *managedClient) replace(vaultClient *VaultClient) {
+	c.stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	renewer := NewTokenRenewer(vaultClient)
+	go func() {
+		_ = renewer.Start(ctx)
+	}()
+
+	c.client = vaultClient
+	c.renewer = renewer
+	c.stopRenewal = cancel
+}
+
+// stop stops the renewal of the current token
+func (c *managedClient) stop() {
+	if c.stopRenewal != nil {
+		c.stopRenewal()
+	}
+}
+
+// renewalErr returns the last renewal error of the current token, if any
+func (c *managedClient) renewalErr() error {
+	if c == nil || c.renewer == nil {
+		return nil
+	}
+	return c.renewer.Err()
+}
@@ -0,0 +1,171 @@
+package vault
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClientManager(t *testing.T) {
+	testClient, roleID, cleanup := setupTestVault(t)
+	defer cleanup()
+
+	secretIDResp, err := testClient.Logical().Write("auth/approle/role/test-role/secret-id", nil)
+	if err != nil {
+		t.Fatalf("failed to generate secret ID: %v", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-secret", Namespace: "test-namespace"},
+		Data: map[string][]byte{
+			"address":   []byte(testClient.Address()),
+			"roleId":    []byte(roleID),
+			"secretId":  []byte(secretIDResp.Data["secret_id"].(string)),
+			"mountPath": []byte("apps"),
+		},
+	}
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	cache := NewSecretCache(time.Minute, 10)
+	manager := NewClientManager(k8sClient, cache)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = manager.Start(ctx)
+	}()
+
+	// Concurrent callers share a single client
+	clients := make([]*VaultClient, 10)
+	var wg sync.WaitGroup
+	for i := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vc, err := manager.Client(ctx, "test-namespace", "vault-secret")
+			if err != nil {
+				t.Errorf("expected no error but got: %v", err)
+				return
+			}
+			clients[i] = vc
+		}()
+	}
+	wg.Wait()
+
+	for _, vc := range clients {
+		if vc == nil || vc != clients[0] {
+			t.Fatalf("expected all callers to share one client")
+		}
+	}
+	if clients[0].Cache != cache {
+		t.Errorf("expected client to use the manager's secret cache")
+	}
+	if err := manager.RenewalErr(); err != nil {
+		t.Errorf("expected no renewal error, got: %v", err)
+	}
+
+	// A revoked token is replaced by a new client
+	if err := clients[0].Client.Auth().Token().RevokeSelf(""); err != nil {
+		t.Fatalf("failed to revoke token: %v", err)
+	}
+	vc, err := manager.Client(ctx, "test-namespace", "vault-secret")
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if vc == clients[0] {
+		t.Errorf("expected a new client after the token was revoked")
+	}
+	if !IsTokenValid(vc, 0) {
+		t.Errorf("expected new client token to be valid")
+	}
+
+	if _, err := manager.Client(ctx, "test-namespace", "missing-secret"); err != nil {
+		t.Errorf("expected the valid current client to be returned, got error: %v", err)
+	}
+}
+
+func TestClientManagerMissingSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	manager := NewClientManager(fake.NewClientBuilder().WithScheme(scheme).Build(), nil)
+
+	if _, err := manager.Client(context.Background(), "test-namespace", "vault-secret"); err == nil {
+		t.Errorf("expected error for missing secret but got none")
+	}
+}
+
+func TestClientManagerClientFor(t *testing.T) {
+	testClient, roleID, cleanup := setupTestVault(t)
+	defer cleanup()
+
+	secretIDResp, err := testClient.Logical().Write("auth/approle/role/test-role/secret-id", nil)
+	if err != nil {
+		t.Fatalf("failed to generate secret ID: %v", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-vault-secret", Namespace: "test-namespace"},
+		Data: map[string][]byte{
+			"address":   []byte(testClient.Address()),
+			"roleId":    []byte(roleID),
+			"secretId":  []byte(secretIDResp.Data["secret_id"].(string)),
+			"mountPath": []byte("apps"),
+		},
+	}
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	cache := NewSecretCache(time.Minute, 10)
+	manager := NewClientManager(k8sClient, cache)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = manager.Start(ctx)
+	}()
+
+	teamA := ClientRef{Namespace: "test-namespace", SecretName: "team-vault-secret", MountPath: "team-a"}
+	teamB := ClientRef{Namespace: "test-namespace", SecretName: "team-vault-secret", MountPath: "team-b"}
+
+	clientA, err := manager.ClientFor(ctx, teamA)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if clientA.Config.MountPath != "team-a" {
+		t.Errorf("expected mount path override team-a, got %s", clientA.Config.MountPath)
+	}
+	again, err := manager.ClientFor(ctx, teamA)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if again != clientA {
+		t.Errorf("expected the same configuration to share a client")
+	}
+
+	clientB, err := manager.ClientFor(ctx, teamB)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+	if clientB == clientA {
+		t.Errorf("expected distinct configurations to use distinct clients")
+	}
+	if clientA.Cache == nil || clientA.Cache == cache || clientA.Cache == clientB.Cache {
+		t.Errorf("expected each configuration to use its own cache partition")
+	}
+	if err := manager.RenewalErrFor(teamA); err != nil {
+		t.Errorf("expected no renewal error, got: %v", err)
+	}
+
+	// A role override only applies to the JWT auth method
+	withRole := ClientRef{Namespace: "test-namespace", SecretName: "team-vault-secret", Role: "team-a"}
+	if _, err := manager.ClientFor(ctx, withRole); err == nil {
+		t.Errorf("expected error for a role override with AppRole auth but got none")
+	}
+}
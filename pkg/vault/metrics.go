@@ -0,0 +1,43 @@
+package vault
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// tracer creates the spans of Vault logins
+var tracer = otel.Tracer("github.com/redhat-data-and-ai/fivetran-operator/pkg/vault")
+
+const (
+	resultSuccess = "success"
+	resultFailure = "failure"
+	resultHit     = "hit"
+	resultMiss    = "miss"
+)
+
+var (
+	tokenRenewalsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fivetran_operator_vault_token_renewals_total",
+		Help: "Number of Vault token renewal attempts by result.",
+	}, []string{"result"})
+
+	loginsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fivetran_operator_vault_logins_total",
+		Help: "Number of Vault login attempts by result.",
+	}, []string{"result"})
+
+	tokenTTLSeconds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fivetran_operator_vault_token_ttl_seconds",
+		Help: "Remaining TTL of each Vault client's token when it was last checked.",
+	}, []string{"client"})
+
+	cacheLookupsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fivetran_operator_vault_cache_lookups_total",
+		Help: "Number of Vault secret cache lookups by result, hit or miss.",
+	}, []string{"result"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(tokenRenewalsTotal, loginsTotal, tokenTTLSeconds, cacheLookupsTotal)
+}
@@ -0,0 +1,132 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	vault "github.com/hashicorp/vault/api"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// defaultLoginRetryInterval is how long the renewer waits before retrying a failed login
+const defaultLoginRetryInterval = 30 * time.Second
+
+// TokenRenewer keeps a client's token alive in the background. It renews the token with a
+// Vault lifetime watcher and logs in again before the token expires or once renewal fails,
+// so reconciles don't have to re-authenticate. Tokens read from a Vault Agent token file are
+// managed by the agent and are not renewed.
+type TokenRenewer struct {
+	vc            *VaultClient
+	retryInterval time.Duration
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+// NewTokenRenewer returns a renewer for the given client
+func NewTokenRenewer(vc *VaultClient) *TokenRenewer {
+	return &TokenRenewer{
+		vc:            vc,
+		retryInterval: defaultLoginRetryInterval,
+	}
+}
+
+// Err returns the error of the last failed renewal or login, or nil once the token has been
+// renewed or obtained successfully again
+func (r *TokenRenewer) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lastErr
+}
+
+func (r *TokenRenewer) setErr(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastErr = err
+}
+
+// Start renews the token until the context is cancelled
+func (r *TokenRenewer) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("vault-token-renewer")
+	if r.vc.Config.AuthMethod == AuthMethodToken {
+		logger.Info("Vault token is managed by Vault Agent, skipping renewal")
+		return nil
+	}
+
+	authInfo := r.vc.authInfo
+	for {
+		if authInfo == nil {
+			var err error
+			authInfo, err = login(ctx, r.vc.Client, r.vc.Config)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				logger.Error(err, "vault login failed", "retryIn", r.retryInterval)
+				r.setErr(fmt.Errorf("vault login failed: %w", err))
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(r.retryInterval):
+				}
+				continue
+			}
+			logger.Info("vault login succeeded")
+			r.setErr(nil)
+		}
+
+		err := r.watch(ctx, authInfo)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			tokenRenewalsTotal.WithLabelValues(resultFailure).Inc()
+			logger.Error(err, "vault token renewal failed, logging in again")
+			r.setErr(fmt.Errorf("vault token renewal failed: %w", err))
+		}
+		authInfo = nil
+	}
+}
+
+// watch renews the token from authInfo until it can no longer be renewed. It returns nil when
+// the token reaches its maximum TTL and an error when a renewal fails.
+func (r *TokenRenewer) watch(ctx context.Context, authInfo *vault.Secret) error {
+	if authInfo.Auth == nil || authInfo.Auth.LeaseDuration <= 0 {
+		// The token does not expire
+		<-ctx.Done()
+		return nil
+	}
+
+	if !authInfo.Auth.Renewable {
+		// Log in again once two thirds of the token's lifetime have passed
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Duration(authInfo.Auth.LeaseDuration) * time.Second * 2 / 3):
+		}
+		return nil
+	}
+
+	watcher, err := r.vc.Client.NewLifetimeWatcher(&vault.LifetimeWatcherInput{
+		Secret:        authInfo,
+		RenewBehavior: vault.RenewBehaviorErrorOnErrors,
+	})
+	if err != nil {
+		return err
+	}
+	go watcher.Start()
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-watcher.DoneCh():
+			return err
+		case <-watcher.RenewCh():
+			tokenRenewalsTotal.WithLabelValues(resultSuccess).Inc()
+			r.setErr(nil)
+		}
+	}
+}
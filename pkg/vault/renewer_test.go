@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTokenRenewer(t *testing.T) {
+	testClient, _, cleanup := setupTestVault(t)
+	defer cleanup()
+
+	// A short-lived renewable token forces both renewals and a new login within the test
+	if _, err := testClient.Logical().Write("auth/approle/role/short-role", map[string]any{
+		"token_ttl":     "2s",
+		"token_max_ttl": "4s",
+		"policies":      []string{"default"},
+	}); err != nil {
+		t.Fatalf("failed to create short role: %v", err)
+	}
+	roleIDResp, err := testClient.Logical().Read("auth/approle/role/short-role/role-id")
+	if err != nil {
+		t.Fatalf("failed to read role ID: %v", err)
+	}
+	secretIDResp, err := testClient.Logical().Write("auth/approle/role/short-role/secret-id", nil)
+	if err != nil {
+		t.Fatalf("failed to generate secret ID: %v", err)
+	}
+
+	cfg, err := NewClientConfig(testClient.Address(), roleIDResp.Data["role_id"].(string), secretIDResp.Data["secret_id"].(string), "apps")
+	if err != nil {
+		t.Fatalf("failed to create client config: %v", err)
+	}
+	client, authInfo, err := newClient(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	vc := &VaultClient{Client: client, Config: cfg, authInfo: authInfo}
+	initialToken := client.Token()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	renewer := NewTokenRenewer(vc)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = renewer.Start(ctx)
+	}()
+
+	// Outlive the initial token's max TTL
+	time.Sleep(6 * time.Second)
+
+	if client.Token() == initialToken {
+		t.Errorf("expected renewer to log in again after the token reached its max TTL")
+	}
+	if _, err := client.Auth().Token().LookupSelf(); err != nil {
+		t.Errorf("expected token to be valid, got: %v", err)
+	}
+	if err := renewer.Err(); err != nil {
+		t.Errorf("expected no renewal error, got: %v", err)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Errorf("expected renewer to stop after the context was cancelled")
+	}
+}
+
+func TestTokenRenewerSkipsTokenFile(t *testing.T) {
+	renewer := NewTokenRenewer(&VaultClient{Config: &ClientConfig{AuthMethod: AuthMethodToken}})
+	if err := renewer.Start(context.Background()); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package vault
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	vault "github.com/hashicorp/vault/api"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// configureTLS applies the TLS settings to the Vault API config.
+// Settings from the VAULT_* environment variables are kept unless overridden.
+func configureTLS(config *vault.Config, tlsConfig TLSConfig) error {
+	if err := config.ConfigureTLS(&vault.TLSConfig{
+		CACertBytes:   tlsConfig.CACert,
+		TLSServerName: tlsConfig.ServerName,
+		Insecure:      tlsConfig.SkipVerify,
+	}); err != nil {
+		return fmt.Errorf("failed to configure vault TLS: %w", err)
+	}
+
+	switch {
+	case len(tlsConfig.ClientCert) > 0 && len(tlsConfig.ClientKey) > 0:
+		clientCert, err := tls.X509KeyPair(tlsConfig.ClientCert, tlsConfig.ClientKey)
+		if err != nil {
+			return fmt.Errorf("failed to load vault client certificate: %w", err)
+		}
+		transport, ok := config.HttpClient.Transport.(*http.Transport)
+		if !ok {
+			return fmt.Errorf("unsupported vault HTTP client transport type %T", config.HttpClient.Transport)
+		}
+		transport.TLSClientConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return &clientCert, nil
+		}
+	case len(tlsConfig.ClientCert) > 0 || len(tlsConfig.ClientKey) > 0:
+		return errors.New("both vault clientCert and clientKey must be provided")
+	}
+
+	return nil
+}
+
+// tlsConfigFromSecret reads the optional TLS settings from the vault credentials secret
+func tlsConfigFromSecret(secret *corev1.Secret) (TLSConfig, error) {
+	tlsConfig := TLSConfig{
+		CACert:     secret.Data["caCert"],
+		ClientCert: secret.Data["clientCert"],
+		ClientKey:  secret.Data["clientKey"],
+		ServerName: string(secret.Data["tlsServerName"]),
+	}
+
+	if raw, ok := secret.Data["tlsSkipVerify"]; ok && len(raw) > 0 {
+		skipVerify, err := strconv.ParseBool(string(raw))
+		if err != nil {
+			return TLSConfig{}, fmt.Errorf("invalid vault tlsSkipVerify value %q: %w", string(raw), err)
+		}
+		tlsConfig.SkipVerify = skipVerify
+	}
+
+	return tlsConfig, nil
+}
@@ -1,19 +1,83 @@
 package vault
 
 import (
+	"sync"
+	"time"
+
 	vault "github.com/hashicorp/vault/api"
 )
 
+// Supported Vault auth methods
+const (
+	AuthMethodAppRole = "approle"
+	AuthMethodJWT     = "jwt"
+	AuthMethodToken   = "token"
+)
+
+// Supported KV secret engine versions
+const (
+	KVVersion1 = 1
+	KVVersion2 = 2
+)
+
+const (
+	// defaultJWTAuthMountPath is the mount path of the JWT/OIDC auth backend when none is configured
+	defaultJWTAuthMountPath = "jwt"
+	// defaultJWTPath is the projected service account token mounted into every pod
+	defaultJWTPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	// defaultTokenPath is where the Vault Agent injector renders the token when agent-inject-token is enabled
+	defaultTokenPath = "/vault/secrets/token"
+)
+
 // VaultClient wraps the Vault API client with its configuration
 type VaultClient struct {
 	Client *vault.Client
 	Config *ClientConfig
+
+	// Cache holds secrets read across reconciles; nil disables the shared cache
+	Cache *SecretCache
+
+	// tokenModTime is the modification time of the token file when it was last read
+	tokenModTime time.Time
+
+	// authInfo is the response of the initial login, used to start token renewal
+	authInfo *vault.Secret
+
+	// kvVersions holds the detected KV engine version per mount
+	kvVersionMu sync.Mutex
+	kvVersions  map[string]int
 }
 
 // ClientConfig holds the configuration for creating a Vault client
 type ClientConfig struct {
-	Address   string
-	RoleID    string
-	SecretID  string
+	Address    string
+	AuthMethod string
+	// AppRole credentials
+	RoleID   string
+	SecretID string
+	// JWT/OIDC credentials
+	Role          string
+	JWTPath       string
+	AuthMountPath string
+	// Vault Agent token file
+	TokenPath string
 	MountPath string
+	// Namespace is the Vault Enterprise namespace to authenticate and read secrets in
+	Namespace string
+	// KVVersion is the KV engine version of MountPath; detected from Vault when zero
+	KVVersion int
+	TLS       TLSConfig
+}
+
+// TLSConfig holds the TLS settings used to connect to Vault
+type TLSConfig struct {
+	// CACert is a PEM-encoded CA certificate or bundle used to verify the Vault server
+	CACert []byte
+	// ClientCert and ClientKey are a PEM-encoded key pair presented for mutual TLS
+	ClientCert []byte
+	ClientKey  []byte
+	// ServerName overrides the SNI host name used to verify the Vault server certificate
+	ServerName string
+	// SkipVerify disables verification of the Vault server certificate
+	SkipVerify bool
 }
@@ -5,9 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"time"
 
 	vault "github.com/hashicorp/vault/api"
-	auth "github.com/hashicorp/vault/api/auth/approle"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -15,63 +19,92 @@ import (
 
 // NewClient creates a new vault client
 func NewClient(cfg *ClientConfig) (*vault.Client, error) {
+	vaultClient, _, err := newClient(context.Background(), cfg)
+	return vaultClient, err
+}
+
+// newClient creates and authenticates a vault client, returning the login response for token renewal
+func newClient(ctx context.Context, cfg *ClientConfig) (*vault.Client, *vault.Secret, error) {
 	config := vault.DefaultConfig()
 	config.Address = cfg.Address
+	if err := configureTLS(config, cfg.TLS); err != nil {
+		return nil, nil, err
+	}
 	vaultClient, err := vault.NewClient(config)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if cfg.Namespace != "" {
+		vaultClient.SetNamespace(cfg.Namespace)
 	}
 
-	appRoleAuth, err := auth.NewAppRoleAuth(
-		cfg.RoleID,
-		&auth.SecretID{FromString: cfg.SecretID},
-	)
+	authInfo, err := login(ctx, vaultClient, cfg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	authInfo, err := vaultClient.Auth().Login(context.Background(), appRoleAuth)
+	return vaultClient, authInfo, nil
+}
+
+// login authenticates the client with the configured auth method and sets its token
+func login(ctx context.Context, vaultClient *vault.Client, cfg *ClientConfig) (*vault.Secret, error) {
+	ctx, span := tracer.Start(ctx, "vault.login", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("vault.auth_method", cfg.AuthMethod)))
+	defer span.End()
+
+	authMethod, err := newAuthMethod(cfg)
 	if err != nil {
 		return nil, err
 	}
-	if authInfo == nil {
-		return nil, fmt.Errorf("no auth info was returned after login")
-	}
 
-	return vaultClient, nil
+	authInfo, err := vaultClient.Auth().Login(ctx, authMethod)
+	if err == nil && authInfo == nil {
+		err = fmt.Errorf("no auth info was returned after login")
+	}
+	if err != nil {
+		if ctx.Err() == nil {
+			loginsTotal.WithLabelValues(resultFailure).Inc()
+		}
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	loginsTotal.WithLabelValues(resultSuccess).Inc()
+	return authInfo, nil
 }
 
 // IsTokenValid checks if the token is valid and has a TTL greater than the minimum TTL
 func IsTokenValid(vc *VaultClient, minTTLSeconds int64) bool {
+	ttlSeconds, ok := tokenTTL(vc)
+	return ok && ttlSeconds > minTTLSeconds
+}
+
+// tokenTTL looks up the remaining TTL of the client's token in seconds, returning false when the
+// token is not valid
+func tokenTTL(vc *VaultClient) (int64, bool) {
 	if vc == nil || vc.Client == nil {
-		return false
+		return 0, false
 	}
 
 	resp, err := vc.Client.Auth().Token().LookupSelf()
 	if err != nil {
-		return false
+		return 0, false
 	}
 
 	ttlRaw, exists := resp.Data["ttl"]
 	if !exists {
-		return false
+		return 0, false
 	}
 
 	ttlJSONNumber, ok := ttlRaw.(json.Number)
 	if !ok {
-		return false
+		return 0, false
 	}
 
 	ttlSeconds, err := ttlJSONNumber.Int64()
 	if err != nil {
-		return false
-	}
-
-	if ttlSeconds <= minTTLSeconds {
-		return false
+		return 0, false
 	}
-
-	return true
+	return ttlSeconds, true
 }
 
 // NewClientConfig creates a new ClientConfig with the provided address and AppRole credentials
@@ -90,39 +123,239 @@ func NewClientConfig(address, roleID, secretID, mountPath string) (*ClientConfig
 	}
 
 	clientConfig := &ClientConfig{
-		Address:   address,
-		RoleID:    roleID,
-		SecretID:  secretID,
-		MountPath: mountPath,
+		Address:    address,
+		AuthMethod: AuthMethodAppRole,
+		RoleID:     roleID,
+		SecretID:   secretID,
+		MountPath:  mountPath,
+	}
+	return clientConfig, nil
+}
+
+// NewJWTClientConfig creates a new ClientConfig with the provided address and JWT/OIDC role.
+// jwtPath and authMountPath fall back to the service account token and the "jwt" mount when empty.
+func NewJWTClientConfig(address, role, jwtPath, authMountPath, mountPath string) (*ClientConfig, error) {
+	if address == "" {
+		return nil, errors.New("vault address is required")
+	}
+	if role == "" {
+		return nil, errors.New("vault role is required")
+	}
+	if mountPath == "" {
+		return nil, errors.New("vault mountPath is required")
+	}
+	if jwtPath == "" {
+		jwtPath = defaultJWTPath
+	}
+	if authMountPath == "" {
+		authMountPath = defaultJWTAuthMountPath
+	}
+
+	clientConfig := &ClientConfig{
+		Address:       address,
+		AuthMethod:    AuthMethodJWT,
+		Role:          role,
+		JWTPath:       jwtPath,
+		AuthMountPath: authMountPath,
+		MountPath:     mountPath,
+	}
+	return clientConfig, nil
+}
+
+// NewTokenFileClientConfig creates a new ClientConfig that consumes a token rendered by Vault Agent.
+// tokenPath falls back to the injector's default sink when empty.
+func NewTokenFileClientConfig(address, tokenPath, mountPath string) (*ClientConfig, error) {
+	if address == "" {
+		return nil, errors.New("vault address is required")
+	}
+	if mountPath == "" {
+		return nil, errors.New("vault mountPath is required")
+	}
+	if tokenPath == "" {
+		tokenPath = defaultTokenPath
+	}
+
+	clientConfig := &ClientConfig{
+		Address:    address,
+		AuthMethod: AuthMethodToken,
+		TokenPath:  tokenPath,
+		MountPath:  mountPath,
+	}
+	return clientConfig, nil
+}
+
+// ReloadTokenFile re-reads the Vault Agent token file when it changed on disk since it was last read.
+// It returns true when a new token was loaded. Clients using other auth methods are left untouched.
+func ReloadTokenFile(vc *VaultClient) (bool, error) {
+	if vc == nil || vc.Client == nil || vc.Config == nil || vc.Config.AuthMethod != AuthMethodToken {
+		return false, nil
+	}
+
+	modTime, err := fileModTime(vc.Config.TokenPath)
+	if err != nil {
+		return false, err
+	}
+	if modTime.Equal(vc.tokenModTime) {
+		return false, nil
+	}
+
+	token, err := readTokenFile(vc.Config.TokenPath)
+	if err != nil {
+		return false, err
+	}
+	vc.Client.SetToken(token)
+	vc.tokenModTime = modTime
+	return true, nil
+}
+
+// fileModTime returns the modification time of the file at path
+func fileModTime(path string) (time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime(), nil
+}
+
+// newClientConfigFromSecret builds a ClientConfig, including TLS and KV settings, from the vault credentials secret
+func newClientConfigFromSecret(secret *corev1.Secret) (*ClientConfig, error) {
+	clientConfig, err := newAuthClientConfigFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := tlsConfigFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	clientConfig.TLS = tlsConfig
+
+	kvVersion, err := kvVersionFromSecret(secret)
+	if err != nil {
+		return nil, err
 	}
+	clientConfig.KVVersion = kvVersion
+
 	return clientConfig, nil
 }
 
+// newAuthClientConfigFromSecret builds a ClientConfig from the vault credentials secret
+// according to its authMethod key (AppRole when unset).
+func newAuthClientConfigFromSecret(secret *corev1.Secret) (*ClientConfig, error) {
+	authMethod := string(secret.Data["authMethod"])
+	switch authMethod {
+	case AuthMethodAppRole, "":
+		return NewClientConfig(
+			string(secret.Data["address"]),
+			string(secret.Data["roleId"]),
+			string(secret.Data["secretId"]),
+			string(secret.Data["mountPath"]),
+		)
+	case AuthMethodJWT:
+		return NewJWTClientConfig(
+			string(secret.Data["address"]),
+			string(secret.Data["role"]),
+			string(secret.Data["jwtPath"]),
+			string(secret.Data["authMountPath"]),
+			string(secret.Data["mountPath"]),
+		)
+	case AuthMethodToken:
+		return NewTokenFileClientConfig(
+			string(secret.Data["address"]),
+			string(secret.Data["tokenPath"]),
+			string(secret.Data["mountPath"]),
+		)
+	default:
+		return nil, fmt.Errorf("unsupported vault auth method %q", authMethod)
+	}
+}
+
+// ClientRef selects a vault connection secret and the settings overriding it, identifying a Vault
+// client that can be shared by the reconciles using the same configuration
+type ClientRef struct {
+	// Namespace and SecretName locate the vault connection secret
+	Namespace  string
+	SecretName string
+	// MountPath overrides the secret's mountPath
+	MountPath string
+	// VaultNamespace is the Vault Enterprise namespace to authenticate and read secrets in
+	VaultNamespace string
+	// Role overrides the secret's JWT/OIDC role
+	Role string
+}
+
+// metricLabel identifies the client in metrics by its secret and the overrides that change its
+// login, without any credentials
+func (ref ClientRef) metricLabel() string {
+	label := ref.Namespace + "/" + ref.SecretName
+	if ref.VaultNamespace != "" {
+		label += ",namespace=" + ref.VaultNamespace
+	}
+	if ref.Role != "" {
+		label += ",role=" + ref.Role
+	}
+	return label
+}
+
+// applyOverrides applies the settings of the reference to a config read from its secret
+func (ref ClientRef) applyOverrides(cfg *ClientConfig) error {
+	if ref.MountPath != "" {
+		cfg.MountPath = ref.MountPath
+		// The KV version configured in the secret describes its own mount
+		cfg.KVVersion = 0
+	}
+	if ref.VaultNamespace != "" {
+		cfg.Namespace = ref.VaultNamespace
+	}
+	if ref.Role != "" {
+		if cfg.AuthMethod != AuthMethodJWT {
+			return fmt.Errorf("a vault role override requires the %s auth method, not %s", AuthMethodJWT, cfg.AuthMethod)
+		}
+		cfg.Role = ref.Role
+	}
+	return nil
+}
+
 // InitializeVaultClientFromSecret creates and authenticates a new Vault client using credentials
-// stored in a Kubernetes secret.
+// stored in a Kubernetes secret. The secret's authMethod key selects AppRole (default), JWT/OIDC or a Vault Agent token file.
 func InitializeVaultClientFromSecret(ctx context.Context, k8sClient client.Client, namespace, secretName string) (*VaultClient, error) {
+	return InitializeVaultClient(ctx, k8sClient, ClientRef{Namespace: namespace, SecretName: secretName})
+}
+
+// InitializeVaultClient behaves like InitializeVaultClientFromSecret, applying the overrides of ref
+// to the settings read from its secret
+func InitializeVaultClient(ctx context.Context, k8sClient client.Client, ref ClientRef) (*VaultClient, error) {
 	vaultSecret := &corev1.Secret{}
-	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: secretName}, vaultSecret); err != nil {
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.SecretName}, vaultSecret); err != nil {
 		return nil, err
 	}
 
-	vaultConfig, err := NewClientConfig(
-		string(vaultSecret.Data["address"]),
-		string(vaultSecret.Data["roleId"]),
-		string(vaultSecret.Data["secretId"]),
-		string(vaultSecret.Data["mountPath"]),
-	)
+	vaultConfig, err := newClientConfigFromSecret(vaultSecret)
 	if err != nil {
 		return nil, err
 	}
+	if err := ref.applyOverrides(vaultConfig); err != nil {
+		return nil, err
+	}
+
+	// Record the token file's modification time before reading it so a rotation
+	// racing with the initial read is picked up by ReloadTokenFile
+	var tokenModTime time.Time
+	if vaultConfig.AuthMethod == AuthMethodToken {
+		if tokenModTime, err = fileModTime(vaultConfig.TokenPath); err != nil {
+			return nil, err
+		}
+	}
 
-	vaultClient, err := NewClient(vaultConfig)
+	vaultClient, authInfo, err := newClient(ctx, vaultConfig)
 	if err != nil {
 		return nil, err
 	}
 
 	return &VaultClient{
-		Client: vaultClient,
-		Config: vaultConfig,
+		Client:       vaultClient,
+		Config:       vaultConfig,
+		tokenModTime: tokenModTime,
+		authInfo:     authInfo,
 	}, nil
 }
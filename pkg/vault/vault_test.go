@@ -2,8 +2,13 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	vaultapi "github.com/hashicorp/vault/api"
 	vaulthttp "github.com/hashicorp/vault/http"
@@ -374,6 +379,22 @@ func TestInitializeVaultClientFromSecret(t *testing.T) {
 		},
 	}
 
+	unsupportedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "unsupported-vault-secret",
+			Namespace: "test-namespace",
+		},
+		Data: map[string][]byte{
+			"address":    []byte(testClient.Address()),
+			"authMethod": []byte("ldap"),
+			"mountPath":  []byte("apps"),
+		},
+	}
+
+	invalidKVSecret := validSecret.DeepCopy()
+	invalidKVSecret.Name = "invalid-kv-vault-secret"
+	invalidKVSecret.Data["kvVersion"] = []byte("3")
+
 	scheme := runtime.NewScheme()
 	_ = corev1.AddToScheme(scheme)
 
@@ -408,6 +429,22 @@ func TestInitializeVaultClientFromSecret(t *testing.T) {
 			expectError: true,
 			errorMsg:    "vault secretID is required",
 		},
+		{
+			name:        "invalid kv version",
+			secretName:  "invalid-kv-vault-secret",
+			namespace:   "test-namespace",
+			objects:     []client.Object{invalidKVSecret},
+			expectError: true,
+			errorMsg:    "invalid vault kvVersion \"3\": must be 1 or 2",
+		},
+		{
+			name:        "unsupported auth method",
+			secretName:  "unsupported-vault-secret",
+			namespace:   "test-namespace",
+			objects:     []client.Object{unsupportedSecret},
+			expectError: true,
+			errorMsg:    "unsupported vault auth method \"ldap\"",
+		},
 	}
 
 	for _, tt := range tests {
@@ -455,3 +492,312 @@ func TestInitializeVaultClientFromSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestNewJWTClientConfig(t *testing.T) {
+	tests := []struct {
+		name              string
+		role              string
+		jwtPath           string
+		authMountPath     string
+		mountPath         string
+		expectError       bool
+		errorMsg          string
+		expectedJWTPath   string
+		expectedAuthMount string
+	}{
+		{
+			name:              "defaults applied",
+			role:              "fivetran-operator",
+			mountPath:         "apps",
+			expectedJWTPath:   defaultJWTPath,
+			expectedAuthMount: defaultJWTAuthMountPath,
+		},
+		{
+			name:              "explicit paths",
+			role:              "fivetran-operator",
+			jwtPath:           "/var/run/secrets/spiffe/jwt",
+			authMountPath:     "oidc",
+			mountPath:         "apps",
+			expectedJWTPath:   "/var/run/secrets/spiffe/jwt",
+			expectedAuthMount: "oidc",
+		},
+		{
+			name:        "empty role",
+			mountPath:   "apps",
+			expectError: true,
+			errorMsg:    "vault role is required",
+		},
+		{
+			name:        "empty mountPath",
+			role:        "fivetran-operator",
+			expectError: true,
+			errorMsg:    "vault mountPath is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := NewJWTClientConfig("http://127.0.0.1:8200", tt.role, tt.jwtPath, tt.authMountPath, tt.mountPath)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+					return
+				}
+				if err.Error() != tt.errorMsg {
+					t.Errorf("expected error message '%s', got '%s'", tt.errorMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if config.AuthMethod != AuthMethodJWT {
+				t.Errorf("expected auth method '%s', got '%s'", AuthMethodJWT, config.AuthMethod)
+			}
+			if config.JWTPath != tt.expectedJWTPath {
+				t.Errorf("expected jwtPath '%s', got '%s'", tt.expectedJWTPath, config.JWTPath)
+			}
+			if config.AuthMountPath != tt.expectedAuthMount {
+				t.Errorf("expected authMountPath '%s', got '%s'", tt.expectedAuthMount, config.AuthMountPath)
+			}
+		})
+	}
+}
+
+func TestNewClientWithJWTAuth(t *testing.T) {
+	jwtFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(jwtFile, []byte("header.payload.signature\n"), 0o600); err != nil {
+		t.Fatalf("failed to write jwt: %v", err)
+	}
+
+	var loginBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/auth/oidc/login" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&loginBody); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"auth":{"client_token":"jwt-token","lease_duration":3600,"renewable":true}}`))
+	}))
+	defer server.Close()
+
+	config, err := NewJWTClientConfig(server.URL, "fivetran-operator", jwtFile, "oidc", "apps")
+	if err != nil {
+		t.Fatalf("failed to create config: %v", err)
+	}
+
+	vaultClient, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if vaultClient.Token() != "jwt-token" {
+		t.Errorf("expected client token 'jwt-token', got '%s'", vaultClient.Token())
+	}
+	if loginBody["role"] != "fivetran-operator" {
+		t.Errorf("expected role 'fivetran-operator', got '%v'", loginBody["role"])
+	}
+	if loginBody["jwt"] != "header.payload.signature" {
+		t.Errorf("expected trimmed jwt, got '%v'", loginBody["jwt"])
+	}
+
+	config.JWTPath = filepath.Join(t.TempDir(), "missing")
+	if _, err := NewClient(config); err == nil {
+		t.Error("expected error for missing jwt file but got none")
+	}
+}
+
+func TestTokenFileAuth(t *testing.T) {
+	testClient, _, cleanup := setupTestVault(t)
+	defer cleanup()
+
+	firstToken, err := testClient.Auth().Token().Create(&vaultapi.TokenCreateRequest{Policies: []string{"default"}})
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+	secondToken, err := testClient.Auth().Token().Create(&vaultapi.TokenCreateRequest{Policies: []string{"default"}})
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte(firstToken.Auth.ClientToken+"\n"), 0o600); err != nil {
+		t.Fatalf("failed to write token file: %v", err)
+	}
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "agent-vault-secret",
+				Namespace: "test-namespace",
+			},
+			Data: map[string][]byte{
+				"address":    []byte(testClient.Address()),
+				"authMethod": []byte(AuthMethodToken),
+				"tokenPath":  []byte(tokenFile),
+				"mountPath":  []byte("apps"),
+			},
+		}).
+		Build()
+
+	vaultClient, err := InitializeVaultClientFromSecret(context.Background(), k8sClient, "test-namespace", "agent-vault-secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vaultClient.Client.Token() != firstToken.Auth.ClientToken {
+		t.Errorf("expected token from file to be used")
+	}
+
+	reloaded, err := ReloadTokenFile(vaultClient)
+	if err != nil || reloaded {
+		t.Errorf("ReloadTokenFile() = (%v, %v), expected no reload for unchanged file", reloaded, err)
+	}
+
+	// Simulate the agent rotating the token
+	if err := os.WriteFile(tokenFile, []byte(secondToken.Auth.ClientToken), 0o600); err != nil {
+		t.Fatalf("failed to rewrite token file: %v", err)
+	}
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(tokenFile, future, future); err != nil {
+		t.Fatalf("failed to update token file mtime: %v", err)
+	}
+
+	reloaded, err = ReloadTokenFile(vaultClient)
+	if err != nil || !reloaded {
+		t.Errorf("ReloadTokenFile() = (%v, %v), expected reload after rotation", reloaded, err)
+	}
+	if vaultClient.Client.Token() != secondToken.Auth.ClientToken {
+		t.Errorf("expected rotated token to be used")
+	}
+
+	// An invalid token must be rejected on initialization
+	if err := os.WriteFile(tokenFile, []byte("not-a-token"), 0o600); err != nil {
+		t.Fatalf("failed to rewrite token file: %v", err)
+	}
+	if _, err := InitializeVaultClientFromSecret(context.Background(), k8sClient, "test-namespace", "agent-vault-secret"); err == nil {
+		t.Error("expected error for invalid token but got none")
+	}
+}
+
+func TestNewClientTLS(t *testing.T) {
+	// No VAULT_SKIP_VERIFY here: the server certificate must be verified against the configured CA
+	cluster := vault.NewTestCluster(t, &vault.CoreConfig{
+		DevToken: "test-token",
+		LogLevel: "error",
+	}, &vault.TestClusterOptions{
+		HandlerFunc: vaulthttp.Handler,
+		NumCores:    1,
+	})
+	cluster.Start()
+	defer cluster.Cleanup()
+
+	vault.TestWaitActive(t, cluster.Cores[0].Core)
+	testClient := cluster.Cores[0].Client
+
+	if err := testClient.Sys().EnableAuthWithOptions("approle", &vaultapi.EnableAuthOptions{Type: "approle"}); err != nil {
+		t.Fatalf("failed to enable approle auth: %v", err)
+	}
+	if _, err := testClient.Logical().Write("auth/approle/role/test-role", map[string]any{"policies": []string{"default"}}); err != nil {
+		t.Fatalf("failed to create test role: %v", err)
+	}
+	roleIDResp, err := testClient.Logical().Read("auth/approle/role/test-role/role-id")
+	if err != nil {
+		t.Fatalf("failed to read role ID: %v", err)
+	}
+	roleID := roleIDResp.Data["role_id"].(string)
+
+	newConfig := func(tlsConfig TLSConfig) *ClientConfig {
+		secretIDResp, err := testClient.Logical().Write("auth/approle/role/test-role/secret-id", nil)
+		if err != nil {
+			t.Fatalf("failed to generate secret ID: %v", err)
+		}
+		return &ClientConfig{
+			Address:   testClient.Address(),
+			RoleID:    roleID,
+			SecretID:  secretIDResp.Data["secret_id"].(string),
+			MountPath: "apps",
+			TLS:       tlsConfig,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		tlsConfig   TLSConfig
+		expectError bool
+	}{
+		{
+			name:      "trusted CA",
+			tlsConfig: TLSConfig{CACert: cluster.CACertPEM},
+		},
+		{
+			name:      "skip verify",
+			tlsConfig: TLSConfig{SkipVerify: true},
+		},
+		{
+			name:        "unknown CA",
+			tlsConfig:   TLSConfig{},
+			expectError: true,
+		},
+		{
+			name:        "invalid CA PEM",
+			tlsConfig:   TLSConfig{CACert: []byte("not a certificate")},
+			expectError: true,
+		},
+		{
+			name:        "client cert without key",
+			tlsConfig:   TLSConfig{CACert: cluster.CACertPEM, ClientCert: cluster.CACertPEM},
+			expectError: true,
+		},
+		{
+			name:        "mismatched server name",
+			tlsConfig:   TLSConfig{CACert: cluster.CACertPEM, ServerName: "vault.example.com"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(newConfig(tt.tlsConfig))
+			if tt.expectError && err == nil {
+				t.Errorf("expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestTLSConfigFromSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"caCert":        []byte("ca"),
+			"tlsServerName": []byte("vault.internal"),
+			"tlsSkipVerify": []byte("true"),
+		},
+	}
+
+	tlsConfig, err := tlsConfigFromSecret(secret)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(tlsConfig.CACert) != "ca" || tlsConfig.ServerName != "vault.internal" || !tlsConfig.SkipVerify {
+		t.Errorf("unexpected TLS config: %+v", tlsConfig)
+	}
+
+	secret.Data["tlsSkipVerify"] = []byte("maybe")
+	if _, err := tlsConfigFromSecret(secret); err == nil {
+		t.Error("expected error for invalid tlsSkipVerify but got none")
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+)
+
+func TestConnectorLifecycle(t *testing.T) {
+	h := Start(t)
+	ctx := context.Background()
+
+	if err := h.PutSecret(ctx, "postgres", map[string]any{"password": "db-password"}); err != nil {
+		t.Fatalf("failed to write vault secret: %v", err)
+	}
+
+	paused := false
+	connector := &operatorv1alpha1.FivetranConnector{
+		ObjectMeta: metav1.ObjectMeta{Name: "postgres", Namespace: Namespace},
+		Spec: operatorv1alpha1.FivetranConnectorSpec{
+			Connector: operatorv1alpha1.Connector{
+				GroupID: "group_id",
+				Service: "postgres",
+				Paused:  &paused,
+				Config: &runtime.RawExtension{
+					Raw: []byte(`{"schema":"sales","host":"db","password":"vault:postgres#password"}`),
+				},
+			},
+		},
+	}
+	if err := h.Client.Create(ctx, connector); err != nil {
+		t.Fatalf("failed to create connector: %v", err)
+	}
+
+	if err := h.ReconcileUntilSettled(ctx, "postgres", 5); err != nil {
+		t.Fatalf("expected the reconcile to settle: %v", err)
+	}
+
+	connector, err := h.Connector(ctx, "postgres")
+	if err != nil {
+		t.Fatalf("failed to get connector: %v", err)
+	}
+	if !meta.IsStatusConditionTrue(connector.Status.Conditions, "ConnectorReady") {
+		t.Errorf("expected the connector to be ready, got conditions %+v", connector.Status.Conditions)
+	}
+	stored, ok := h.Fivetran.Connection(connector.Status.ConnectorID)
+	if !ok {
+		t.Fatalf("expected connection %q in the fake Fivetran API", connector.Status.ConnectorID)
+	}
+	if config, _ := stored["config"].(map[string]any); config["password"] != "db-password" {
+		t.Errorf("expected the password resolved from vault, got %v", stored["config"])
+	}
+
+	// Deleting the resource deletes the connection
+	if err := h.Client.Delete(ctx, connector); err != nil {
+		t.Fatalf("failed to delete connector: %v", err)
+	}
+	if err := h.ReconcileUntilSettled(ctx, "postgres", 2); err != nil {
+		t.Fatalf("expected the deletion to settle: %v", err)
+	}
+	if _, ok := h.Fivetran.Connection(connector.Status.ConnectorID); ok {
+		t.Errorf("expected the connection deleted from the fake Fivetran API")
+	}
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package integration provides a harness that drives full reconcile cycles of the
+// FivetranConnector reconciler against an envtest API server, the fake Fivetran API server and an
+// in-process Vault dev cluster.
+package integration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	vaulthttp "github.com/hashicorp/vault/http"
+	vaultcore "github.com/hashicorp/vault/vault"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	operatorv1alpha1 "github.com/redhat-data-and-ai/fivetran-operator/api/v1alpha1"
+	"github.com/redhat-data-and-ai/fivetran-operator/internal/controller/fivetranconnector"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/fivetranfake"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/fivetran/vault"
+	"github.com/redhat-data-and-ai/fivetran-operator/pkg/secrets"
+	vaultpkg "github.com/redhat-data-and-ai/fivetran-operator/pkg/vault"
+)
+
+const (
+	// Namespace is the namespace the harness creates for the resources under test
+	Namespace = "fivetran-operator"
+	// VaultMount is the KV v2 mount whose secrets connectors can reference
+	VaultMount = "apps"
+
+	// vaultSecretName is the name of the vault connection secret the reconciler reads by default
+	vaultSecretName = "fivetran-vault-secret"
+	// vaultRole is the AppRole the reconciler logs in with
+	vaultRole = "fivetran-operator"
+)
+
+// Harness is a running test environment with a FivetranConnector reconciler
+type Harness struct {
+	// Client reads and writes resources in the envtest API server
+	Client client.Client
+	// Fivetran is the fake Fivetran API the reconciler's client talks to
+	Fivetran *fivetranfake.Server
+	// Vault is a root client of the Vault cluster
+	Vault *vaultapi.Client
+	// Reconciler is the reconciler under test
+	Reconciler *fivetranconnector.FivetranConnectorReconciler
+}
+
+// Start starts the test environment, which is stopped when the test ends. The test is skipped when
+// the envtest binaries are not installed; run make setup-envtest to install them.
+func Start(t *testing.T) *Harness {
+	t.Helper()
+
+	assets := envTestBinaryDir()
+	if assets == "" {
+		t.Skip("envtest binaries not found; run make setup-envtest or set KUBEBUILDER_ASSETS")
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go types to scheme: %v", err)
+	}
+	if err := operatorv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add operator types to scheme: %v", err)
+	}
+
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+		BinaryAssetsDirectory: assets,
+	}
+	cfg, err := testEnv.Start()
+	if err != nil {
+		t.Fatalf("failed to start envtest: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := testEnv.Stop(); err != nil {
+			t.Logf("failed to stop envtest: %v", err)
+		}
+	})
+
+	k8sClient, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		t.Fatalf("failed to create kubernetes client: %v", err)
+	}
+
+	server := fivetranfake.NewServer()
+	t.Cleanup(server.Close)
+	fivetranClient, err := fivetran.NewClientWithConfig("key", "secret", fivetran.ClientConfig{
+		Retry:   fivetran.RetryConfig{MaxAttempts: 1},
+		BaseURL: server.URL,
+	})
+	if err != nil {
+		t.Fatalf("failed to create fivetran client: %v", err)
+	}
+
+	vaultClient, caCert := startVault(t)
+
+	ctx := context.Background()
+	if err := k8sClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: Namespace}}); err != nil {
+		t.Fatalf("failed to create namespace: %v", err)
+	}
+	if err := k8sClient.Create(ctx, vaultConnectionSecret(t, vaultClient, caCert)); err != nil {
+		t.Fatalf("failed to create vault connection secret: %v", err)
+	}
+
+	resolvers := vault.NewRegistry()
+	if err := resolvers.Register(secrets.SecretScheme, secrets.NewKubernetesSecretResolver(k8sClient)); err != nil {
+		t.Fatalf("failed to register secret resolver: %v", err)
+	}
+
+	return &Harness{
+		Client:   k8sClient,
+		Fivetran: server,
+		Vault:    vaultClient,
+		Reconciler: &fivetranconnector.FivetranConnectorReconciler{
+			Client:          k8sClient,
+			Scheme:          scheme,
+			FivetranClient:  fivetranClient,
+			VaultClients:    vaultpkg.NewClientManager(k8sClient, nil),
+			SecretResolvers: resolvers,
+		},
+	}
+}
+
+// Reconcile runs one reconcile cycle of the named FivetranConnector
+func (h *Harness) Reconcile(ctx context.Context, name string) (ctrl.Result, error) {
+	return h.Reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: Namespace, Name: name}})
+}
+
+// ReconcileUntilSettled runs reconcile cycles of the named FivetranConnector until one succeeds
+// without asking for an immediate requeue, failing after maxCycles
+func (h *Harness) ReconcileUntilSettled(ctx context.Context, name string, maxCycles int) error {
+	var lastErr error
+	for range maxCycles {
+		result, err := h.Reconcile(ctx, name)
+		if err == nil && !result.Requeue {
+			return nil
+		}
+		lastErr = err
+	}
+	return fmt.Errorf("reconcile of %s did not settle after %d cycles: %w", name, maxCycles, lastErr)
+}
+
+// Connector returns the current state of the named FivetranConnector
+func (h *Harness) Connector(ctx context.Context, name string) (*operatorv1alpha1.FivetranConnector, error) {
+	connector := &operatorv1alpha1.FivetranConnector{}
+	err := h.Client.Get(ctx, types.NamespacedName{Namespace: Namespace, Name: name}, connector)
+	return connector, err
+}
+
+// PutSecret writes a secret to the KV v2 mount that connectors can reference
+func (h *Harness) PutSecret(ctx context.Context, path string, data map[string]any) error {
+	_, err := h.Vault.KVv2(VaultMount).Put(ctx, path, data)
+	return err
+}
+
+// startVault starts a Vault dev cluster with the KV v2 mount and an AppRole allowed to read it,
+// returning its root client and CA certificate
+func startVault(t *testing.T) (*vaultapi.Client, []byte) {
+	t.Helper()
+
+	cluster := vaultcore.NewTestCluster(t, &vaultcore.CoreConfig{
+		DevToken: "root",
+		LogLevel: "error",
+	}, &vaultcore.TestClusterOptions{
+		HandlerFunc: vaulthttp.Handler,
+		NumCores:    1,
+	})
+	cluster.Start()
+	t.Cleanup(cluster.Cleanup)
+	vaultcore.TestWaitActive(t, cluster.Cores[0].Core)
+	vaultClient := cluster.Cores[0].Client
+
+	if err := vaultClient.Sys().Mount(VaultMount, &vaultapi.MountInput{Type: "kv-v2"}); err != nil {
+		t.Fatalf("failed to create %s mount: %v", VaultMount, err)
+	}
+	policy := fmt.Sprintf(`path "%s/*" { capabilities = ["read"] }`, VaultMount)
+	if err := vaultClient.Sys().PutPolicy(vaultRole, policy); err != nil {
+		t.Fatalf("failed to create vault policy: %v", err)
+	}
+	if err := vaultClient.Sys().EnableAuthWithOptions("approle", &vaultapi.EnableAuthOptions{Type: "approle"}); err != nil {
+		t.Fatalf("failed to enable approle auth: %v", err)
+	}
+	if _, err := vaultClient.Logical().Write("auth/approle/role/"+vaultRole, map[string]any{
+		"token_ttl": "1h",
+		"policies":  []string{vaultRole},
+	}); err != nil {
+		t.Fatalf("failed to create approle role: %v", err)
+	}
+
+	return vaultClient, cluster.CACertPEM
+}
+
+// vaultConnectionSecret returns the vault connection secret logging in with the harness AppRole
+func vaultConnectionSecret(t *testing.T, vaultClient *vaultapi.Client, caCert []byte) *corev1.Secret {
+	t.Helper()
+
+	roleID, err := vaultClient.Logical().Read("auth/approle/role/" + vaultRole + "/role-id")
+	if err != nil {
+		t.Fatalf("failed to read approle role id: %v", err)
+	}
+	secretID, err := vaultClient.Logical().Write("auth/approle/role/"+vaultRole+"/secret-id", nil)
+	if err != nil {
+		t.Fatalf("failed to create approle secret id: %v", err)
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: vaultSecretName, Namespace: Namespace},
+		Data: map[string][]byte{
+			"address":   []byte(vaultClient.Address()),
+			"roleId":    []byte(roleID.Data["role_id"].(string)),
+			"secretId":  []byte(secretID.Data["secret_id"].(string)),
+			"mountPath": []byte(VaultMount),
+			"caCert":    caCert,
+		},
+	}
+}
+
+// envTestBinaryDir returns the directory of the envtest binaries, from KUBEBUILDER_ASSETS or the
+// first version installed by make setup-envtest, or empty when there is none
+func envTestBinaryDir() string {
+	if dir := os.Getenv("KUBEBUILDER_ASSETS"); dir != "" {
+		return dir
+	}
+	basePath := filepath.Join("..", "..", "bin", "k8s")
+	entries, err := os.ReadDir(basePath)
+	if err != nil {
+		return ""
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			return filepath.Join(basePath, entry.Name())
+		}
+	}
+	return ""
+}